@@ -27,6 +27,8 @@ import (
 	gov "github.com/zenanetwork/iris/gov"
 	govTypes "github.com/zenanetwork/iris/gov/types"
 	"github.com/zenanetwork/iris/helper"
+	"github.com/zenanetwork/iris/logregistry"
+	logregistryTypes "github.com/zenanetwork/iris/logregistry/types"
 	"github.com/zenanetwork/iris/params"
 	paramsClient "github.com/zenanetwork/iris/params/client"
 	"github.com/zenanetwork/iris/params/subspace"
@@ -43,6 +45,9 @@ import (
 	topupTypes "github.com/zenanetwork/iris/topup/types"
 	"github.com/zenanetwork/iris/types"
 	hmModule "github.com/zenanetwork/iris/types/module"
+	"github.com/zenanetwork/iris/upgrade"
+	upgradeClient "github.com/zenanetwork/iris/upgrade/client"
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
 	"github.com/zenanetwork/iris/version"
 	zena "github.com/zenanetwork/iris/zena"
 	zenaTypes "github.com/zenanetwork/iris/zena/types"
@@ -72,8 +77,10 @@ var (
 		zena.AppModuleBasic{},
 		clerk.AppModuleBasic{},
 		topup.AppModuleBasic{},
+		logregistry.AppModuleBasic{},
 		slashing.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsClient.ProposalHandler),
+		gov.NewAppModuleBasic(paramsClient.ProposalHandler, upgradeClient.ProposalHandler, upgradeClient.CancelProposalHandler),
+		upgrade.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -92,6 +99,9 @@ type IrisApp struct {
 	keys  map[string]*sdk.KVStoreKey
 	tkeys map[string]*sdk.TransientStoreKey
 
+	// true if the upgrade stores are in the multistore
+	upgradeStoresMounted bool
+
 	// subspaces
 	subspaces map[string]subspace.Subspace
 
@@ -111,6 +121,8 @@ type IrisApp struct {
 	ClerkKeeper       clerk.Keeper
 	TopupKeeper       topup.Keeper
 	SlashingKeeper    slashing.Keeper
+	UpgradeKeeper     upgrade.Keeper
+	LogRegistryKeeper logregistry.Keeper
 
 	// param keeper
 	ParamsKeeper params.Keeper
@@ -144,6 +156,11 @@ func (d ModuleCommunicator) GetACKCount(ctx sdk.Context) uint64 {
 	return d.App.CheckpointKeeper.GetACKCount(ctx)
 }
 
+// GetLastAckBlock returns the L1 block of the last checkpoint ack
+func (d ModuleCommunicator) GetLastAckBlock(ctx sdk.Context) uint64 {
+	return d.App.CheckpointKeeper.GetLastAckBlock(ctx)
+}
+
 // IsCurrentValidatorByAddress check if validator is current validator
 func (d ModuleCommunicator) IsCurrentValidatorByAddress(ctx sdk.Context, address []byte) bool {
 	return d.App.StakingKeeper.IsCurrentValidatorByAddress(ctx, address)
@@ -197,7 +214,11 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 	// base app
 	bApp := bam.NewBaseApp(AppName, logger, db, authTypes.DefaultMainTxDecoder(cdc, func() int64 {
 		return app.LastBlockHeight()
-	}, helper.GetDanelawHeight, helper.GetJorvikHeight), baseAppOptions...)
+	}, func() int64 {
+		return app.upgradeHeight(helper.DanelawUpgrade)
+	}, func() int64 {
+		return app.upgradeHeight(helper.JorvikUpgrade)
+	}), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(nil)
 	bApp.SetAppVersion(version.Version)
 
@@ -216,7 +237,9 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		zenaTypes.StoreKey,
 		clerkTypes.StoreKey,
 		topupTypes.StoreKey,
+		logregistryTypes.StoreKey,
 		paramsTypes.StoreKey,
+		upgradeTypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramsTypes.TStoreKey)
 
@@ -243,6 +266,7 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 	app.subspaces[zenaTypes.ModuleName] = app.ParamsKeeper.Subspace(zenaTypes.DefaultParamspace)
 	app.subspaces[clerkTypes.ModuleName] = app.ParamsKeeper.Subspace(clerkTypes.DefaultParamspace)
 	app.subspaces[topupTypes.ModuleName] = app.ParamsKeeper.Subspace(topupTypes.DefaultParamspace)
+	app.subspaces[logregistryTypes.ModuleName] = app.ParamsKeeper.Subspace(logregistryTypes.DefaultParamspace)
 
 	//
 	// Contract caller
@@ -290,13 +314,32 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		authTypes.ProtoBaseAccount, // prototype
 	)
 
+	app.UpgradeKeeper = upgrade.NewKeeper(
+		app.cdc,
+		keys[upgradeTypes.StoreKey], // target store
+		upgradeTypes.DefaultCodespace,
+	)
+
+	// log registry keeper, shared by the modules processing L1 logs
+	app.LogRegistryKeeper = logregistry.NewKeeper(
+		app.cdc,
+		keys[logregistryTypes.StoreKey], // target store
+		app.subspaces[logregistryTypes.ModuleName],
+		common.DefaultCodespace,
+		app.ChainKeeper,
+		app.UpgradeKeeper,
+		moduleCommunicator,
+	)
+
 	app.StakingKeeper = staking.NewKeeper(
 		app.cdc,
 		keys[stakingTypes.StoreKey], // target store
 		app.subspaces[stakingTypes.ModuleName],
 		common.DefaultCodespace,
 		app.ChainKeeper,
+		app.UpgradeKeeper,
 		moduleCommunicator,
+		app.LogRegistryKeeper,
 	)
 
 	app.SlashingKeeper = slashing.NewKeeper(
@@ -306,6 +349,7 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		app.subspaces[slashingTypes.ModuleName],
 		common.DefaultCodespace,
 		app.ChainKeeper,
+		app.LogRegistryKeeper,
 	)
 
 	// bank keeper
@@ -328,11 +372,25 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		app.BankKeeper,
 	)
 
+	// move the per-module sequences to the log registry once it activates
+	app.UpgradeKeeper.SetUpgradeHandler(upgradeTypes.LogRegistryUpgrade, func(ctx sdk.Context, _ upgradeTypes.Plan) {
+		app.StakingKeeper.MigrateStakingSequences(ctx)
+		app.SlashingKeeper.MigrateSlashingSequences(ctx)
+		app.ClerkKeeper.MigrateRecordSequences(ctx)
+		app.TopupKeeper.MigrateTopupSequences(ctx)
+	})
+
+	// index the checkpoints acked before the checkpoint block index
+	app.UpgradeKeeper.SetUpgradeHandler(upgradeTypes.CheckpointBlockIndexUpgrade, func(ctx sdk.Context, _ upgradeTypes.Plan) {
+		app.CheckpointKeeper.IndexCheckpointBlocks(ctx)
+	})
+
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.
 		AddRoute(govTypes.RouterKey, govTypes.ProposalHandler).
-		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(upgradeTypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
 
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc,
@@ -351,6 +409,7 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		common.DefaultCodespace,
 		app.StakingKeeper,
 		app.ChainKeeper,
+		app.UpgradeKeeper,
 		moduleCommunicator,
 	)
 
@@ -360,6 +419,7 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		app.subspaces[zenaTypes.ModuleName],
 		common.DefaultCodespace,
 		app.ChainKeeper,
+		app.UpgradeKeeper,
 		app.StakingKeeper,
 		&app.caller,
 	)
@@ -370,6 +430,8 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		app.subspaces[clerkTypes.ModuleName],
 		common.DefaultCodespace,
 		app.ChainKeeper,
+		app.UpgradeKeeper,
+		app.LogRegistryKeeper,
 	)
 
 	// may be need signer
@@ -381,11 +443,15 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		app.ChainKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
+		app.LogRegistryKeeper,
 	)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
+	// NOTE: upgrade must be the first module to begin blocks, so that the others see
+	// the upgrades active at the block.
 	app.mm = module.NewManager(
+		upgrade.NewAppModule(app.UpgradeKeeper),
 		sidechannel.NewAppModule(app.SidechannelKeeper),
 		auth.NewAppModule(app.AccountKeeper, &app.caller, []authTypes.AccountProcessor{
 			supplyTypes.AccountProcessor,
@@ -400,17 +466,20 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		zena.NewAppModule(app.ZenaKeeper, &app.caller),
 		clerk.NewAppModule(app.ClerkKeeper, &app.caller),
 		topup.NewAppModule(app.TopupKeeper, &app.caller),
+		logregistry.NewAppModule(app.LogRegistryKeeper, &app.caller),
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
+		upgradeTypes.ModuleName,
 		sidechannelTypes.ModuleName,
 		authTypes.ModuleName,
 		bankTypes.ModuleName,
 		govTypes.ModuleName,
 		chainmanagerTypes.ModuleName,
 		supplyTypes.ModuleName,
+		logregistryTypes.ModuleName,
 		stakingTypes.ModuleName,
 		slashingTypes.ModuleName,
 		checkpointTypes.ModuleName,
@@ -455,10 +524,25 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 	)
 	app.sm.RegisterStoreDecoders()
 
-	// mount the multistore and load the latest state
-	app.MountKVStores(keys)
+	// mount the multistore and load the latest state. The upgrade stores are left out of
+	// the blocks before their height.
+	latest, storesHeight := getLatestVersion(db), helper.GetUpgradeStoresHeight()
+	app.upgradeStoresMounted = mountsUpgradeStores(latest, storesHeight)
+
+	for name, key := range keys {
+		if !app.upgradeStoresMounted && isUpgradeStore(name) {
+			continue
+		}
+
+		app.MountStore(key, sdk.StoreTypeIAVL)
+	}
+
 	app.MountTransientStores(tkeys)
 
+	if app.upgradeStoresMounted {
+		addUpgradeStores(db, latest, storesHeight)
+	}
+
 	// perform initialization logic
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -467,6 +551,7 @@ func NewIrisApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		auth.NewAnteHandler(
 			app.AccountKeeper,
 			app.ChainKeeper,
+			app.UpgradeKeeper,
 			app.SupplyKeeper,
 			&app.caller,
 			auth.DefaultSigVerificationGasConsumer,
@@ -554,6 +639,13 @@ func (app *IrisApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 
 // BeginBlocker application updates every begin block
 func (app *IrisApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	// a node running since before the upgrade stores height mounts them on restart
+	if !app.upgradeStoresMounted && app.UpgradeKeeper.HasStores(ctx) {
+		msg := fmt.Sprintf("UPGRADE STORES NEEDED at height %d: restart the node to add them", ctx.BlockHeight())
+		logger.Error(msg)
+		panic(msg)
+	}
+
 	app.AccountKeeper.SetBlockProposer(
 		ctx,
 		types.BytesToIrisAddress(req.Header.GetProposerAddress()),
@@ -562,6 +654,17 @@ func (app *IrisApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) ab
 	return app.mm.BeginBlock(ctx, req)
 }
 
+// upgradeHeight returns the height of the plan of a built-in upgrade at the last committed block
+func (app *IrisApp) upgradeHeight(name string) int64 {
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	if plan, ok := app.UpgradeKeeper.GetPlan(ctx, name); ok {
+		return plan.Height
+	}
+
+	// the plans seeded at genesis are committed with the first block
+	return helper.GetUpgradeHeights()[name]
+}
+
 // EndBlocker executes on each end block
 func (app *IrisApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	// transfer fees to current proposer
@@ -603,7 +706,7 @@ func (app *IrisApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.R
 		}
 
 		//Hardfork to remove the rotation of validator list on stake update
-		if ctx.BlockHeight() < helper.GetAalborgHardForkHeight() {
+		if !app.UpgradeKeeper.IsUpgradeActive(ctx, helper.AalborgUpgrade) {
 			// increment proposer priority
 			currentValidatorSet.IncrementProposerPriority(1)
 		}
@@ -618,6 +721,19 @@ func (app *IrisApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.R
 			return abci.ResponseEndBlock{}
 		}
 
+		// record the diff in the validator set changelog
+		if app.UpgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.ValidatorSetChangelogUpgrade) {
+			var proposer types.IrisAddress
+			if p := currentValidatorSet.GetProposer(); p != nil {
+				proposer = p.Signer
+			}
+
+			diff := stakingTypes.NewValidatorSetDiff(ctx.BlockHeight(), setUpdates, proposer)
+			if err := app.StakingKeeper.AddValidatorSetDiff(ctx, diff); err != nil {
+				logger.Error("Unable to add validator set diff to changelog", "Error", err)
+			}
+		}
+
 		// convert updates from map to array
 		for _, v := range setUpdates {
 			tmValUpdates = append(tmValUpdates, abci.ValidatorUpdate{
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var sideTxResults = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "iris",
+	Subsystem: "sidechannel",
+	Name:      "side_tx_results_total",
+	Help:      "The total number of side-txs executed by the side blocker, by module and vote result (yes, no or skip)",
+}, []string{"module", "result"})
+
+// recordSideTxResult counts the result of a side-tx for the module routing its message
+func recordSideTxResult(tx sdk.Tx, sideTxResult abci.SideTxResultType) {
+	module := "unknown"
+	if msgs := tx.GetMsgs(); len(msgs) > 0 {
+		module = msgs[0].Route()
+	}
+
+	sideTxResults.WithLabelValues(module, strings.ToLower(sideTxResult.String())).Inc()
+}
@@ -8,8 +8,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"go.opentelemetry.io/otel/attribute"
 
 	authTypes "github.com/zenanetwork/iris/auth/types"
+	"github.com/zenanetwork/iris/common/tracing"
 	"github.com/zenanetwork/iris/types"
 )
 
@@ -151,6 +154,19 @@ func (app *IrisApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abci.Re
 		codespace string
 	)
 
+	// record the chain reads the vote is based on, if enabled
+	app.caller.Fixture.Begin(ctx.BlockHeight(), tmTypes.Tx(req.Tx).Hash())
+	defer func() { app.caller.Fixture.End(res.Result.String()) }()
+
+	_, span := startTxSpan(tx, "DeliverSideTx")
+	defer func() {
+		tracing.SetAttributes(span,
+			attribute.String("result", res.Result.String()),
+			attribute.Int64("code", int64(res.Code)),
+		)
+		tracing.EndSpan(span)
+	}()
+
 	result := abci.SideTxResultType_Skip
 	data := make([]byte, 0)
 
@@ -213,6 +229,17 @@ func (app *IrisApp) runTx(ctx sdk.Context, txBytes []byte, sideTxResult abci.Sid
 		return
 	}
 
+	recordSideTxResult(tx, sideTxResult)
+
+	_, span := startTxSpan(tx, "PostTxHandler")
+	defer func() {
+		tracing.SetAttributes(span,
+			attribute.String("sideTxResult", sideTxResult.String()),
+			attribute.Int64("code", int64(result.Code)),
+		)
+		tracing.EndSpan(span)
+	}()
+
 	// recover if runMsgs fails
 	defer func() {
 		if r := recover(); r != nil {
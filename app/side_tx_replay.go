@@ -0,0 +1,49 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	authTypes "github.com/zenanetwork/iris/auth/types"
+	"github.com/zenanetwork/iris/helper"
+)
+
+// ReplaySideTx runs the side handlers of the side-tx with hash txHash, included in the block of
+// header, against the state at that height and the chain reads recorded in fixture, without
+// writing any state.
+func (app *IrisApp) ReplaySideTx(header abci.Header, txHash []byte, fixture *helper.CallFixture) (res abci.ResponseDeliverSideTx, err error) {
+	if !fixture.Replaying() {
+		return res, errors.New("fixture is not loaded for replay")
+	}
+
+	height := header.Height
+
+	cms, err := app.GetCommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return res, fmt.Errorf("state at height %d is not available: %w", height, err)
+	}
+
+	// side-txs are voted on while their block is executed, with its header
+	ctx := sdk.NewContext(cms, header, false, app.Logger())
+
+	txBytes := app.SidechannelKeeper.GetTx(ctx, height, txHash)
+	if txBytes == nil {
+		return res, fmt.Errorf("side-tx %X not found at height %d", txHash, height)
+	}
+
+	tx, err := authTypes.DefaultTxDecoder(app.cdc)(txBytes)
+	if err != nil {
+		return res, err
+	}
+
+	// serve the contract caller reads from the fixture for the time of the replay
+	recorder := app.caller.Fixture
+	app.caller.Fixture = fixture
+
+	defer func() { app.caller.Fixture = recorder }()
+
+	return app.DeliverSideTxHandler(ctx.WithTxBytes(txBytes), tx, abci.RequestDeliverSideTx{Tx: txBytes}), nil
+}
@@ -0,0 +1,88 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/zenanetwork/iris/app"
+	authTypes "github.com/zenanetwork/iris/auth/types"
+	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
+	checkpointTypes "github.com/zenanetwork/iris/checkpoint/types"
+	"github.com/zenanetwork/iris/helper"
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+func TestReplaySideTx(t *testing.T) {
+	t.Parallel()
+
+	happ := app.Setup(false)
+	ctx := happ.NewContext(false, abci.Header{Height: 1})
+
+	// the checkpoint side handler reads the block time for the adaptive checkpoint bounds
+	params := happ.CheckpointKeeper.GetParams(ctx)
+	params.AdaptiveCheckpoint.Enabled = true
+	happ.CheckpointKeeper.SetParams(ctx, params)
+
+	lastCheckpointTime := time.Unix(1000000, 0)
+	lastCheckpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToIrisHash("123"), hmTypes.HexToIrisAddress("123"), helper.DefaultZenaChainID, uint64(lastCheckpointTime.Unix()))
+	require.NoError(t, happ.CheckpointKeeper.AddCheckpoint(ctx, 1, lastCheckpoint))
+	happ.CheckpointKeeper.UpdateACKCount(ctx)
+
+	// shorter than the min checkpoint length
+	msg := checkpointTypes.NewMsgCheckpointBlock(
+		hmTypes.HexToIrisAddress("123"),
+		256,
+		256+params.AdaptiveCheckpoint.MinCheckpointLength-2,
+		hmTypes.HexToIrisHash("456"),
+		hmTypes.HexToIrisHash("456"),
+		helper.DefaultZenaChainID,
+	)
+
+	txBytes, err := authTypes.DefaultTxEncoder(happ.Codec())(authTypes.NewStdTx(msg, authTypes.StdSignature{}, ""))
+	require.NoError(t, err)
+
+	txHash := tmTypes.Tx(txBytes).Hash()
+	happ.SidechannelKeeper.SetTx(ctx, 1, txBytes)
+	happ.Commit()
+
+	// the chain reads of the vote
+	dir := t.TempDir()
+	recorder, err := helper.NewRecordingCallFixture(dir)
+	require.NoError(t, err)
+
+	recorder.Begin(1, txHash)
+	require.NoError(t, recorder.Call("CheckIfBlocksExist", []interface{}{msg.EndBlock + chainmanagerTypes.DefaultMaticchainTxConfirmations}, new(bool), func() error { return nil }))
+	require.NoError(t, recorder.Call("GetRootHash", []interface{}{msg.StartBlock, msg.EndBlock, params.MaxCheckpointLength}, new([]byte), func() error { return nil }))
+	recorder.End(abci.SideTxResultType_Yes.String())
+
+	// answers the reads with the root hash of the msg
+	fixture, err := helper.LoadCallFixture(helper.GetCallFixtureFile(dir, txHash))
+	require.NoError(t, err)
+
+	fixture.Calls[0].Results = []byte("true")
+	fixture.Calls[1].Results, err = happ.Codec().MarshalJSON(msg.RootHash.Bytes())
+	require.NoError(t, err)
+
+	header := func(blockTime time.Time) abci.Header {
+		return abci.Header{Height: 1, Time: blockTime, ChainID: "iris-replay"}
+	}
+
+	// too short a target interval after the last checkpoint
+	res, err := happ.ReplaySideTx(header(lastCheckpointTime.Add(params.AdaptiveCheckpoint.TargetInterval)), txHash, fixture)
+	require.NoError(t, err)
+	require.Equal(t, abci.SideTxResultType_Skip, res.Result)
+
+	// accepted two target intervals after the last checkpoint, at the time of the block
+	res, err = happ.ReplaySideTx(header(lastCheckpointTime.Add(2*params.AdaptiveCheckpoint.TargetInterval)), txHash, fixture)
+	require.NoError(t, err)
+	require.Equal(t, abci.SideTxResultType_Yes, res.Result)
+	require.Equal(t, fixture.Result, res.Result.String())
+
+	// the side-tx is looked up at the height of the header
+	_, err = happ.ReplaySideTx(abci.Header{Height: 2}, txHash, fixture)
+	require.Error(t, err)
+}
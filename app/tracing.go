@@ -0,0 +1,37 @@
+package app
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	authTypes "github.com/zenanetwork/iris/auth/types"
+	"github.com/zenanetwork/iris/common/tracing"
+)
+
+// appTracerName is the name of the tracer of the side-tx handling
+const appTracerName = "iris-app"
+
+// startTxSpan starts a span for handling tx. The span continues the trace of the bridge
+// task which broadcast the tx, carried as a W3C traceparent in the tx memo.
+func startTxSpan(tx sdk.Tx, spanName string) (context.Context, trace.Span) {
+	ctx := context.Background()
+
+	if stdTx, ok := tx.(authTypes.StdTx); ok {
+		ctx = tracing.WithTraceParent(ctx, stdTx.GetMemo())
+	}
+
+	ctx, span := tracing.StartSpan(tracing.WithTracer(ctx, otel.Tracer(appTracerName)), spanName)
+
+	routes := make([]string, 0, len(tx.GetMsgs()))
+	for _, msg := range tx.GetMsgs() {
+		routes = append(routes, msg.Route()+"/"+msg.Type())
+	}
+
+	tracing.SetAttributes(span, attribute.StringSlice("msgs", routes))
+
+	return ctx, span
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	dbm "github.com/tendermint/tm-db"
+
+	logregistryTypes "github.com/zenanetwork/iris/logregistry/types"
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
+)
+
+// key of the latest version of the multistore in the application db
+const latestVersionKey = "s/latest"
+
+// upgradeStoreKeys are the stores added to the multistore at the upgrade stores height, past
+// genesis on a live network
+var upgradeStoreKeys = []string{
+	upgradeTypes.StoreKey,
+	logregistryTypes.StoreKey,
+}
+
+// getLatestVersion returns the latest version committed to the application db, as the
+// multistore reads it
+func getLatestVersion(db dbm.DB) int64 {
+	var latest int64
+
+	bz := db.Get([]byte(latestVersionKey))
+	if bz == nil {
+		return 0
+	}
+
+	codec.New().MustUnmarshalBinaryLengthPrefixed(bz, &latest)
+
+	return latest
+}
+
+// isUpgradeStore returns true if name is the key of an upgrade store
+func isUpgradeStore(name string) bool {
+	for _, key := range upgradeStoreKeys {
+		if key == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mountsUpgradeStores returns true if the upgrade stores, added at height, are mounted by a node
+// whose latest committed height is latest, i.e. if its next block is at or past height. Mounted
+// before, they would change the app hash of the blocks committed without them.
+func mountsUpgradeStores(latest int64, height int64) bool {
+	return height >= 0 && latest+1 >= height
+}
+
+// addUpgradeStores prepares the upgrade stores, added at height, of a node about to commit it.
+// Their trees start at the latest version with an empty root, as the tree saves it, so that
+// their versions follow the heights of the chain and the queries at a height find them.
+func addUpgradeStores(db dbm.DB, latest int64, height int64) {
+	if latest == 0 || latest+1 != height {
+		return
+	}
+
+	version := make([]byte, 8)
+	binary.BigEndian.PutUint64(version, uint64(latest))
+
+	for _, key := range upgradeStoreKeys {
+		db.SetSync(append([]byte(fmt.Sprintf("s/k:%s/r", key)), version...), []byte{})
+	}
+}
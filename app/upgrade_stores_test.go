@@ -0,0 +1,86 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
+)
+
+func TestMountsUpgradeStores(t *testing.T) {
+	t.Parallel()
+
+	tc := []struct {
+		latest int64
+		height int64
+		mounts bool
+	}{
+		{latest: 0, height: 0, mounts: true},
+		{latest: 10, height: 0, mounts: true},
+		{latest: 8, height: 10, mounts: false},
+		{latest: 9, height: 10, mounts: true},
+		{latest: 12, height: 10, mounts: true},
+		{latest: 12, height: -1, mounts: false},
+	}
+
+	for _, c := range tc {
+		require.Equal(t, c.mounts, mountsUpgradeStores(c.latest, c.height), "latest %d height %d", c.latest, c.height)
+	}
+}
+
+func TestAddUpgradeStores(t *testing.T) {
+	t.Parallel()
+
+	db := dbm.NewMemDB()
+	mainKey := sdk.NewKVStoreKey("main")
+	upgradeKey := sdk.NewKVStoreKey(upgradeTypes.StoreKey)
+
+	// a chain without the upgrade store up to height 3
+	rs := rootmulti.NewStore(db)
+	rs.MountStoreWithDB(mainKey, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	for i := 0; i < 3; i++ {
+		rs.GetKVStore(mainKey).Set([]byte{byte(i)}, []byte{byte(i)})
+		rs.Commit()
+	}
+
+	hash := rs.LastCommitID().Hash
+	require.Equal(t, int64(3), getLatestVersion(db))
+
+	// restarted before height 4, with the upgrade store
+	addUpgradeStores(db, getLatestVersion(db), 4)
+
+	rs = rootmulti.NewStore(db)
+	rs.MountStoreWithDB(mainKey, sdk.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(upgradeKey, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	// the committed blocks are unchanged
+	require.Equal(t, hash, rs.LastCommitID().Hash)
+
+	rs.GetKVStore(upgradeKey).Set([]byte("plan"), []byte("plan"))
+	id := rs.Commit()
+	require.Equal(t, int64(4), id.Version)
+
+	// the upgrade store is at the version of the chain, and queries at a height find it
+	require.Equal(t, int64(4), rs.GetCommitKVStore(upgradeKey).LastCommitID().Version)
+
+	cms, err := rs.CacheMultiStoreWithVersion(4)
+	require.NoError(t, err)
+	require.Equal(t, []byte("plan"), cms.GetKVStore(upgradeKey).Get([]byte("plan")))
+
+	// restarted past height 4, the upgrade store is loaded as committed
+	addUpgradeStores(db, getLatestVersion(db), 4)
+
+	rs = rootmulti.NewStore(db)
+	rs.MountStoreWithDB(mainKey, sdk.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(upgradeKey, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	require.Equal(t, id, rs.LastCommitID())
+	require.Equal(t, []byte("plan"), rs.GetKVStore(upgradeKey).Get([]byte("plan")))
+}
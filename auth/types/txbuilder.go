@@ -12,6 +12,8 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/zenanetwork/go-zenanet/crypto"
 	ethCrypto "github.com/zenanetwork/go-zenanet/crypto/secp256k1"
+
+	"github.com/zenanetwork/iris/signer"
 )
 
 // TxBuilder implements a transaction context created in SDK modules.
@@ -208,7 +210,12 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 
 // Sign transaction with default node key
 func (bldr TxBuilder) Sign(privKey secp256k1.PrivKeySecp256k1, msg StdSignMsg) ([]byte, error) {
-	sig, err := MakeSignature(privKey, msg)
+	return bldr.SignWithSigner(signer.NewLocalSigner(privKey), msg)
+}
+
+// SignWithSigner signs a transaction with the key held by the signer
+func (bldr TxBuilder) SignWithSigner(s signer.Signer, msg StdSignMsg) ([]byte, error) {
+	sig, err := MakeSignatureWithSigner(s, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -230,12 +237,18 @@ func (bldr TxBuilder) SignWithPassphrase(name, passphrase string, msg StdSignMsg
 // BuildAndSign builds a single message to be signed, and signs a transaction
 // with the built message given a set of messages.
 func (bldr TxBuilder) BuildAndSign(privKey secp256k1.PrivKeySecp256k1, msgs []sdk.Msg) ([]byte, error) {
+	return bldr.BuildAndSignWithSigner(signer.NewLocalSigner(privKey), msgs)
+}
+
+// BuildAndSignWithSigner builds a single message to be signed, and signs a transaction
+// with the built message with the key held by the signer.
+func (bldr TxBuilder) BuildAndSignWithSigner(s signer.Signer, msgs []sdk.Msg) ([]byte, error) {
 	stdMsg, err := bldr.BuildSignMsg(msgs)
 	if err != nil {
 		return nil, err
 	}
 
-	return bldr.Sign(privKey, stdMsg)
+	return bldr.SignWithSigner(s, stdMsg)
 }
 
 // BuildAndSignWithPassphrase builds a single message to be signed, and signs a transaction
@@ -289,6 +302,11 @@ func (bldr TxBuilder) SignStdTxWithPassphrase(name, passphrase string, stdTx Std
 // SignStdTx appends a signature to a StdTx and returns a copy of it. If append
 // is false, it replaces the signatures already attached with the new signature.
 func (bldr TxBuilder) SignStdTx(privKey secp256k1.PrivKeySecp256k1, stdTx StdTx, appendSig bool) (signedStdTx StdTx, err error) {
+	return bldr.SignStdTxWithSigner(signer.NewLocalSigner(privKey), stdTx, appendSig)
+}
+
+// SignStdTxWithSigner is SignStdTx with the key held by the signer
+func (bldr TxBuilder) SignStdTxWithSigner(s signer.Signer, stdTx StdTx, appendSig bool) (signedStdTx StdTx, err error) {
 	if bldr.chainID == "" {
 		return StdTx{}, fmt.Errorf("chain ID required but not specified")
 	}
@@ -301,7 +319,7 @@ func (bldr TxBuilder) SignStdTx(privKey secp256k1.PrivKeySecp256k1, stdTx StdTx,
 		Msg:           stdTx.Msg, // allow only one message
 	}
 
-	sig, err := MakeSignature(privKey, signMsg)
+	sig, err := MakeSignatureWithSigner(s, signMsg)
 	if err != nil {
 		return
 	}
@@ -322,6 +340,11 @@ func MakeSignature(privKey secp256k1.PrivKeySecp256k1, msg StdSignMsg) (sig StdS
 	return ethCrypto.Sign(data, privKey[:])
 }
 
+// MakeSignatureWithSigner builds a StdSignature for given a StdSignMsg with the key held by the signer.
+func MakeSignatureWithSigner(s signer.Signer, msg StdSignMsg) (sig StdSignature, err error) {
+	return signer.SignBytes(s, msg.Bytes(), signer.KindStdSignMsg)
+}
+
 // RecoverPubkey builds a StdSignature for given a StdSignMsg.
 func RecoverPubkey(msg []byte, sig []byte) ([]byte, error) {
 	data := crypto.Keccak256(msg)
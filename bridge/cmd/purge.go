@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/streadway/amqp"
 	"github.com/zenanetwork/iris/bridge/setu/queue"
+	"github.com/zenanetwork/iris/bridge/setu/util"
 	"github.com/zenanetwork/iris/helper"
 )
 
@@ -18,6 +20,18 @@ var purgeCmd = &cobra.Command{
 }
 
 func purgeQueue() {
+	if helper.GetConfig().QueueBackend == helper.QueueBackendEmbedded {
+		// embedded queue tasks are journaled in the bridge db
+		db := util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag))
+		defer util.CloseBridgeDBInstance()
+
+		if err := queue.PurgeEmbeddedJournal(db); err != nil {
+			logger.Error("purgeQueue | PurgeEmbeddedJournal", "Error", err)
+		}
+
+		return
+	}
+
 	dialer := helper.GetConfig().AmqpURL
 
 	// amqp dialer
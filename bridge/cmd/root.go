@@ -3,16 +3,12 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"net/http"
-	"os"
 	"path/filepath"
 	"time"
 
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	tendermintLogger "github.com/tendermint/tendermint/libs/log"
-	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
 
 	"github.com/zenanetwork/iris/helper"
 	"github.com/zenanetwork/iris/version"
@@ -24,11 +20,7 @@ const (
 	logsTypeFlag    = "logs-type"
 )
 
-var (
-	logger = helper.Logger.With("module", "bridge/cmd/")
-
-	metricsServer http.Server
-)
+var logger = helper.Logger.With("module", "bridge/cmd/")
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -39,16 +31,18 @@ var rootCmd = &cobra.Command{
 		if cmd.Use != version.Cmd.Use {
 			// initialize tendermint viper config
 			initTendermintViperConfig(cmd)
+		}
 
-			// init metrics server
-			initMetrics()
+		// init metrics server, only for the bridge itself as the other commands run along with it
+		if cmd.Name() == startCmdName {
+			helper.StartMetricsServer()
 		}
 	},
 	PostRunE: func(cmd *cobra.Command, args []string) error {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 		defer cancel()
 
-		return metricsServer.Shutdown(ctx)
+		return helper.StopMetricsServer(ctx)
 	},
 }
 
@@ -102,28 +96,6 @@ func DecorateWithBridgeRootFlags(cmd *cobra.Command, v *viper.Viper, loggerInsta
 	}
 }
 
-// initMetrics initializes metrics server with the default handler
-func initMetrics() {
-	cfg := rpcserver.DefaultConfig()
-
-	metricsServer = http.Server{
-		Addr:              ":2112",
-		ReadTimeout:       cfg.ReadTimeout,
-		ReadHeaderTimeout: cfg.ReadTimeout,
-		WriteTimeout:      cfg.WriteTimeout,
-		MaxHeaderBytes:    cfg.MaxHeaderBytes,
-	}
-
-	http.Handle("/metrics", promhttp.Handler())
-
-	go func() {
-		if err := metricsServer.ListenAndServe(); err != nil {
-			logger.Error("failed to start metrics server", "error", err)
-			os.Exit(1)
-		}
-	}()
-}
-
 // function is called to set appropriate bridge db path
 func AdjustBridgeDBValue(cmd *cobra.Command, v *viper.Viper) {
 	tendermintNode, _ := cmd.Flags().GetString(helper.TendermintNodeFlag)
@@ -15,10 +15,12 @@ import (
 	"github.com/tendermint/tendermint/libs/common"
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/zenanetwork/iris/app"
+	"github.com/zenanetwork/iris/bridge/setu/admin"
 	"github.com/zenanetwork/iris/bridge/setu/broadcaster"
 	"github.com/zenanetwork/iris/bridge/setu/listener"
 	"github.com/zenanetwork/iris/bridge/setu/processor"
 	"github.com/zenanetwork/iris/bridge/setu/queue"
+	"github.com/zenanetwork/iris/bridge/setu/txmgr"
 	"github.com/zenanetwork/iris/bridge/setu/util"
 	"golang.org/x/sync/errgroup"
 
@@ -27,6 +29,8 @@ import (
 )
 
 const (
+	startCmdName = "start"
+
 	waitDuration = 1 * time.Minute
 )
 
@@ -36,17 +40,20 @@ func StartBridgeWithCtx(shutdownCtx context.Context) error {
 	// create codec
 	cdc := app.MakeCodec()
 	// queue connector & http client
-	_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+	_queueConnector := queue.NewQueueConnectorFromConfig(util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag)))
 	_queueConnector.StartWorker()
 
 	_txBroadcaster := broadcaster.NewTxBroadcaster(cdc)
+	_txManager := txmgr.NewManager(util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag)))
 	_httpClient := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
 
 	// selected services to start
 	services := []common.Service{}
 	services = append(services,
 		listener.NewListenerService(cdc, _queueConnector, _httpClient),
-		processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster),
+		processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster, _txManager),
+		_txManager,
+		admin.NewServer(_queueConnector, util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag))),
 	)
 
 	// Start http client
@@ -135,17 +142,20 @@ func StartBridge(isStandAlone bool) {
 	// create codec
 	cdc := app.MakeCodec()
 	// queue connector & http client
-	_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+	_queueConnector := queue.NewQueueConnectorFromConfig(util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag)))
 	_queueConnector.StartWorker()
 
 	_txBroadcaster := broadcaster.NewTxBroadcaster(cdc)
+	_txManager := txmgr.NewManager(util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag)))
 	_httpClient := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
 
 	// selected services to start
 	services := []common.Service{}
 	services = append(services,
 		listener.NewListenerService(cdc, _queueConnector, _httpClient),
-		processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster),
+		processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster, _txManager),
+		_txManager,
+		admin.NewServer(_queueConnector, util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag))),
 	)
 
 	// sync group
@@ -226,7 +236,7 @@ func StartBridge(isStandAlone bool) {
 // GetStartCmd returns the start command to start bridge
 func GetStartCmd() *cobra.Command {
 	startCmd := &cobra.Command{
-		Use:   "start",
+		Use:   startCmdName,
 		Short: "Start bridge server",
 		Run: func(cmd *cobra.Command, args []string) {
 			StartBridge(true)
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zenanetwork/iris/bridge/setu/admin"
+	"github.com/zenanetwork/iris/bridge/setu/queue"
+	"github.com/zenanetwork/iris/helper"
+)
+
+const taskStateFlag = "state"
+
+// tasksCmd operates the tasks of a running bridge through its admin API
+var tasksCmd = &cobra.Command{
+	Use:   "tasks",
+	Short: "Inspect, retry and drop the tasks of the running bridge",
+}
+
+var listTasksCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the pending, delayed, failed or dead-letter tasks with their event payloads",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		state, _ := cmd.Flags().GetString(taskStateFlag)
+
+		result, err := adminClient().ListTasks(state)
+		if err != nil {
+			return err
+		}
+
+		return printJSON(cmd, result)
+	},
+}
+
+var lastBlocksCmd = &cobra.Command{
+	Use:   "blocks",
+	Short: "Show the last block processed by each listener",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		result, err := adminClient().LastBlocks()
+		if err != nil {
+			return err
+		}
+
+		return printJSON(cmd, result)
+	},
+}
+
+var retryTaskCmd = &cobra.Command{
+	Use:   "retry [uuid]",
+	Short: "Enqueue a failed, dead-letter or queued task to run now",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		result, err := adminClient().RetryTask(args[0])
+		if err != nil {
+			return err
+		}
+
+		return printJSON(cmd, result)
+	},
+}
+
+var deadLetterTaskCmd = &cobra.Command{
+	Use:   "dead-letter [uuid]",
+	Short: "Move a failed or queued task to the dead-letter store, so that it is not run unless retried",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		result, err := adminClient().DeadLetterTask(args[0])
+		if err != nil {
+			return err
+		}
+
+		return printJSON(cmd, result)
+	},
+}
+
+var skipLogCmd = &cobra.Command{
+	Use:   "skip [tx-hash] [log-index]",
+	Short: "Skip an L1 log, whether its task is queued or the log is seen again",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logIndex, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid log index %q: %w", args[1], err)
+		}
+
+		result, err := adminClient().SkipLog(args[0], logIndex)
+		if err != nil {
+			return err
+		}
+
+		return printJSON(cmd, result)
+	},
+}
+
+func adminClient() *admin.Client {
+	return admin.NewClient(helper.GetConfig().BridgeAdminAddr)
+}
+
+func printJSON(cmd *cobra.Command, v interface{}) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(out))
+
+	return err
+}
+
+func init() {
+	listTasksCmd.Flags().String(
+		taskStateFlag,
+		queue.TaskFailed,
+		fmt.Sprintf("State of the tasks (%s, %s, %s or %s)", queue.TaskPending, queue.TaskDelayed, queue.TaskFailed, queue.TaskDeadLetter),
+	)
+
+	tasksCmd.AddCommand(listTasksCmd, lastBlocksCmd, retryTaskCmd, deadLetterTaskCmd, skipLogCmd)
+	rootCmd.AddCommand(tasksCmd)
+}
@@ -0,0 +1,107 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/zenanetwork/iris/bridge/setu/queue"
+	"github.com/zenanetwork/iris/helper"
+)
+
+// SkippedLog is an L1 log skipped by the bridge
+type SkippedLog struct {
+	TxHash   string `json:"tx_hash"`
+	LogIndex uint64 `json:"log_index"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Client calls the admin API of a running bridge
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a client of the bridge admin API served on addr
+func NewClient(addr string) *Client {
+	return &Client{
+		baseURL:    "http://" + addr,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// ListTasks returns the tasks in the given state
+func (c *Client) ListTasks(state string) ([]*queue.TaskInfo, error) {
+	var result []*queue.TaskInfo
+
+	err := c.do(http.MethodGet, "/tasks?state="+url.QueryEscape(state), &result)
+
+	return result, err
+}
+
+// RetryTask enqueues a failed, dead-letter or queued task to run now
+func (c *Client) RetryTask(uuid string) (*queue.TaskInfo, error) {
+	result := new(queue.TaskInfo)
+
+	return result, c.do(http.MethodPost, fmt.Sprintf("/tasks/%s/retry", url.PathEscape(uuid)), result)
+}
+
+// DeadLetterTask moves a failed or queued task to the dead-letter store
+func (c *Client) DeadLetterTask(uuid string) (*queue.TaskInfo, error) {
+	result := new(queue.TaskInfo)
+
+	return result, c.do(http.MethodPost, fmt.Sprintf("/tasks/%s/dead-letter", url.PathEscape(uuid)), result)
+}
+
+// LastBlocks returns the last block processed by each listener
+func (c *Client) LastBlocks() (map[string]uint64, error) {
+	var result map[string]uint64
+
+	err := c.do(http.MethodGet, "/listeners/blocks", &result)
+
+	return result, err
+}
+
+// SkipLog makes the bridge skip an L1 log
+func (c *Client) SkipLog(txHash string, logIndex uint64) (*SkippedLog, error) {
+	result := new(SkippedLog)
+
+	return result, c.do(http.MethodPost, fmt.Sprintf("/logs/%s/%d/skip", url.PathEscape(txHash), logIndex), result)
+}
+
+func (c *Client) do(method string, path string, result interface{}) error {
+	request, err := http.NewRequest(method, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	// Limit the number of bytes read from the response body
+	body, err := io.ReadAll(http.MaxBytesReader(nil, response.Body, helper.APIBodyLimit))
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		var errResponse errorResponse
+		if err = json.Unmarshal(body, &errResponse); err != nil || errResponse.Error == "" {
+			return fmt.Errorf("bridge admin API returned %s", response.Status)
+		}
+
+		return errors.New(errResponse.Error)
+	}
+
+	return json.Unmarshal(body, result)
+}
@@ -0,0 +1,198 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/tendermint/tendermint/libs/common"
+
+	"github.com/zenanetwork/iris/bridge/setu/listener"
+	"github.com/zenanetwork/iris/bridge/setu/queue"
+	"github.com/zenanetwork/iris/bridge/setu/util"
+	"github.com/zenanetwork/iris/helper"
+)
+
+const (
+	adminServiceStr = "admin-service"
+
+	shutdownTimeout = 10 * time.Second
+)
+
+// Server serves the bridge admin API, to inspect, retry and drop the tasks of a running bridge
+type Server struct {
+	// Base service
+	common.BaseService
+
+	// queue connector
+	queueConnector *queue.QueueConnector
+
+	// storage client
+	storageClient *leveldb.DB
+
+	httpServer *http.Server
+}
+
+// NewServer returns the admin API server of the bridge
+func NewServer(queueConnector *queue.QueueConnector, storageClient *leveldb.DB) *Server {
+	logger := util.Logger().With("module", adminServiceStr)
+
+	server := &Server{
+		queueConnector: queueConnector,
+		storageClient:  storageClient,
+	}
+
+	server.BaseService = *common.NewBaseService(logger, adminServiceStr, server)
+	server.httpServer = &http.Server{
+		Addr:              helper.GetConfig().BridgeAdminAddr,
+		Handler:           server.router(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return server
+}
+
+// OnStart starts serving the admin API
+func (s *Server) OnStart() error {
+	if err := s.BaseService.OnStart(); err != nil {
+		s.Logger.Error("OnStart | OnStart", "Error", err)
+	} // Always call the overridden method.
+
+	ln, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Info("Starting bridge admin server", "address", s.httpServer.Addr)
+
+	go func() {
+		if err := s.httpServer.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.Logger.Error("Bridge admin server stopped", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// OnStop gracefully shuts down the admin API
+func (s *Server) OnStop() {
+	s.BaseService.OnStop() // Always call the overridden method.
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		s.Logger.Error("OnStop | Shutdown", "Error", err)
+	}
+}
+
+func (s *Server) router() http.Handler {
+	r := mux.NewRouter()
+
+	r.HandleFunc("/tasks", s.listTasksHandler).Methods(http.MethodGet)
+	r.HandleFunc("/tasks/{uuid}/retry", s.retryTaskHandler).Methods(http.MethodPost)
+	r.HandleFunc("/tasks/{uuid}/dead-letter", s.deadLetterTaskHandler).Methods(http.MethodPost)
+	r.HandleFunc("/listeners/blocks", s.lastBlocksHandler).Methods(http.MethodGet)
+	r.HandleFunc("/logs/{txHash}/{logIndex}/skip", s.skipLogHandler).Methods(http.MethodPost)
+
+	return r
+}
+
+// listTasksHandler lists the tasks in the state of the `state` query param
+func (s *Server) listTasksHandler(w http.ResponseWriter, r *http.Request) {
+	state := r.URL.Query().Get("state")
+	if state == "" {
+		writeError(w, http.StatusBadRequest, errors.New("state is required"))
+		return
+	}
+
+	result, err := s.queueConnector.ListTasks(state)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(w, result)
+}
+
+// retryTaskHandler enqueues a failed, dead-letter or queued task to run now
+func (s *Server) retryTaskHandler(w http.ResponseWriter, r *http.Request) {
+	task, err := s.queueConnector.RetryTask(mux.Vars(r)["uuid"])
+	if err != nil {
+		writeError(w, errorStatus(err), err)
+		return
+	}
+
+	writeJSON(w, task)
+}
+
+// deadLetterTaskHandler moves a failed or queued task to the dead-letter store
+func (s *Server) deadLetterTaskHandler(w http.ResponseWriter, r *http.Request) {
+	task, err := s.queueConnector.DeadLetterTask(mux.Vars(r)["uuid"])
+	if err != nil {
+		writeError(w, errorStatus(err), err)
+		return
+	}
+
+	writeJSON(w, task)
+}
+
+// lastBlocksHandler returns the last block processed by each listener
+func (s *Server) lastBlocksHandler(w http.ResponseWriter, _ *http.Request) {
+	blocks, err := listener.LastBlocks(s.storageClient)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, blocks)
+}
+
+// skipLogHandler makes the bridge skip an L1 log, whether it is queued or seen again
+func (s *Server) skipLogHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	logIndex, err := strconv.ParseUint(vars["logIndex"], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = util.SkipLog(s.storageClient, vars["txHash"], logIndex); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	s.Logger.Info("Skipping log", "txHash", vars["txHash"], "logIndex", logIndex)
+
+	writeJSON(w, SkippedLog{TxHash: vars["txHash"], LogIndex: logIndex})
+}
+
+func errorStatus(err error) int {
+	if errors.Is(err, queue.ErrTaskNotFound) {
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		util.Logger().Error("Error writing admin response", "error", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: err.Error()})
+}
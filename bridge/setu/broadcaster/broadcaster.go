@@ -2,7 +2,11 @@ package broadcaster
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -10,11 +14,14 @@ import (
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	jsoniter "github.com/json-iterator/go"
 	zena "github.com/zenanetwork/go-zenanet"
 	"github.com/zenanetwork/go-zenanet/core/types"
+	"go.opentelemetry.io/otel/attribute"
 
 	authTypes "github.com/zenanetwork/iris/auth/types"
 	"github.com/zenanetwork/iris/bridge/setu/util"
+	"github.com/zenanetwork/iris/common/tracing"
 	"github.com/zenanetwork/iris/helper"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -22,7 +29,12 @@ import (
 	hmTypes "github.com/zenanetwork/iris/types"
 )
 
-// TxBroadcaster uses to broadcast transaction to each chain
+// unconfirmedTxsLimitQuery asks for the max number of mempool txs tendermint returns. The endpoint
+// has no paging, so the txs past the limit are only known by the total it reports.
+const unconfirmedTxsLimitQuery = "?limit=100"
+
+// TxBroadcaster uses to broadcast transaction to each chain. Msgs are sent to iris one per tx, as a
+// StdTx carries a single msg and the side txs are voted on by the hash of their tx.
 type TxBroadcaster struct {
 	logger log.Logger
 
@@ -59,6 +71,15 @@ func NewTxBroadcaster(cdc *codec.Codec) *TxBroadcaster {
 
 // BroadcastToIris broadcast to iris
 func (tb *TxBroadcaster) BroadcastToIris(msg sdk.Msg, event interface{}, testOpts ...*helper.TestOpts) (sdk.TxResponse, error) {
+	return tb.BroadcastToIrisWithContext(context.Background(), msg, event, testOpts...)
+}
+
+// BroadcastToIrisWithContext broadcast to iris, within the trace of ctx. The trace context
+// is carried in the tx memo, so that the node continues the trace when handling the tx.
+func (tb *TxBroadcaster) BroadcastToIrisWithContext(ctx context.Context, msg sdk.Msg, event interface{}, testOpts ...*helper.TestOpts) (sdk.TxResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "BroadcastToIris")
+	defer tracing.EndSpan(span)
+
 	tb.irisMutex.Lock()
 	defer tb.irisMutex.Unlock()
 	defer util.LogElapsedTimeForStateSyncedEvent(event, "BroadcastToIris", time.Now())
@@ -75,28 +96,26 @@ func (tb *TxBroadcaster) BroadcastToIris(msg sdk.Msg, event interface{}, testOpt
 		WithSequence(tb.lastSeqNo).
 		WithChainID(chainID)
 
+	if traceParent := tracing.TraceParent(ctx); traceParent != "" {
+		txBldr = txBldr.WithMemo(traceParent)
+	}
+
 	txResponse, err := helper.BuildAndBroadcastMsgs(tb.CliCtx, txBldr, []sdk.Msg{msg}, testOpts...)
 	if err != nil || txResponse.Code != uint32(sdk.CodeOK) {
 		tb.logger.Error("Error while broadcasting the iris transaction", "error", err, "txResponse", txResponse.Code)
+		broadcastErrors.WithLabelValues(irisChain).Inc()
 
-		// current address
-		address := hmTypes.BytesToIrisAddress(helper.GetAddress())
-
-		// fetch from APIs
-		account, errAcc := util.GetAccount(tb.CliCtx, address)
-		if errAcc != nil {
-			tb.logger.Error("Error fetching account from rest-api", "url", helper.GetIrisServerEndpoint(fmt.Sprintf(util.AccountDetailsURL, helper.GetAddress())))
-			return txResponse, errAcc
+		if errSeq := tb.resyncSequence(); errSeq != nil {
+			return txResponse, errSeq
 		}
 
-		// update seqNo for safety
-		tb.lastSeqNo = account.GetSequence()
-
 		return txResponse, err
 	}
 
 	txHash := txResponse.TxHash
 
+	tracing.SetAttributes(span, attribute.String("txHash", txHash))
+
 	tb.logger.Info("Tx sent on iris", "txHash", txHash, "accSeq", tb.lastSeqNo, "accNum", tb.accNum)
 	tb.logger.Debug("Tx successful on iris", "txResponse", txResponse)
 	// increment account sequence
@@ -105,6 +124,115 @@ func (tb *TxBroadcaster) BroadcastToIris(msg sdk.Msg, event interface{}, testOpt
 	return txResponse, nil
 }
 
+// resyncSequence resets the sequence of the next tx to the account sequence, plus the txs of
+// the account still in the mempool, which CheckTx already counts. It must be called with irisMutex held.
+func (tb *TxBroadcaster) resyncSequence() error {
+	// current address
+	address := hmTypes.BytesToIrisAddress(helper.GetAddress())
+
+	// fetch from APIs
+	account, err := util.GetAccount(tb.CliCtx, address)
+	if err != nil {
+		tb.logger.Error("Error fetching account from rest-api", "url", helper.GetIrisServerEndpoint(fmt.Sprintf(util.AccountDetailsURL, helper.GetAddress())))
+		return err
+	}
+
+	seqNo := account.GetSequence()
+
+	// txs are only checked against the mempool when broadcast for real
+	if !tb.CliCtx.Simulate {
+		mempoolTxs, unseenTxs := tb.countMempoolTxs(sdk.AccAddress(helper.GetAddress()))
+		seqNo = nextSequence(seqNo, tb.lastSeqNo, mempoolTxs, unseenTxs)
+	}
+
+	if seqNo != tb.lastSeqNo {
+		tb.logger.Info("Account sequence out of sync, resetting it", "accSeq", tb.lastSeqNo, "chainAccSeq", account.GetSequence(), "nextAccSeq", seqNo)
+		broadcastSequenceMismatches.Inc()
+	}
+
+	// update seqNo for safety
+	tb.lastSeqNo = seqNo
+
+	return nil
+}
+
+// nextSequence returns the sequence of the next tx, from the account sequence and the txs of the
+// account in the mempool, of which unseenTxs more txs may be. Within the sequences the unseen txs
+// allow, the last sequence is kept.
+func nextSequence(accSeq uint64, lastSeqNo uint64, mempoolTxs uint64, unseenTxs uint64) uint64 {
+	seqNo := accSeq + mempoolTxs
+
+	switch {
+	case lastSeqNo < seqNo:
+		return seqNo
+	case lastSeqNo > seqNo+unseenTxs:
+		return seqNo + unseenTxs
+	default:
+		return lastSeqNo
+	}
+}
+
+// countMempoolTxs counts the txs signed by address in the mempool of the node, and the mempool
+// txs past the limit of the endpoint, which were not seen. On failure, it logs the error and
+// counts no tx.
+func (tb *TxBroadcaster) countMempoolTxs(address sdk.AccAddress) (count uint64, unseen uint64) {
+	endpoint := helper.GetConfig().TendermintRPCUrl + util.TendermintUnconfirmedTxsURL + unconfirmedTxsLimitQuery
+
+	resp, err := helper.Client.Get(endpoint)
+	if err != nil {
+		tb.logger.Error("Error fetching mempool txs", "url", endpoint, "error", err)
+		return 0, 0
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		tb.logger.Error("Error fetching mempool txs", "url", endpoint, "status", resp.Status)
+		return 0, 0
+	}
+
+	// Limit the number of bytes read from the response body
+	body, err := io.ReadAll(http.MaxBytesReader(nil, resp.Body, helper.APIBodyLimit))
+	if err != nil {
+		tb.logger.Error("Error reading response body for mempool txs", "error", err)
+		return 0, 0
+	}
+
+	var response util.TendermintUnconfirmedTxs
+	if err = jsoniter.ConfigFastest.Unmarshal(body, &response); err != nil {
+		tb.logger.Error("Error unmarshalling mempool txs", "error", err)
+		return 0, 0
+	}
+
+	if total, err := strconv.ParseUint(response.Result.Total, 10, 64); err == nil && total > uint64(len(response.Result.Txs)) {
+		unseen = total - uint64(len(response.Result.Txs))
+		tb.logger.Debug("Mempool txs past the limit of the endpoint", "total", total, "unseen", unseen)
+	}
+
+	txDecoder := helper.GetTxDecoder(tb.CliCtx.Codec)
+
+	for _, txn := range response.Result.Txs {
+		// Tendermint encodes the transactions with base64 encoding. Decode it first.
+		txBytes, err := base64.StdEncoding.DecodeString(txn)
+		if err != nil {
+			continue
+		}
+
+		decodedTx, err := txDecoder(txBytes)
+		if err != nil {
+			continue
+		}
+
+		for _, msg := range decodedTx.GetMsgs() {
+			if signers := msg.GetSigners(); len(signers) > 0 && signers[0].Equals(address) {
+				count++
+				break
+			}
+		}
+	}
+
+	return count, unseen
+}
+
 // BroadcastToMatic broadcast to matic
 func (tb *TxBroadcaster) BroadcastToMatic(msg zena.CallMsg) error {
 	tb.maticMutex.Lock()
@@ -118,6 +246,8 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg zena.CallMsg) error {
 
 	if err != nil {
 		tb.logger.Error("Error generating auth object", "error", err)
+		broadcastErrors.WithLabelValues(maticChain).Inc()
+
 		return err
 	}
 
@@ -128,6 +258,8 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg zena.CallMsg) error {
 	signedTx, err := auth.Signer(auth.From, rawTx)
 	if err != nil {
 		tb.logger.Error("Error signing the transaction", "error", err)
+		broadcastErrors.WithLabelValues(maticChain).Inc()
+
 		return err
 	}
 
@@ -140,6 +272,8 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg zena.CallMsg) error {
 	// broadcast transaction
 	if err := maticClient.SendTransaction(ctx, signedTx); err != nil {
 		tb.logger.Error("Error while broadcasting the transaction to maticchain", "error", err)
+		broadcastErrors.WithLabelValues(maticChain).Inc()
+
 		return err
 	}
 
@@ -300,3 +300,16 @@ func (r *resettableReadCloser) Close() error {
 	r.r = bytes.NewReader(r.data)
 	return nil
 }
+
+func TestNextSequence(t *testing.T) {
+	t.Parallel()
+
+	// all the mempool txs were seen
+	require.Equal(t, uint64(12), nextSequence(10, 15, 2, 0))
+	require.Equal(t, uint64(12), nextSequence(10, 11, 2, 0))
+
+	// up to 5 more txs of the account may be past the limit of the endpoint
+	require.Equal(t, uint64(14), nextSequence(10, 14, 2, 5))
+	require.Equal(t, uint64(17), nextSequence(10, 20, 2, 5))
+	require.Equal(t, uint64(12), nextSequence(10, 11, 2, 5))
+}
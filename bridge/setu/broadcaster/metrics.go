@@ -0,0 +1,28 @@
+package broadcaster
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Chains the broadcaster sends transactions to
+const (
+	irisChain  = "iris"
+	maticChain = "matic"
+)
+
+var (
+	broadcastErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "iris",
+		Subsystem: "bridge_broadcaster",
+		Name:      "errors_total",
+		Help:      "The total number of transactions the broadcaster failed to send, by chain",
+	}, []string{"chain"})
+
+	broadcastSequenceMismatches = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "iris",
+		Subsystem: "bridge_broadcaster",
+		Name:      "sequence_mismatches_total",
+		Help:      "The total number of times the account sequence of the broadcaster was found out of sync with iris",
+	})
+)
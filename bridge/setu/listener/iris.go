@@ -79,6 +79,9 @@ func (hl *IrisListener) StartPolling(ctx context.Context, pollInterval time.Dura
 			if err != nil {
 				hl.Logger.Error("Error fetching from and toBlock, skipping events query", "fromBlock", fromBlock, "toBlock", toBlock, "error", err)
 			} else if fromBlock < toBlock {
+				if fromBlock > 0 {
+					hl.recordPolledBlock(toBlock, fromBlock-1)
+				}
 
 				hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
 
@@ -138,6 +141,8 @@ func (hl *IrisListener) StartPolling(ctx context.Context, pollInterval time.Dura
 				if err := hl.storageClient.Put([]byte(irisLastBlockKey), []byte(strconv.FormatUint(toBlock, 10)), nil); err != nil {
 					hl.Logger.Error("hl.storageClient.Put", "Error", err)
 				}
+
+				hl.recordPolledBlock(toBlock, toBlock)
 			}
 
 		case <-ctx.Done():
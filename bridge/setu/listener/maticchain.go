@@ -54,6 +54,9 @@ func (ml *MaticChainListener) ProcessHeader(newHeader *blockHeader) {
 	}
 
 	ml.sendTaskWithDelay("sendCheckpointToIris", headerBytes, 0)
+
+	// the checkpointer takes the latest block as is
+	ml.recordPolledBlock(newHeader.header.Number.Uint64(), newHeader.header.Number.Uint64())
 }
 
 func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []byte, delay time.Duration) {
@@ -0,0 +1,42 @@
+package listener
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	listenerHeadBlock = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "iris",
+		Subsystem: "bridge_listener",
+		Name:      "head_block",
+		Help:      "The latest block seen by the listener on its chain",
+	}, []string{"listener"})
+
+	listenerPolledBlock = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "iris",
+		Subsystem: "bridge_listener",
+		Name:      "last_polled_block",
+		Help:      "The last block the listener processed the events of",
+	}, []string{"listener"})
+
+	listenerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "iris",
+		Subsystem: "bridge_listener",
+		Name:      "lag_blocks",
+		Help:      "The number of blocks the listener is behind the head of its chain",
+	}, []string{"listener"})
+)
+
+// recordPolledBlock records the head of the chain and the last block processed by the listener
+func (bl *BaseListener) recordPolledBlock(head uint64, polled uint64) {
+	listenerHeadBlock.WithLabelValues(bl.name).Set(float64(head))
+	listenerPolledBlock.WithLabelValues(bl.name).Set(float64(polled))
+
+	var lag uint64
+	if head > polled {
+		lag = head - polled
+	}
+
+	listenerLag.WithLabelValues(bl.name).Set(float64(lag))
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/zenanetwork/iris/bridge/setu/util"
 	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
+	"github.com/zenanetwork/iris/common/tracing"
 	"github.com/zenanetwork/iris/helper"
 )
 
@@ -31,8 +32,9 @@ type RootChainListener struct {
 	stakingInfoAbi *abi.ABI
 	stateSenderAbi *abi.ABI
 
-	// For self-heal, Will be only initialised if sub_graph_url is provided
-	subGraphClient *subGraphClient
+	// For self-heal, the sub graph if sub_graph_url is provided, the L1 logs otherwise
+	selfHealBackend selfHealBackend
+	logScanner      *logScanner
 }
 
 const (
@@ -97,6 +99,7 @@ func (rl *RootChainListener) ProcessHeader(newHeader *blockHeader) {
 	}
 
 	requiredConfirmations := rootchainContext.ChainmanagerParams.MainchainTxConfirmations
+	head := newHeader.header.Number.Uint64()
 	headerNumber := newHeader.header.Number
 	from := headerNumber
 
@@ -135,6 +138,7 @@ func (rl *RootChainListener) ProcessHeader(newHeader *blockHeader) {
 		//nolint:gosec
 		if result, e := strconv.ParseUint(string(lastBlockBytes), 10, 64); e == nil {
 			if result >= headerNumber.Uint64() {
+				rl.recordPolledBlock(head, result)
 				return
 			}
 
@@ -154,6 +158,8 @@ func (rl *RootChainListener) ProcessHeader(newHeader *blockHeader) {
 		rl.Logger.Error("rl.storageClient.Put", "Error", err)
 	}
 
+	rl.recordPolledBlock(head, to.Uint64())
+
 	// Handle events
 	rl.queryAndBroadcastEvents(rootchainContext, from, to)
 }
@@ -199,11 +205,16 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 	}
 }
 
-func (rl *RootChainListener) SendTaskWithDelay(taskName string, eventName string, logBytes []byte, delay time.Duration, event interface{}) {
+// SendTaskWithDelay sends the task processing an event log, carrying the trace context of ctx
+func (rl *RootChainListener) SendTaskWithDelay(ctx context.Context, taskName string, eventName string, logBytes []byte, delay time.Duration, event interface{}) {
 	defer util.LogElapsedTimeForStateSyncedEvent(event, "SendTaskWithDelay", time.Now())
 
+	headers := tasks.Headers{}
+	tracing.Inject(ctx, util.TaskHeadersCarrier(headers))
+
 	signature := &tasks.Signature{
-		Name: taskName,
+		Name:    taskName,
+		Headers: headers,
 		Args: []tasks.Arg{
 			{
 				Type:  "string",
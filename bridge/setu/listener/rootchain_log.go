@@ -2,13 +2,18 @@ package listener
 
 import (
 	"bytes"
+	"context"
+	"strconv"
 
 	jsoniter "github.com/json-iterator/go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/zenanetwork/go-zenanet/accounts/abi"
 	"github.com/zenanetwork/go-zenanet/core/types"
 
 	"github.com/zenanetwork/iris/bridge/setu/util"
+	"github.com/zenanetwork/iris/common/tracing"
 	"github.com/zenanetwork/iris/contracts/stakinginfo"
 	"github.com/zenanetwork/iris/contracts/statesender"
 	"github.com/zenanetwork/iris/helper"
@@ -18,40 +23,56 @@ import (
 func (rl *RootChainListener) handleLog(vLog types.Log, selectedEvent *abi.Event) {
 	rl.Logger.Debug("ReceivedEvent", "eventname", selectedEvent.Name)
 
+	if util.IsLogSkipped(rl.storageClient, vLog.TxHash.Hex(), uint64(vLog.Index)) {
+		rl.Logger.Info("Skipping log", "eventname", selectedEvent.Name, "txHash", vLog.TxHash, "logIndex", vLog.Index)
+		return
+	}
+
+	// root span of the event, continued by the processor task and the side-tx on the node
+	ctx, span := tracing.StartSpan(tracing.WithTracer(context.Background(), otel.Tracer(util.BridgeTracerName)), "handleLog")
+	defer tracing.EndSpan(span)
+
+	tracing.SetAttributes(span,
+		attribute.String("event", selectedEvent.Name),
+		attribute.String("txHash", vLog.TxHash.Hex()),
+		attribute.String("logIndex", strconv.FormatUint(uint64(vLog.Index), 10)),
+		attribute.String("blockNumber", strconv.FormatUint(vLog.BlockNumber, 10)),
+	)
+
 	switch selectedEvent.Name {
 	case "NewHeaderBlock":
-		rl.handleNewHeaderBlockLog(vLog, selectedEvent)
+		rl.handleNewHeaderBlockLog(ctx, vLog, selectedEvent)
 	case "Staked":
-		rl.handleStakedLog(vLog, selectedEvent)
+		rl.handleStakedLog(ctx, vLog, selectedEvent)
 	case "StakeUpdate":
-		rl.handleStakeUpdateLog(vLog, selectedEvent)
+		rl.handleStakeUpdateLog(ctx, vLog, selectedEvent)
 	case "SignerChange":
-		rl.handleSignerChangeLog(vLog, selectedEvent)
+		rl.handleSignerChangeLog(ctx, vLog, selectedEvent)
 	case "UnstakeInit":
-		rl.handleUnstakeInitLog(vLog, selectedEvent)
+		rl.handleUnstakeInitLog(ctx, vLog, selectedEvent)
 	case "StateSynced":
-		rl.handleStateSyncedLog(vLog, selectedEvent)
+		rl.handleStateSyncedLog(ctx, vLog, selectedEvent)
 	case "TopUpFee":
-		rl.handleTopUpFeeLog(vLog, selectedEvent)
+		rl.handleTopUpFeeLog(ctx, vLog, selectedEvent)
 	case "Slashed":
-		rl.handleSlashedLog(vLog, selectedEvent)
+		rl.handleSlashedLog(ctx, vLog, selectedEvent)
 	case "UnJailed":
-		rl.handleUnJailedLog(vLog, selectedEvent)
+		rl.handleUnJailedLog(ctx, vLog, selectedEvent)
 	}
 }
 
-func (rl *RootChainListener) handleNewHeaderBlockLog(vLog types.Log, selectedEvent *abi.Event) {
+func (rl *RootChainListener) handleNewHeaderBlockLog(ctx context.Context, vLog types.Log, selectedEvent *abi.Event) {
 	logBytes, err := jsoniter.ConfigFastest.Marshal(vLog)
 	if err != nil {
 		rl.Logger.Error("Failed to marshal log", "Error", err)
 	}
 
 	if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx, selectedEvent); isCurrentValidator {
-		rl.SendTaskWithDelay("sendCheckpointAckToIris", selectedEvent.Name, logBytes, delay, selectedEvent)
+		rl.SendTaskWithDelay(ctx, "sendCheckpointAckToIris", selectedEvent.Name, logBytes, delay, selectedEvent)
 	}
 }
 
-func (rl *RootChainListener) handleStakedLog(vLog types.Log, selectedEvent *abi.Event) {
+func (rl *RootChainListener) handleStakedLog(ctx context.Context, vLog types.Log, selectedEvent *abi.Event) {
 	logBytes, err := jsoniter.ConfigFastest.Marshal(vLog)
 	if err != nil {
 		rl.Logger.Error("Failed to marshal log", "Error", err)
@@ -71,15 +92,15 @@ func (rl *RootChainListener) handleStakedLog(vLog types.Log, selectedEvent *abi.
 	if bytes.Equal(event.SignerPubkey, pubkey[1:]) {
 		// topup has to be processed first before validator join. so adding delay.
 		delay := util.TaskDelayBetweenEachVal
-		rl.SendTaskWithDelay("sendValidatorJoinToIris", selectedEvent.Name, logBytes, delay, event)
+		rl.SendTaskWithDelay(ctx, "sendValidatorJoinToIris", selectedEvent.Name, logBytes, delay, event)
 	} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx, event); isCurrentValidator {
 		// topup has to be processed first before validator join. so adding delay.
 		delay = delay + util.TaskDelayBetweenEachVal
-		rl.SendTaskWithDelay("sendValidatorJoinToIris", selectedEvent.Name, logBytes, delay, event)
+		rl.SendTaskWithDelay(ctx, "sendValidatorJoinToIris", selectedEvent.Name, logBytes, delay, event)
 	}
 }
 
-func (rl *RootChainListener) handleStakeUpdateLog(vLog types.Log, selectedEvent *abi.Event) {
+func (rl *RootChainListener) handleStakeUpdateLog(ctx context.Context, vLog types.Log, selectedEvent *abi.Event) {
 	logBytes, err := jsoniter.ConfigFastest.Marshal(vLog)
 	if err != nil {
 		rl.Logger.Error("Failed to marshal log", "Error", err)
@@ -91,13 +112,13 @@ func (rl *RootChainListener) handleStakeUpdateLog(vLog types.Log, selectedEvent
 	}
 
 	if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-		rl.SendTaskWithDelay("sendStakeUpdateToIris", selectedEvent.Name, logBytes, 0, event)
+		rl.SendTaskWithDelay(ctx, "sendStakeUpdateToIris", selectedEvent.Name, logBytes, 0, event)
 	} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx, event); isCurrentValidator {
-		rl.SendTaskWithDelay("sendStakeUpdateToIris", selectedEvent.Name, logBytes, delay, event)
+		rl.SendTaskWithDelay(ctx, "sendStakeUpdateToIris", selectedEvent.Name, logBytes, delay, event)
 	}
 }
 
-func (rl *RootChainListener) handleSignerChangeLog(vLog types.Log, selectedEvent *abi.Event) {
+func (rl *RootChainListener) handleSignerChangeLog(ctx context.Context, vLog types.Log, selectedEvent *abi.Event) {
 	logBytes, err := jsoniter.ConfigFastest.Marshal(vLog)
 	if err != nil {
 		rl.Logger.Error("Failed to marshal log", "Error", err)
@@ -111,13 +132,13 @@ func (rl *RootChainListener) handleSignerChangeLog(vLog types.Log, selectedEvent
 	}
 
 	if bytes.Equal(event.SignerPubkey, pubkey[1:]) && util.IsPubKeyFirstByteValid(pubkey[0:1]) {
-		rl.SendTaskWithDelay("sendSignerChangeToIris", selectedEvent.Name, logBytes, 0, event)
+		rl.SendTaskWithDelay(ctx, "sendSignerChangeToIris", selectedEvent.Name, logBytes, 0, event)
 	} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx, event); isCurrentValidator {
-		rl.SendTaskWithDelay("sendSignerChangeToIris", selectedEvent.Name, logBytes, delay, event)
+		rl.SendTaskWithDelay(ctx, "sendSignerChangeToIris", selectedEvent.Name, logBytes, delay, event)
 	}
 }
 
-func (rl *RootChainListener) handleUnstakeInitLog(vLog types.Log, selectedEvent *abi.Event) {
+func (rl *RootChainListener) handleUnstakeInitLog(ctx context.Context, vLog types.Log, selectedEvent *abi.Event) {
 	logBytes, err := jsoniter.ConfigFastest.Marshal(vLog)
 	if err != nil {
 		rl.Logger.Error("Failed to marshal log", "Error", err)
@@ -129,13 +150,13 @@ func (rl *RootChainListener) handleUnstakeInitLog(vLog types.Log, selectedEvent
 	}
 
 	if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-		rl.SendTaskWithDelay("sendUnstakeInitToIris", selectedEvent.Name, logBytes, 0, event)
+		rl.SendTaskWithDelay(ctx, "sendUnstakeInitToIris", selectedEvent.Name, logBytes, 0, event)
 	} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx, event); isCurrentValidator {
-		rl.SendTaskWithDelay("sendUnstakeInitToIris", selectedEvent.Name, logBytes, delay, event)
+		rl.SendTaskWithDelay(ctx, "sendUnstakeInitToIris", selectedEvent.Name, logBytes, delay, event)
 	}
 }
 
-func (rl *RootChainListener) handleStateSyncedLog(vLog types.Log, selectedEvent *abi.Event) {
+func (rl *RootChainListener) handleStateSyncedLog(ctx context.Context, vLog types.Log, selectedEvent *abi.Event) {
 	logBytes, err := jsoniter.ConfigFastest.Marshal(vLog)
 	if err != nil {
 		rl.Logger.Error("Failed to marshal log", "Error", err)
@@ -149,11 +170,11 @@ func (rl *RootChainListener) handleStateSyncedLog(vLog types.Log, selectedEvent
 	rl.Logger.Info("StateSyncedEvent: detected", "stateSyncId", event.Id)
 
 	if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx, event); isCurrentValidator {
-		rl.SendTaskWithDelay("sendStateSyncedToIris", selectedEvent.Name, logBytes, delay, event)
+		rl.SendTaskWithDelay(ctx, "sendStateSyncedToIris", selectedEvent.Name, logBytes, delay, event)
 	}
 }
 
-func (rl *RootChainListener) handleTopUpFeeLog(vLog types.Log, selectedEvent *abi.Event) {
+func (rl *RootChainListener) handleTopUpFeeLog(ctx context.Context, vLog types.Log, selectedEvent *abi.Event) {
 	logBytes, err := jsoniter.ConfigFastest.Marshal(vLog)
 	if err != nil {
 		rl.Logger.Error("Failed to marshal log", "Error", err)
@@ -165,24 +186,24 @@ func (rl *RootChainListener) handleTopUpFeeLog(vLog types.Log, selectedEvent *ab
 	}
 
 	if bytes.Equal(event.User.Bytes(), helper.GetAddress()) {
-		rl.SendTaskWithDelay("sendTopUpFeeToIris", selectedEvent.Name, logBytes, 0, event)
+		rl.SendTaskWithDelay(ctx, "sendTopUpFeeToIris", selectedEvent.Name, logBytes, 0, event)
 	} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx, event); isCurrentValidator {
-		rl.SendTaskWithDelay("sendTopUpFeeToIris", selectedEvent.Name, logBytes, delay, event)
+		rl.SendTaskWithDelay(ctx, "sendTopUpFeeToIris", selectedEvent.Name, logBytes, delay, event)
 	}
 }
 
-func (rl *RootChainListener) handleSlashedLog(vLog types.Log, selectedEvent *abi.Event) {
+func (rl *RootChainListener) handleSlashedLog(ctx context.Context, vLog types.Log, selectedEvent *abi.Event) {
 	logBytes, err := jsoniter.ConfigFastest.Marshal(vLog)
 	if err != nil {
 		rl.Logger.Error("Failed to marshal log", "Error", err)
 	}
 
 	if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx, selectedEvent); isCurrentValidator {
-		rl.SendTaskWithDelay("sendTickAckToIris", selectedEvent.Name, logBytes, delay, selectedEvent)
+		rl.SendTaskWithDelay(ctx, "sendTickAckToIris", selectedEvent.Name, logBytes, delay, selectedEvent)
 	}
 }
 
-func (rl *RootChainListener) handleUnJailedLog(vLog types.Log, selectedEvent *abi.Event) {
+func (rl *RootChainListener) handleUnJailedLog(ctx context.Context, vLog types.Log, selectedEvent *abi.Event) {
 	logBytes, err := jsoniter.ConfigFastest.Marshal(vLog)
 	if err != nil {
 		rl.Logger.Error("Failed to marshal log", "Error", err)
@@ -194,8 +215,8 @@ func (rl *RootChainListener) handleUnJailedLog(vLog types.Log, selectedEvent *ab
 	}
 
 	if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-		rl.SendTaskWithDelay("sendUnjailToIris", selectedEvent.Name, logBytes, 0, event)
+		rl.SendTaskWithDelay(ctx, "sendUnjailToIris", selectedEvent.Name, logBytes, 0, event)
 	} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx, event); isCurrentValidator {
-		rl.SendTaskWithDelay("sendUnjailToIris", selectedEvent.Name, logBytes, delay, event)
+		rl.SendTaskWithDelay(ctx, "sendUnjailToIris", selectedEvent.Name, logBytes, delay, event)
 	}
 }
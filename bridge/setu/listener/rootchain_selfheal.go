@@ -3,6 +3,7 @@ package listener
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"net/http"
 	"sync"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
+	"github.com/zenanetwork/go-zenanet/accounts/abi/bind"
 	"github.com/zenanetwork/go-zenanet/core/types"
 	"github.com/zenanetwork/iris/bridge/setu/util"
 	"github.com/zenanetwork/iris/helper"
@@ -31,27 +33,43 @@ var (
 	}, []string{"id", "nonce", "contract_address", "block_number", "tx_hash"})
 )
 
-type subGraphClient struct {
-	graphUrl   string
-	httpClient *http.Client
+// selfHealBackend finds the L1 events the listener missed, to compare the L1 counters with iris
+type selfHealBackend interface {
+	// getLatestStateID returns the ID of the latest StateSynced event
+	getLatestStateID(ctx context.Context) (*big.Int, error)
+	// getStateSync returns the StateSynced event of the given state ID
+	getStateSync(ctx context.Context, stateId int64) (*types.Log, error)
+	// getLatestNonce returns the nonce of the latest StakeUpdate event of the validator
+	getLatestNonce(ctx context.Context, validatorId uint64) (uint64, error)
+	// getStakeUpdate returns the StakeUpdate event of the validator with the given nonce
+	getStakeUpdate(ctx context.Context, validatorId, nonce uint64) (*types.Log, error)
 }
 
 // startSelfHealing starts self-healing processes for all required events
 func (rl *RootChainListener) startSelfHealing(ctx context.Context) {
-	if !helper.GetConfig().EnableSH || helper.GetConfig().SubGraphUrl == "" {
+	if !helper.GetConfig().EnableSH {
 		rl.Logger.Info("Self-healing disabled")
 		return
 	}
 
-	rl.subGraphClient = &subGraphClient{
-		graphUrl:   helper.GetConfig().SubGraphUrl,
-		httpClient: &http.Client{Timeout: 5 * time.Second},
+	// the missing events are found by scanning the L1 logs, or with the sub graph if any
+	rl.logScanner = newLogScanner(rl)
+
+	if helper.GetConfig().SubGraphUrl != "" {
+		rl.selfHealBackend = &subGraphClient{
+			graphUrl:        helper.GetConfig().SubGraphUrl,
+			httpClient:      &http.Client{Timeout: 5 * time.Second},
+			mainChainClient: rl.contractConnector.MainChainClient,
+		}
+	} else {
+		rl.selfHealBackend = rl.logScanner
 	}
 
 	stakeUpdateTicker := time.NewTicker(helper.GetConfig().SHStakeUpdateInterval)
 	stateSyncedTicker := time.NewTicker(helper.GetConfig().SHStateSyncedInterval)
+	eventsTicker := time.NewTicker(helper.GetConfig().SHEventsInterval)
 
-	rl.Logger.Info("Started self-healing")
+	rl.Logger.Info("Started self-healing", "subGraph", helper.GetConfig().SubGraphUrl != "")
 
 	for {
 		select {
@@ -59,10 +77,13 @@ func (rl *RootChainListener) startSelfHealing(ctx context.Context) {
 			rl.processStakeUpdate(ctx)
 		case <-stateSyncedTicker.C:
 			rl.processStateSynced(ctx)
+		case <-eventsTicker.C:
+			rl.processEvents(ctx)
 		case <-ctx.Done():
 			rl.Logger.Info("Stopping self-healing")
 			stakeUpdateTicker.Stop()
 			stateSyncedTicker.Stop()
+			eventsTicker.Stop()
 
 			return
 		}
@@ -97,7 +118,7 @@ func (rl *RootChainListener) processStakeUpdate(ctx context.Context) {
 			var ethereumNonce uint64
 
 			if err = helper.ExponentialBackoff(func() error {
-				ethereumNonce, err = rl.getLatestNonce(ctx, id)
+				ethereumNonce, err = rl.selfHealBackend.getLatestNonce(ctx, id)
 				return err
 			}, 3, time.Second); err != nil {
 				rl.Logger.Error("Error getting nonce for validator from L1", "error", err, "id", id)
@@ -115,7 +136,7 @@ func (rl *RootChainListener) processStakeUpdate(ctx context.Context) {
 			var stakeUpdate *types.Log
 
 			if err = helper.ExponentialBackoff(func() error {
-				stakeUpdate, err = rl.getStakeUpdate(ctx, id, nonce)
+				stakeUpdate, err = rl.selfHealBackend.getStakeUpdate(ctx, id, nonce)
 				return err
 			}, 3, time.Second); err != nil {
 				rl.Logger.Error("Error getting stake update for validator", "error", err, "id", id)
@@ -149,7 +170,7 @@ func (rl *RootChainListener) processStateSynced(ctx context.Context) {
 		return
 	}
 
-	latestEthereumStateId, err := rl.getLatestStateID(ctx)
+	latestEthereumStateId, err := rl.selfHealBackend.getLatestStateID(ctx)
 	if err != nil {
 		rl.Logger.Error("Unable to fetch latest state id from state sender contract", "error", err)
 		return
@@ -170,7 +191,7 @@ func (rl *RootChainListener) processStateSynced(ctx context.Context) {
 		var stateSynced *types.Log
 
 		if err = helper.ExponentialBackoff(func() error {
-			stateSynced, err = rl.getStateSync(ctx, i)
+			stateSynced, err = rl.selfHealBackend.getStateSync(ctx, i)
 			return err
 		}, 3, time.Second); err != nil {
 			rl.Logger.Error("Error getting state sync", "error", err, "id", i)
@@ -214,6 +235,28 @@ func (rl *RootChainListener) processStateSynced(ctx context.Context) {
 	}
 }
 
+// getCurrentStateID returns the current state ID handled by the polygon chain
+func (rl *RootChainListener) getCurrentStateID(ctx context.Context) (*big.Int, error) {
+	rootchainContext, err := rl.getRootChainContext()
+	if err != nil {
+		return nil, err
+	}
+
+	stateReceiverInstance, err := rl.contractConnector.GetStateReceiverInstance(
+		rootchainContext.ChainmanagerParams.ChainParams.StateReceiverAddress.EthAddress(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stateId, err := stateReceiverInstance.LastStateId(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+
+	return stateId, nil
+}
+
 func (rl *RootChainListener) processEvent(ctx context.Context, vLog *types.Log) (bool, error) {
 	blockTime, err := rl.contractConnector.GetMainChainBlockTime(ctx, vLog.BlockNumber)
 	if err != nil {
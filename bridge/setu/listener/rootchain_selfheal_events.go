@@ -0,0 +1,172 @@
+package listener
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/zenanetwork/go-zenanet/common"
+	"github.com/zenanetwork/go-zenanet/core/types"
+
+	"github.com/zenanetwork/iris/bridge/setu/util"
+	"github.com/zenanetwork/iris/helper"
+)
+
+const (
+	shEventsLastBlockKey = "rootchain-sh-events-last-block" // storage key
+)
+
+// selfHealEvents are the staking info events self-healed by scanning the L1 logs,
+// with the module whose sequence store tells if iris has processed them
+var selfHealEvents = map[string]util.BridgeEvent{
+	"Staked":       util.StakingEvent,
+	"SignerChange": util.StakingEvent,
+	"UnstakeInit":  util.StakingEvent,
+	"TopUpFee":     util.TopupEvent,
+	"Slashed":      util.SlashingEvent,
+	"UnJailed":     util.SlashingEvent,
+}
+
+// missingEventCounters count the missing events of each self-healed event
+var missingEventCounters = func() map[string]*prometheus.CounterVec {
+	counters := make(map[string]*prometheus.CounterVec, len(selfHealEvents))
+
+	for name := range selfHealEvents {
+		counters[name] = promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "self_healing",
+			Subsystem: helper.GetConfig().Chain,
+			Name:      name,
+			Help:      fmt.Sprintf("The total number of missing %s events", name),
+		}, []string{"contract_address", "block_number", "tx_hash", "log_index"})
+	}
+
+	return counters
+}()
+
+// processEvents scans the staking info logs of the blocks polled by the listener since the last run,
+// and replays the self-healed events iris has not processed
+func (rl *RootChainListener) processEvents(ctx context.Context) {
+	rootchainContext, err := rl.getRootChainContext()
+	if err != nil {
+		return
+	}
+
+	fromBlock := helper.GetConfig().SHLogsStartBlock
+	if lastBlock, ok := rl.getStoredBlock(shEventsLastBlockKey); ok {
+		fromBlock = lastBlock + 1
+	}
+
+	// the events of the blocks not polled yet are not missing
+	toBlock, ok := rl.getStoredBlock(lastRootBlockKey)
+	if !ok {
+		return
+	}
+
+	topics := make([]common.Hash, 0, len(selfHealEvents))
+	for name := range selfHealEvents {
+		topics = append(topics, rl.stakingInfoAbi.Events[name].ID)
+	}
+
+	stakingInfoAddress := rootchainContext.ChainmanagerParams.ChainParams.StakingInfoAddress.EthAddress()
+
+	for from := fromBlock; from <= toBlock; from += rl.logScanner.blockRange {
+		to := from + rl.logScanner.blockRange - 1
+		if to > toBlock {
+			to = toBlock
+		}
+
+		logs, err := rl.logScanner.filterLogs(ctx, from, to, stakingInfoAddress, [][]common.Hash{topics})
+		if err != nil {
+			rl.Logger.Error("Error while filtering logs to self-heal", "error", err)
+			return
+		}
+
+		for i := range logs {
+			if !rl.healEvent(ctx, &logs[i]) {
+				// resume from the block of the event next time
+				if logs[i].BlockNumber > 0 {
+					rl.storeBlock(shEventsLastBlockKey, logs[i].BlockNumber-1)
+				}
+
+				return
+			}
+		}
+
+		rl.storeBlock(shEventsLastBlockKey, to)
+	}
+}
+
+// healEvent replays the event log if iris has not processed it.
+// It returns false if the event is to be checked again later.
+func (rl *RootChainListener) healEvent(ctx context.Context, vLog *types.Log) bool {
+	if vLog.Removed || len(vLog.Topics) == 0 {
+		return true
+	}
+
+	selectedEvent := helper.EventByID(rl.stakingInfoAbi, vLog.Topics[0].Bytes())
+	if selectedEvent == nil {
+		return true
+	}
+
+	eventType, ok := selfHealEvents[selectedEvent.Name]
+	if !ok {
+		return true
+	}
+
+	isOld, err := util.IsOldTx(rl.cliCtx, vLog.TxHash.Hex(), uint64(vLog.Index), eventType)
+	if err != nil {
+		rl.Logger.Error("Error checking if event is processed on iris", "event", selectedEvent.Name, "txHash", vLog.TxHash, "error", err)
+		return false
+	}
+
+	if isOld {
+		return true
+	}
+
+	rl.Logger.Info("Processing missing event", "event", selectedEvent.Name, "txHash", vLog.TxHash, "logIndex", vLog.Index)
+
+	ignore, err := rl.processEvent(ctx, vLog)
+	if err != nil {
+		rl.Logger.Error("Error processing missing event", "event", selectedEvent.Name, "txHash", vLog.TxHash, "error", err)
+		return false
+	}
+
+	// the event is recent, it may still be on its way to iris
+	if ignore {
+		return false
+	}
+
+	missingEventCounters[selectedEvent.Name].WithLabelValues(
+		vLog.Address.Hex(),
+		strconv.FormatUint(vLog.BlockNumber, 10),
+		vLog.TxHash.Hex(),
+		strconv.FormatUint(uint64(vLog.Index), 10),
+	).Add(1)
+
+	return true
+}
+
+// getStoredBlock returns the block number stored at key in the bridge db
+func (rl *RootChainListener) getStoredBlock(key string) (uint64, bool) {
+	data, err := rl.storageClient.Get([]byte(key), nil)
+	if err != nil {
+		return 0, false
+	}
+
+	block, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		rl.Logger.Error("Invalid block number in bridge storage", "key", key, "error", err)
+		return 0, false
+	}
+
+	return block, true
+}
+
+// storeBlock stores the block number at key in the bridge db
+func (rl *RootChainListener) storeBlock(key string, block uint64) {
+	if err := rl.storageClient.Put([]byte(key), []byte(strconv.FormatUint(block, 10)), nil); err != nil {
+		rl.Logger.Error("rl.storageClient.Put", "Error", err)
+	}
+}
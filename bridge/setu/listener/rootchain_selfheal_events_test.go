@@ -0,0 +1,337 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/zenanetwork/go-zenanet/accounts/abi"
+	"github.com/zenanetwork/go-zenanet/common"
+	"github.com/zenanetwork/go-zenanet/core/types"
+
+	"github.com/zenanetwork/iris/bridge/setu/util"
+	"github.com/zenanetwork/iris/contracts/stakinginfo"
+	"github.com/zenanetwork/iris/helper"
+	helperMocks "github.com/zenanetwork/iris/helper/mocks"
+)
+
+var testStakingInfoAddress = common.HexToAddress("0x318EeD65F064904Bc6E0e3842940c5972BC8E38f")
+
+// txStatusRequest is a request of iris for the status of an L1 tx
+type txStatusRequest struct {
+	module string
+	txHash common.Hash
+}
+
+// fakeIris serves the chain manager params and the tx status requests of the self-healing
+type fakeIris struct {
+	mtx sync.Mutex
+
+	// txs processed by iris, and the ones iris fails to tell about
+	processed map[common.Hash]bool
+	failing   map[common.Hash]bool
+
+	requests []txStatusRequest
+}
+
+func (f *fakeIris) get(rawURL string) (*http.Response, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case u.Path == util.ChainManagerParamsURL:
+		return irisResponse(fmt.Sprintf(`{"height": "1", "result": {"chain_params": {"staking_info_address": "%s"}}}`, testStakingInfoAddress.Hex())), nil
+	case strings.HasSuffix(u.Path, "/isoldtx"):
+		txHash := common.HexToHash(u.Query().Get("txhash"))
+		f.requests = append(f.requests, txStatusRequest{module: strings.Split(u.Path, "/")[1], txHash: txHash})
+
+		if f.failing[txHash] {
+			return nil, errors.New("iris unavailable")
+		}
+
+		return irisResponse(fmt.Sprintf(`{"height": "1", "result": %t}`, f.processed[txHash])), nil
+	}
+
+	return nil, fmt.Errorf("unexpected url %s", rawURL)
+}
+
+// takeRequests returns the tx status requests since the last take
+func (f *fakeIris) takeRequests() []txStatusRequest {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	requests := f.requests
+	f.requests = nil
+
+	return requests
+}
+
+func irisResponse(body string) *http.Response {
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+// newTestSelfHealListener returns a listener self-healing from the fake L1 and iris
+func newTestSelfHealListener(t *testing.T, l1 *fakeL1, iris *fakeIris) *RootChainListener {
+	t.Helper()
+
+	conf := helper.GetConfig()
+	client := helper.Client
+
+	t.Cleanup(func() {
+		helper.SetTestConfig(conf)
+		helper.Client = client
+	})
+
+	testConf := conf
+	testConf.IrisServerURL = "http://iris"
+	testConf.SHLogsStartBlock = 1
+	testConf.SHMaxDepthDuration = time.Hour
+	helper.SetTestConfig(testConf)
+
+	mockHTTPClient := helperMocks.NewMockHTTPClient(gomock.NewController(t))
+	mockHTTPClient.EXPECT().Get(gomock.Any()).DoAndReturn(iris.get).AnyTimes()
+	helper.Client = mockHTTPClient
+
+	// the util funcs log through the bridge logger, created on first use
+	util.Logger()
+
+	stakingInfoAbi, err := abi.JSON(strings.NewReader(stakinginfo.StakinginfoABI))
+	require.NoError(t, err)
+
+	rl := &RootChainListener{
+		abis:           []*abi.ABI{&stakingInfoAbi},
+		stakingInfoAbi: &stakingInfoAbi,
+	}
+
+	rl.Logger = log.NewNopLogger()
+	rl.cliCtx = cliContext.CLIContext{}.WithCodec(codec.New())
+	rl.contractConnector = l1.contractCaller(t)
+	rl.storageClient = newTestStorage(t)
+	rl.logScanner = &logScanner{
+		logger:            rl.Logger,
+		contractConnector: rl.contractConnector,
+		storageClient:     rl.storageClient,
+		startBlock:        1,
+		blockRange:        100,
+	}
+
+	return rl
+}
+
+// eventLog returns a staking info log of the event, in its own tx. The log is skipped by the
+// bridge, so that it is not sent to the processor once replayed.
+func eventLog(t *testing.T, rl *RootChainListener, name string, block uint64) types.Log {
+	t.Helper()
+
+	vLog := types.Log{
+		Address:     testStakingInfoAddress,
+		Topics:      []common.Hash{rl.stakingInfoAbi.Events[name].ID},
+		BlockNumber: block,
+		TxHash:      common.BigToHash(new(big.Int).SetUint64(block)),
+		Index:       1,
+	}
+
+	require.NoError(t, util.SkipLog(rl.storageClient, vLog.TxHash.Hex(), uint64(vLog.Index)))
+
+	return vLog
+}
+
+// missingCount returns the count of the log as a missing event
+func missingCount(name string, vLog types.Log) float64 {
+	return testutil.ToFloat64(missingEventCounters[name].WithLabelValues(
+		vLog.Address.Hex(),
+		strconv.FormatUint(vLog.BlockNumber, 10),
+		vLog.TxHash.Hex(),
+		strconv.FormatUint(uint64(vLog.Index), 10),
+	))
+}
+
+// TestSelfHealEventModules checks the module iris is asked about each self-healed event
+func TestSelfHealEventModules(t *testing.T) {
+	iris := &fakeIris{processed: make(map[common.Hash]bool)}
+	rl := newTestSelfHealListener(t, &fakeL1{}, iris)
+
+	modules := map[string]string{
+		"Staked":       "staking",
+		"SignerChange": "staking",
+		"UnstakeInit":  "staking",
+		"TopUpFee":     "topup",
+		"Slashed":      "slashing",
+		"UnJailed":     "slashing",
+	}
+
+	require.Len(t, selfHealEvents, len(modules))
+
+	for name, module := range modules {
+		vLog := eventLog(t, rl, name, 10)
+		iris.processed[vLog.TxHash] = true
+
+		require.True(t, rl.healEvent(context.Background(), &vLog), name)
+		require.Equal(t, []txStatusRequest{{module: module, txHash: vLog.TxHash}}, iris.takeRequests(), name)
+	}
+}
+
+func TestHealEvent(t *testing.T) {
+	l1 := &fakeL1{
+		blockTime:  time.Now().Add(-2 * time.Hour),
+		blockTimes: map[uint64]time.Time{30: time.Now()},
+	}
+	iris := &fakeIris{processed: make(map[common.Hash]bool), failing: make(map[common.Hash]bool)}
+	rl := newTestSelfHealListener(t, l1, iris)
+
+	processed := eventLog(t, rl, "Staked", 10)
+	iris.processed[processed.TxHash] = true
+
+	removed := eventLog(t, rl, "Staked", 11)
+	removed.Removed = true
+
+	failing := eventLog(t, rl, "UnJailed", 12)
+	iris.failing[failing.TxHash] = true
+
+	testCases := []struct {
+		name  string
+		event string
+		vLog  types.Log
+
+		healed  bool
+		checked bool // iris is asked about the tx
+		missing bool
+	}{
+		{name: "processed event", event: "Staked", vLog: processed, healed: true, checked: true},
+		{name: "removed log", event: "Staked", vLog: removed, healed: true},
+		{name: "not a self-healed event", event: "StakeUpdate", vLog: eventLog(t, rl, "StakeUpdate", 13), healed: true},
+		{name: "missing event is replayed", event: "TopUpFee", vLog: eventLog(t, rl, "TopUpFee", 20), healed: true, checked: true, missing: true},
+		{name: "recent missing event is checked again", event: "Slashed", vLog: eventLog(t, rl, "Slashed", 30), checked: true},
+		{name: "tx status error", event: "UnJailed", vLog: failing, checked: true},
+	}
+
+	for _, tc := range testCases {
+		var count float64
+		if tc.missing {
+			count = missingCount(tc.event, tc.vLog)
+		}
+
+		vLog := tc.vLog
+		require.Equal(t, tc.healed, rl.healEvent(context.Background(), &vLog), tc.name)
+
+		requests := iris.takeRequests()
+		if tc.checked {
+			require.Len(t, requests, 1, tc.name)
+			require.Equal(t, tc.vLog.TxHash, requests[0].txHash, tc.name)
+		} else {
+			require.Empty(t, requests, tc.name)
+		}
+
+		if tc.missing {
+			require.Equal(t, count+1, missingCount(tc.event, tc.vLog), tc.name)
+		}
+	}
+}
+
+func TestProcessEvents(t *testing.T) {
+	l1 := &fakeL1{
+		blockTime:  time.Now().Add(-2 * time.Hour),
+		blockTimes: map[uint64]time.Time{180: time.Now()},
+	}
+	iris := &fakeIris{processed: make(map[common.Hash]bool), failing: make(map[common.Hash]bool)}
+	rl := newTestSelfHealListener(t, l1, iris)
+
+	staked := eventLog(t, rl, "Staked", 50)
+	iris.processed[staked.TxHash] = true
+
+	removed := eventLog(t, rl, "SignerChange", 60)
+	removed.Removed = true
+
+	topUp := eventLog(t, rl, "TopUpFee", 120)
+	slashed := eventLog(t, rl, "Slashed", 180)
+	unstake := eventLog(t, rl, "UnstakeInit", 260)
+	iris.failing[unstake.TxHash] = true
+
+	l1.logs = []types.Log{
+		staked,
+		removed,
+		eventLog(t, rl, "StakeUpdate", 70),
+		topUp,
+		slashed,
+		unstake,
+	}
+
+	lastEventsBlock := func() uint64 {
+		block, ok := rl.getStoredBlock(shEventsLastBlockKey)
+		require.True(t, ok)
+
+		return block
+	}
+
+	// nothing before the listener has polled any block
+	rl.processEvents(context.Background())
+	require.Empty(t, l1.takeRanges())
+
+	// up to the recent slashing, resumed from its block next time
+	rl.storeBlock(lastRootBlockKey, 250)
+
+	topUpCount := missingCount("TopUpFee", topUp)
+	rl.processEvents(context.Background())
+
+	require.Equal(t, [][2]uint64{{1, 100}, {101, 200}}, l1.takeRanges())
+	require.Equal(t, []txStatusRequest{
+		{module: "staking", txHash: staked.TxHash},
+		{module: "topup", txHash: topUp.TxHash},
+		{module: "slashing", txHash: slashed.TxHash},
+	}, iris.takeRequests())
+	require.Equal(t, topUpCount+1, missingCount("TopUpFee", topUp))
+	require.Equal(t, uint64(179), lastEventsBlock())
+
+	// the slashing is replayed once old enough, up to the last block polled
+	l1.mtx.Lock()
+	delete(l1.blockTimes, 180)
+	l1.mtx.Unlock()
+
+	slashedCount := missingCount("Slashed", slashed)
+	rl.processEvents(context.Background())
+
+	require.Equal(t, [][2]uint64{{180, 250}}, l1.takeRanges())
+	require.Equal(t, []txStatusRequest{{module: "slashing", txHash: slashed.TxHash}}, iris.takeRequests())
+	require.Equal(t, slashedCount+1, missingCount("Slashed", slashed))
+	require.Equal(t, uint64(250), lastEventsBlock())
+
+	// no new block polled
+	rl.processEvents(context.Background())
+	require.Empty(t, l1.takeRanges())
+
+	// the unstake iris fails to tell about is checked again from its block
+	rl.storeBlock(lastRootBlockKey, 300)
+
+	rl.processEvents(context.Background())
+	require.Equal(t, [][2]uint64{{251, 300}}, l1.takeRanges())
+	require.Equal(t, []txStatusRequest{{module: "staking", txHash: unstake.TxHash}}, iris.takeRequests())
+	require.Equal(t, uint64(259), lastEventsBlock())
+
+	rl.processEvents(context.Background())
+	require.Equal(t, [][2]uint64{{260, 300}}, l1.takeRanges())
+	require.Equal(t, uint64(259), lastEventsBlock())
+}
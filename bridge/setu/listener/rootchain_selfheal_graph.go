@@ -11,9 +11,9 @@ import (
 	"net/http"
 	"strconv"
 
-	"github.com/zenanetwork/go-zenanet/accounts/abi/bind"
 	"github.com/zenanetwork/go-zenanet/common"
 	"github.com/zenanetwork/go-zenanet/core/types"
+	"github.com/zenanetwork/go-zenanet/ethclient"
 	jsoniter "github.com/json-iterator/go"
 
 	"github.com/zenanetwork/iris/helper"
@@ -45,16 +45,24 @@ type stateSyncResponse struct {
 	} `json:"data"`
 }
 
+// subGraphClient finds the missing events with the sub graph indexing the L1 contracts
+type subGraphClient struct {
+	graphUrl   string
+	httpClient *http.Client
+
+	mainChainClient *ethclient.Client
+}
+
 // querySubGraph queries the subgraph and limits the read size
-func (rl *RootChainListener) querySubGraph(query []byte, ctx context.Context) (data []byte, err error) {
-	request, err := http.NewRequestWithContext(ctx, http.MethodPost, rl.subGraphClient.graphUrl, bytes.NewBuffer(query))
+func (sg *subGraphClient) querySubGraph(query []byte, ctx context.Context) (data []byte, err error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, sg.graphUrl, bytes.NewBuffer(query))
 	if err != nil {
 		return nil, err
 	}
 
 	request.Header.Set("Content-Type", "application/json")
 
-	response, err := rl.subGraphClient.httpClient.Do(request)
+	response, err := sg.httpClient.Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +75,7 @@ func (rl *RootChainListener) querySubGraph(query []byte, ctx context.Context) (d
 }
 
 // getLatestStateID returns state ID from the latest StateSynced event
-func (rl *RootChainListener) getLatestStateID(ctx context.Context) (*big.Int, error) {
+func (sg *subGraphClient) getLatestStateID(ctx context.Context) (*big.Int, error) {
 	query := map[string]string{
 		"query": `
 		{
@@ -83,7 +91,7 @@ func (rl *RootChainListener) getLatestStateID(ctx context.Context) (*big.Int, er
 		return nil, err
 	}
 
-	data, err := rl.querySubGraph(byteQuery, ctx)
+	data, err := sg.querySubGraph(byteQuery, ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch latest state id from graph with err: %s", err)
 	}
@@ -103,30 +111,8 @@ func (rl *RootChainListener) getLatestStateID(ctx context.Context) (*big.Int, er
 	return stateID, nil
 }
 
-// getCurrentStateID returns the current state ID handled by the polygon chain
-func (rl *RootChainListener) getCurrentStateID(ctx context.Context) (*big.Int, error) {
-	rootchainContext, err := rl.getRootChainContext()
-	if err != nil {
-		return nil, err
-	}
-
-	stateReceiverInstance, err := rl.contractConnector.GetStateReceiverInstance(
-		rootchainContext.ChainmanagerParams.ChainParams.StateReceiverAddress.EthAddress(),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	stateId, err := stateReceiverInstance.LastStateId(&bind.CallOpts{Context: ctx})
-	if err != nil {
-		return nil, err
-	}
-
-	return stateId, nil
-}
-
 // getStateSync returns the StateSynced event based on the given state ID
-func (rl *RootChainListener) getStateSync(ctx context.Context, stateId int64) (*types.Log, error) {
+func (sg *subGraphClient) getStateSync(ctx context.Context, stateId int64) (*types.Log, error) {
 	query := map[string]string{
 		"query": `
 		{
@@ -143,7 +129,7 @@ func (rl *RootChainListener) getStateSync(ctx context.Context, stateId int64) (*
 		return nil, err
 	}
 
-	data, err := rl.querySubGraph(byteQuery, ctx)
+	data, err := sg.querySubGraph(byteQuery, ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch latest state id from graph with err: %s", err)
 	}
@@ -157,7 +143,7 @@ func (rl *RootChainListener) getStateSync(ctx context.Context, stateId int64) (*
 		return nil, fmt.Errorf("no state sync found for state id %d", stateId)
 	}
 
-	receipt, err := rl.contractConnector.MainChainClient.TransactionReceipt(ctx, common.HexToHash(response.Data.StateSyncs[0].TransactionHash))
+	receipt, err := sg.mainChainClient.TransactionReceipt(ctx, common.HexToHash(response.Data.StateSyncs[0].TransactionHash))
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +161,7 @@ func (rl *RootChainListener) getStateSync(ctx context.Context, stateId int64) (*
 }
 
 // getLatestNonce returns the nonce from the latest StakeUpdate event
-func (rl *RootChainListener) getLatestNonce(ctx context.Context, validatorId uint64) (uint64, error) {
+func (sg *subGraphClient) getLatestNonce(ctx context.Context, validatorId uint64) (uint64, error) {
 	if validatorId > math.MaxInt {
 		return 0, fmt.Errorf("validator ID value out of range for int: %d", validatorId)
 	}
@@ -195,7 +181,7 @@ func (rl *RootChainListener) getLatestNonce(ctx context.Context, validatorId uin
 		return 0, err
 	}
 
-	data, err := rl.querySubGraph(byteQuery, ctx)
+	data, err := sg.querySubGraph(byteQuery, ctx)
 	if err != nil {
 		return 0, fmt.Errorf("unable to fetch latest nonce from graph with err: %s", err)
 	}
@@ -222,7 +208,7 @@ func (rl *RootChainListener) getLatestNonce(ctx context.Context, validatorId uin
 }
 
 // getStakeUpdate returns StakeUpdate event based on the given validator ID and nonce
-func (rl *RootChainListener) getStakeUpdate(ctx context.Context, validatorId, nonce uint64) (*types.Log, error) {
+func (sg *subGraphClient) getStakeUpdate(ctx context.Context, validatorId, nonce uint64) (*types.Log, error) {
 	if validatorId > math.MaxInt {
 		return nil, fmt.Errorf("validator ID value out of range for int: %d", validatorId)
 	}
@@ -245,7 +231,7 @@ func (rl *RootChainListener) getStakeUpdate(ctx context.Context, validatorId, no
 		return nil, err
 	}
 
-	data, err := rl.querySubGraph(byteQuery, ctx)
+	data, err := sg.querySubGraph(byteQuery, ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch stake update from graph with err: %s", err)
 	}
@@ -259,7 +245,7 @@ func (rl *RootChainListener) getStakeUpdate(ctx context.Context, validatorId, no
 		return nil, fmt.Errorf("no stake update found for validator %d and nonce %d", validatorId, nonce)
 	}
 
-	receipt, err := rl.contractConnector.MainChainClient.TransactionReceipt(ctx, common.HexToHash(response.Data.StakeUpdates[0].TransactionHash))
+	receipt, err := sg.mainChainClient.TransactionReceipt(ctx, common.HexToHash(response.Data.StakeUpdates[0].TransactionHash))
 	if err != nil {
 		return nil, err
 	}
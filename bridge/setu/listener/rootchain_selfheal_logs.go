@@ -0,0 +1,298 @@
+package listener
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/tendermint/tendermint/libs/log"
+	ethereum "github.com/zenanetwork/go-zenanet"
+	"github.com/zenanetwork/go-zenanet/accounts/abi/bind"
+	"github.com/zenanetwork/go-zenanet/common"
+	"github.com/zenanetwork/go-zenanet/core/types"
+	"github.com/zenanetwork/go-zenanet/rpc"
+
+	"github.com/zenanetwork/iris/helper"
+)
+
+const (
+	shStateSyncedKey       = "rootchain-sh-state-synced"  // storage key
+	shStakeUpdateKeyPrefix = "rootchain-sh-stake-update-" // storage key prefix, followed by the validator id
+)
+
+// logScanCheckpoint is the progress of a log scan, stored in the bridge db.
+// All the logs up to block Scanned have a counter (state id or nonce) up to Counter,
+// which is emitted at block Block. The counter last found is Found, emitted at block
+// FoundBlock; the counters are requested in order, so the ones not resolved yet are
+// emitted from FoundBlock on.
+type logScanCheckpoint struct {
+	Counter    uint64 `json:"counter"`
+	Block      uint64 `json:"block"`
+	Scanned    uint64 `json:"scanned"`
+	Found      uint64 `json:"found,omitempty"`
+	FoundBlock uint64 `json:"found_block,omitempty"`
+}
+
+// logScanner finds the missing events by scanning the L1 logs with eth_getLogs,
+// for validators running without a sub graph
+type logScanner struct {
+	logger log.Logger
+
+	contractConnector helper.ContractCaller
+	rootChainContext  func() (*RootChainListenerContext, error)
+	storageClient     *leveldb.DB
+
+	stateSyncedID common.Hash
+	stakeUpdateID common.Hash
+
+	startBlock uint64
+	blockRange uint64
+}
+
+func newLogScanner(rl *RootChainListener) *logScanner {
+	return &logScanner{
+		logger:            rl.Logger,
+		contractConnector: rl.contractConnector,
+		rootChainContext:  rl.getRootChainContext,
+		storageClient:     rl.storageClient,
+		stateSyncedID:     rl.stateSenderAbi.Events["StateSynced"].ID,
+		stakeUpdateID:     rl.stakingInfoAbi.Events["StakeUpdate"].ID,
+		startBlock:        helper.GetConfig().SHLogsStartBlock,
+		blockRange:        helper.GetConfig().SHLogsBlockRange,
+	}
+}
+
+// getLatestStateID returns the state counter of the state sender contract
+func (ls *logScanner) getLatestStateID(_ context.Context) (*big.Int, error) {
+	rootchainContext, err := ls.rootChainContext()
+	if err != nil {
+		return nil, err
+	}
+
+	stateSenderInstance, err := ls.contractConnector.GetStateSenderInstance(
+		rootchainContext.ChainmanagerParams.ChainParams.StateSenderAddress.EthAddress(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	counter := ls.contractConnector.CurrentStateCounter(stateSenderInstance)
+	if counter == nil {
+		return nil, fmt.Errorf("unable to fetch state counter from state sender contract")
+	}
+
+	return counter, nil
+}
+
+// getStateSync returns the StateSynced event based on the given state ID
+func (ls *logScanner) getStateSync(ctx context.Context, stateId int64) (*types.Log, error) {
+	if stateId < 0 {
+		return nil, fmt.Errorf("state id value out of range for uint64: %d", stateId)
+	}
+
+	rootchainContext, err := ls.rootChainContext()
+	if err != nil {
+		return nil, err
+	}
+
+	stateSynced, err := ls.findLog(
+		ctx,
+		shStateSyncedKey,
+		rootchainContext.ChainmanagerParams.ChainParams.StateSenderAddress.EthAddress(),
+		[][]common.Hash{{ls.stateSyncedID}},
+		1, // StateSynced(uint256 indexed id, ...)
+		uint64(stateId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if stateSynced == nil {
+		return nil, fmt.Errorf("no state sync found for state id %d", stateId)
+	}
+
+	return stateSynced, nil
+}
+
+// getLatestNonce returns the nonce of the validator in the staking info contract
+func (ls *logScanner) getLatestNonce(ctx context.Context, validatorId uint64) (uint64, error) {
+	rootchainContext, err := ls.rootChainContext()
+	if err != nil {
+		return 0, err
+	}
+
+	stakingInfoInstance, err := ls.contractConnector.GetStakingInfoInstance(
+		rootchainContext.ChainmanagerParams.ChainParams.StakingInfoAddress.EthAddress(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	nonce, err := stakingInfoInstance.ValidatorNonce(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(validatorId))
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch validator nonce from staking info contract: %w", err)
+	}
+
+	if !nonce.IsUint64() {
+		return 0, fmt.Errorf("validator nonce value out of range for uint64: %s", nonce)
+	}
+
+	return nonce.Uint64(), nil
+}
+
+// getStakeUpdate returns StakeUpdate event based on the given validator ID and nonce
+func (ls *logScanner) getStakeUpdate(ctx context.Context, validatorId, nonce uint64) (*types.Log, error) {
+	rootchainContext, err := ls.rootChainContext()
+	if err != nil {
+		return nil, err
+	}
+
+	stakeUpdate, err := ls.findLog(
+		ctx,
+		fmt.Sprintf("%s%d", shStakeUpdateKeyPrefix, validatorId),
+		rootchainContext.ChainmanagerParams.ChainParams.StakingInfoAddress.EthAddress(),
+		[][]common.Hash{{ls.stakeUpdateID}, {common.BigToHash(new(big.Int).SetUint64(validatorId))}},
+		2, // StakeUpdate(uint256 indexed validatorId, uint256 indexed nonce, ...)
+		nonce,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if stakeUpdate == nil {
+		return nil, fmt.Errorf("no stake update found for validator %d and nonce %d", validatorId, nonce)
+	}
+
+	return stakeUpdate, nil
+}
+
+// findLog scans the logs of address matching topics, up to the finalized block, for the one
+// with the counter (state id or nonce) indexed at counterTopic. The counters grow with the blocks,
+// so the scan resumes from the checkpoint stored at key, which is moved forward as it goes:
+// past the scanned blocks for a new counter, and from the block of the counter last found
+// for a counter the scan went past already.
+// It returns nil if no log is found.
+func (ls *logScanner) findLog(ctx context.Context, key string, address common.Address, topics [][]common.Hash, counterTopic int, counter uint64) (*types.Log, error) {
+	checkpoint, err := ls.loadCheckpoint(key)
+	if err != nil {
+		return nil, err
+	}
+
+	fromBlock := ls.startBlock
+
+	if checkpoint == nil {
+		checkpoint = &logScanCheckpoint{}
+	} else {
+		switch {
+		case counter > checkpoint.Counter:
+			fromBlock = checkpoint.Scanned + 1
+		case counter == checkpoint.Counter:
+			fromBlock = checkpoint.Block
+		case checkpoint.FoundBlock != 0 && counter >= checkpoint.Found:
+			fromBlock = checkpoint.FoundBlock
+		}
+	}
+
+	finalized, err := ls.contractConnector.MainChainClient.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch finalized block: %w", err)
+	}
+
+	toBlock := finalized.Number.Uint64()
+
+	for from := fromBlock; from <= toBlock; from += ls.blockRange {
+		to := from + ls.blockRange - 1
+		if to > toBlock {
+			to = toBlock
+		}
+
+		logs, err := ls.filterLogs(ctx, from, to, address, topics)
+		if err != nil {
+			return nil, err
+		}
+
+		var found *types.Log
+
+		for i := range logs {
+			vLog := &logs[i]
+			if vLog.Removed || len(vLog.Topics) <= counterTopic {
+				continue
+			}
+
+			logCounter := new(big.Int).SetBytes(vLog.Topics[counterTopic].Bytes()).Uint64()
+			if logCounter == counter {
+				found = vLog
+				checkpoint.Found = logCounter
+				checkpoint.FoundBlock = vLog.BlockNumber
+			}
+
+			if logCounter >= checkpoint.Counter {
+				checkpoint.Counter = logCounter
+				checkpoint.Block = vLog.BlockNumber
+			}
+		}
+
+		if to > checkpoint.Scanned {
+			checkpoint.Scanned = to
+		}
+
+		if err = ls.storeCheckpoint(key, checkpoint); err != nil {
+			ls.logger.Error("Error while storing self-healing log scan checkpoint", "key", key, "error", err)
+		}
+
+		if found != nil {
+			return found, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// filterLogs fetches the logs of address matching topics within the block range
+func (ls *logScanner) filterLogs(ctx context.Context, fromBlock, toBlock uint64, address common.Address, topics [][]common.Hash) ([]types.Log, error) {
+	ctx, cancel := context.WithTimeout(ctx, ls.contractConnector.MainChainTimeout)
+	defer cancel()
+
+	ls.logger.Debug("Scanning rootchain logs to self-heal", "fromBlock", fromBlock, "toBlock", toBlock, "address", address)
+
+	logs, err := ls.contractConnector.MainChainClient.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: []common.Address{address},
+		Topics:    topics,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to filter logs from block %d to %d: %w", fromBlock, toBlock, err)
+	}
+
+	return logs, nil
+}
+
+// loadCheckpoint returns the log scan checkpoint stored at key, nil if none
+func (ls *logScanner) loadCheckpoint(key string) (*logScanCheckpoint, error) {
+	data, err := ls.storageClient.Get([]byte(key), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var checkpoint logScanCheckpoint
+	if err = json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("invalid self-healing log scan checkpoint %s: %w", key, err)
+	}
+
+	return &checkpoint, nil
+}
+
+// storeCheckpoint stores the log scan checkpoint at key
+func (ls *logScanner) storeCheckpoint(key string, checkpoint *logScanCheckpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	return ls.storageClient.Put([]byte(key), data, nil)
+}
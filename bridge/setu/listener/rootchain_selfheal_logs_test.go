@@ -0,0 +1,376 @@
+package listener
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/zenanetwork/go-zenanet/common"
+	"github.com/zenanetwork/go-zenanet/common/hexutil"
+	"github.com/zenanetwork/go-zenanet/core/types"
+	"github.com/zenanetwork/go-zenanet/ethclient"
+	"github.com/zenanetwork/go-zenanet/rpc"
+
+	"github.com/zenanetwork/iris/helper"
+)
+
+// fakeL1 serves the eth_getLogs and eth_getBlockByNumber calls of the self-healing
+type fakeL1 struct {
+	mtx sync.Mutex
+
+	finalized uint64
+	logs      []types.Log
+
+	// time of the blocks, blockTime for the ones not set
+	blockTime  time.Time
+	blockTimes map[uint64]time.Time
+
+	// returned by eth_getLogs if set
+	filterErr error
+
+	// block ranges of the eth_getLogs calls
+	ranges [][2]uint64
+}
+
+type fakeFilterQuery struct {
+	FromBlock hexutil.Uint64   `json:"fromBlock"`
+	ToBlock   hexutil.Uint64   `json:"toBlock"`
+	Addresses []common.Address `json:"address"`
+	Topics    [][]common.Hash  `json:"topics"`
+}
+
+func (f *fakeL1) GetLogs(_ context.Context, q fakeFilterQuery) ([]types.Log, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.ranges = append(f.ranges, [2]uint64{uint64(q.FromBlock), uint64(q.ToBlock)})
+
+	if f.filterErr != nil {
+		return nil, f.filterErr
+	}
+
+	logs := []types.Log{}
+
+	for _, vLog := range f.logs {
+		if vLog.BlockNumber < uint64(q.FromBlock) || vLog.BlockNumber > uint64(q.ToBlock) {
+			continue
+		}
+
+		if len(q.Addresses) > 0 && vLog.Address != q.Addresses[0] {
+			continue
+		}
+
+		if matchTopics(vLog.Topics, q.Topics) {
+			logs = append(logs, vLog)
+		}
+	}
+
+	return logs, nil
+}
+
+// matchTopics matches the topics of a log with the ones of a filter, any of a set at each position
+func matchTopics(topics []common.Hash, filter [][]common.Hash) bool {
+	for i, set := range filter {
+		if len(set) == 0 {
+			continue
+		}
+
+		if i >= len(topics) {
+			return false
+		}
+
+		match := false
+
+		for _, topic := range set {
+			if topics[i] == topic {
+				match = true
+			}
+		}
+
+		if !match {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *fakeL1) GetBlockByNumber(_ context.Context, number rpc.BlockNumber, _ bool) (map[string]interface{}, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	height := uint64(number.Int64())
+	if number == rpc.FinalizedBlockNumber {
+		height = f.finalized
+	}
+
+	blockTime, ok := f.blockTimes[height]
+	if !ok {
+		blockTime = f.blockTime
+	}
+
+	header := &types.Header{
+		Number:     new(big.Int).SetUint64(height),
+		Time:       uint64(blockTime.Unix()),
+		Difficulty: big.NewInt(0),
+		TxHash:     types.EmptyTxsHash,
+		UncleHash:  types.EmptyUncleHash,
+	}
+
+	bz, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var block map[string]interface{}
+	if err = json.Unmarshal(bz, &block); err != nil {
+		return nil, err
+	}
+
+	block["hash"] = header.Hash()
+	block["transactions"] = []interface{}{}
+	block["uncles"] = []interface{}{}
+
+	return block, nil
+}
+
+// takeRanges returns the block ranges of the eth_getLogs calls since the last take
+func (f *fakeL1) takeRanges() [][2]uint64 {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	ranges := f.ranges
+	f.ranges = nil
+
+	return ranges
+}
+
+// contractCaller returns a contract caller with a main chain client served by the fake
+func (f *fakeL1) contractCaller(t *testing.T) helper.ContractCaller {
+	t.Helper()
+
+	server := rpc.NewServer("", 0, 0)
+	require.NoError(t, server.RegisterName("eth", f))
+
+	client := rpc.DialInProc(server)
+
+	t.Cleanup(func() {
+		client.Close()
+		server.Stop()
+	})
+
+	return helper.ContractCaller{MainChainClient: ethclient.NewClient(client), MainChainTimeout: time.Second}
+}
+
+func newTestStorage(t *testing.T) *leveldb.DB {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	return db
+}
+
+// counterLog returns a log of the event with the counter indexed as its first topic
+func counterLog(address common.Address, eventID common.Hash, counter uint64, block uint64) types.Log {
+	return types.Log{
+		Address:     address,
+		Topics:      []common.Hash{eventID, common.BigToHash(new(big.Int).SetUint64(counter))},
+		BlockNumber: block,
+		TxHash:      common.BigToHash(new(big.Int).SetUint64(block<<8 | counter)),
+	}
+}
+
+func TestFindLog(t *testing.T) {
+	t.Parallel()
+
+	var (
+		address      = common.HexToAddress("0x1")
+		otherAddress = common.HexToAddress("0x2")
+		eventID      = common.HexToHash("0x10")
+		otherEventID = common.HexToHash("0x20")
+	)
+
+	removed := counterLog(address, eventID, 6, 240)
+	removed.Removed = true
+
+	// counters 1 to 5 from block 105 to 230, scanned from block 100 by ranges of 50 blocks
+	logs := []types.Log{
+		counterLog(address, eventID, 1, 105),
+		counterLog(address, eventID, 2, 120),
+		counterLog(address, eventID, 3, 120),
+		counterLog(otherAddress, eventID, 4, 130),
+		counterLog(address, otherEventID, 4, 140),
+		counterLog(address, eventID, 4, 150),
+		counterLog(address, eventID, 5, 230),
+		removed,
+	}
+
+	testCases := []struct {
+		name       string
+		checkpoint *logScanCheckpoint
+		filterErr  error
+
+		counter uint64
+
+		found  uint64 // block of the log found, zero if none
+		err    bool
+		ranges [][2]uint64
+		stored *logScanCheckpoint // after the scan
+	}{
+		{
+			name:    "first scan from the start block",
+			counter: 3,
+			found:   120,
+			ranges:  [][2]uint64{{100, 149}},
+			stored:  &logScanCheckpoint{Counter: 3, Block: 120, Scanned: 149, Found: 3, FoundBlock: 120},
+		},
+		{
+			name:       "new counter resumes past the scanned blocks",
+			checkpoint: &logScanCheckpoint{Counter: 3, Block: 120, Scanned: 149, Found: 3, FoundBlock: 120},
+			counter:    5,
+			found:      230,
+			ranges:     [][2]uint64{{150, 199}, {200, 249}},
+			stored:     &logScanCheckpoint{Counter: 5, Block: 230, Scanned: 249, Found: 5, FoundBlock: 230},
+		},
+		{
+			name:       "latest counter resumes from its block",
+			checkpoint: &logScanCheckpoint{Counter: 4, Block: 150, Scanned: 199, Found: 3, FoundBlock: 120},
+			counter:    4,
+			found:      150,
+			ranges:     [][2]uint64{{150, 199}},
+			stored:     &logScanCheckpoint{Counter: 4, Block: 150, Scanned: 199, Found: 4, FoundBlock: 150},
+		},
+		{
+			name:       "skipped counter resumes from the block of the counter last found",
+			checkpoint: &logScanCheckpoint{Counter: 5, Block: 230, Scanned: 249, Found: 2, FoundBlock: 120},
+			counter:    4,
+			found:      150,
+			ranges:     [][2]uint64{{120, 169}},
+			stored:     &logScanCheckpoint{Counter: 5, Block: 230, Scanned: 249, Found: 4, FoundBlock: 150},
+		},
+		{
+			name:       "counter before the one last found is scanned from the start block",
+			checkpoint: &logScanCheckpoint{Counter: 5, Block: 230, Scanned: 249, Found: 3, FoundBlock: 120},
+			counter:    1,
+			found:      105,
+			ranges:     [][2]uint64{{100, 149}},
+			stored:     &logScanCheckpoint{Counter: 5, Block: 230, Scanned: 249, Found: 1, FoundBlock: 105},
+		},
+		{
+			name:       "removed log is not found up to the finalized block",
+			checkpoint: &logScanCheckpoint{Counter: 4, Block: 150, Scanned: 229, Found: 4, FoundBlock: 150},
+			counter:    6,
+			ranges:     [][2]uint64{{230, 250}},
+			stored:     &logScanCheckpoint{Counter: 5, Block: 230, Scanned: 250, Found: 4, FoundBlock: 150},
+		},
+		{
+			name:       "filter error keeps the checkpoint",
+			checkpoint: &logScanCheckpoint{Counter: 3, Block: 120, Scanned: 149, Found: 3, FoundBlock: 120},
+			filterErr:  errors.New("rate limited"),
+			counter:    4,
+			err:        true,
+			ranges:     [][2]uint64{{150, 199}},
+			stored:     &logScanCheckpoint{Counter: 3, Block: 120, Scanned: 149, Found: 3, FoundBlock: 120},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			l1 := &fakeL1{finalized: 250, logs: logs, filterErr: tc.filterErr}
+
+			ls := &logScanner{
+				logger:            log.NewNopLogger(),
+				contractConnector: l1.contractCaller(t),
+				storageClient:     newTestStorage(t),
+				startBlock:        100,
+				blockRange:        50,
+			}
+
+			const key = "test-scan"
+
+			if tc.checkpoint != nil {
+				require.NoError(t, ls.storeCheckpoint(key, tc.checkpoint))
+			}
+
+			vLog, err := ls.findLog(context.Background(), key, address, [][]common.Hash{{eventID}}, 1, tc.counter)
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.found == 0 {
+				require.Nil(t, vLog)
+			} else {
+				require.NotNil(t, vLog)
+				require.Equal(t, tc.found, vLog.BlockNumber)
+				require.Equal(t, common.BigToHash(new(big.Int).SetUint64(tc.counter)), vLog.Topics[1])
+			}
+
+			require.Equal(t, tc.ranges, l1.takeRanges())
+
+			checkpoint, err := ls.loadCheckpoint(key)
+			require.NoError(t, err)
+			require.Equal(t, tc.stored, checkpoint)
+		})
+	}
+}
+
+// TestFindLogResume checks the scans of successive counters against the checkpoint they store
+func TestFindLogResume(t *testing.T) {
+	t.Parallel()
+
+	address := common.HexToAddress("0x1")
+	eventID := common.HexToHash("0x10")
+
+	l1 := &fakeL1{finalized: 120}
+
+	ls := &logScanner{
+		logger:            log.NewNopLogger(),
+		contractConnector: l1.contractCaller(t),
+		storageClient:     newTestStorage(t),
+		startBlock:        1,
+		blockRange:        100,
+	}
+
+	find := func(counter uint64) *types.Log {
+		vLog, err := ls.findLog(context.Background(), "test-scan", address, [][]common.Hash{{eventID}}, 1, counter)
+		require.NoError(t, err)
+
+		return vLog
+	}
+
+	// not emitted yet, the blocks up to the finalized one are scanned
+	require.Nil(t, find(1))
+	require.Equal(t, [][2]uint64{{1, 100}, {101, 120}}, l1.takeRanges())
+
+	// emitted past the scanned blocks
+	l1.mtx.Lock()
+	l1.finalized = 200
+	l1.logs = []types.Log{counterLog(address, eventID, 1, 150), counterLog(address, eventID, 2, 160)}
+	l1.mtx.Unlock()
+
+	require.Equal(t, uint64(150), find(1).BlockNumber)
+	require.Equal(t, [][2]uint64{{121, 200}}, l1.takeRanges())
+
+	// the next counter was seen by the last scan, it resumes from its block
+	require.Equal(t, uint64(160), find(2).BlockNumber)
+	require.Equal(t, [][2]uint64{{160, 200}}, l1.takeRanges())
+}
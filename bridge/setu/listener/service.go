@@ -1,7 +1,12 @@
 package listener
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/tendermint/tendermint/libs/common"
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/zenanetwork/iris/bridge/setu/queue"
@@ -79,3 +84,31 @@ func (listenerService *ListenerService) OnStop() {
 
 	listenerService.Logger.Info("all listeners stopped")
 }
+
+// LastBlocks returns the last block processed by each listener keeping it in the bridge db
+func LastBlocks(db *leveldb.DB) (map[string]uint64, error) {
+	keys := map[string]string{
+		RootChainListenerStr: lastRootBlockKey,
+		IrisListenerStr:      irisLastBlockKey,
+	}
+
+	blocks := make(map[string]uint64, len(keys))
+
+	for name, key := range keys {
+		value, err := db.Get([]byte(key), nil)
+		if errors.Is(err, leveldb.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		block, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid last block of %s listener: %w", name, err)
+		}
+
+		blocks[name] = block
+	}
+
+	return blocks, nil
+}
@@ -105,49 +105,18 @@ func (bp *BaseProcessor) Stop() {
 	// override to stop any go-routines in individual processors
 }
 
-// isOldTx checks if the transaction already exists in the chain or not
+// isOldTx checks if the transaction already exists in the chain or not, or is skipped
 // It is a generic function, which is consumed in all processors
 func (bp *BaseProcessor) isOldTx(_ cliContext.CLIContext, txHash string, logIndex uint64, eventType util.BridgeEvent, event interface{}) (bool, error) {
 	defer util.LogElapsedTimeForStateSyncedEvent(event, "isOldTx", time.Now())
 
-	queryParam := map[string]interface{}{
-		"txhash":   txHash,
-		"logindex": logIndex,
+	// the logs skipped through the bridge admin API are treated as processed
+	if util.IsLogSkipped(bp.storageClient, txHash, logIndex) {
+		bp.Logger.Info("Skipping log", "txHash", txHash, "logIndex", logIndex)
+		return true, nil
 	}
 
-	// define the endpoint based on the type of event
-	var endpoint string
-
-	switch eventType {
-	case util.StakingEvent:
-		endpoint = helper.GetIrisServerEndpoint(util.StakingTxStatusURL)
-	case util.TopupEvent:
-		endpoint = helper.GetIrisServerEndpoint(util.TopupTxStatusURL)
-	case util.ClerkEvent:
-		endpoint = helper.GetIrisServerEndpoint(util.ClerkTxStatusURL)
-	case util.SlashingEvent:
-		endpoint = helper.GetIrisServerEndpoint(util.SlashingTxStatusURL)
-	}
-
-	url, err := util.CreateURLWithQuery(endpoint, queryParam)
-	if err != nil {
-		bp.Logger.Error("Error in creating url", "endpoint", endpoint, "error", err)
-		return false, err
-	}
-
-	res, err := helper.FetchFromAPI(bp.cliCtx, url)
-	if err != nil {
-		bp.Logger.Error("Error fetching tx status", "url", url, "error", err)
-		return false, err
-	}
-
-	var status bool
-	if err := jsoniter.ConfigFastest.Unmarshal(res.Result, &status); err != nil {
-		bp.Logger.Error("Error unmarshalling tx status received from Iris Server", "error", err)
-		return false, err
-	}
-
-	return status, nil
+	return util.IsOldTx(bp.cliCtx, txHash, logIndex, eventType)
 }
 
 // checkTxAgainstMempool checks if the transaction is already in the mempool or not
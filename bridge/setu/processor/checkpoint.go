@@ -18,6 +18,7 @@ import (
 	"github.com/zenanetwork/go-zenanet/core/types"
 
 	authTypes "github.com/zenanetwork/iris/auth/types"
+	"github.com/zenanetwork/iris/bridge/setu/txmgr"
 	"github.com/zenanetwork/iris/bridge/setu/util"
 	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
 	checkpointTypes "github.com/zenanetwork/iris/checkpoint/types"
@@ -37,6 +38,9 @@ type CheckpointProcessor struct {
 
 	// Rootchain abi
 	rootchainAbi *abi.ABI
+
+	// mainchain tx manager
+	txManager *txmgr.Manager
 }
 
 // Result represents single req result
@@ -51,9 +55,10 @@ type CheckpointContext struct {
 }
 
 // NewCheckpointProcessor - add rootchain abi to checkpoint processor
-func NewCheckpointProcessor(rootchainAbi *abi.ABI) *CheckpointProcessor {
+func NewCheckpointProcessor(rootchainAbi *abi.ABI, txManager *txmgr.Manager) *CheckpointProcessor {
 	return &CheckpointProcessor{
 		rootchainAbi: rootchainAbi,
+		txManager:    txManager,
 	}
 }
 
@@ -74,15 +79,15 @@ func (cp *CheckpointProcessor) Start() error {
 func (cp *CheckpointProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering checkpoint tasks")
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToIris", cp.sendCheckpointToIris); err != nil {
+	if err := cp.registerTask("sendCheckpointToIris", cp.sendCheckpointToIris); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToIris", "error", err)
 	}
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
+	if err := cp.registerTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToRootchain", "error", err)
 	}
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointAckToIris", cp.sendCheckpointAckToIris); err != nil {
+	if err := cp.registerTask("sendCheckpointAckToIris", cp.sendCheckpointAckToIris); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointAckToIris", "error", err)
 	}
 }
@@ -249,7 +254,7 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 
 // sendCheckpointAckToIris - handles checkpointAck event from rootchain
 // 1. create and broadcast checkpointAck msg to iris.
-func (cp *CheckpointProcessor) sendCheckpointAckToIris(eventName string, checkpointAckStr string) error {
+func (cp *CheckpointProcessor) sendCheckpointAckToIris(ctx context.Context, eventName string, checkpointAckStr string) error {
 	// fetch checkpoint context
 	checkpointContext, err := cp.getCheckpointContext()
 	if err != nil {
@@ -300,9 +305,10 @@ func (cp *CheckpointProcessor) sendCheckpointAckToIris(eventName string, checkpo
 			hmTypes.BytesToIrisHash(log.TxHash.Bytes()),
 			uint64(log.Index),
 		)
+		msg.BlockNumber = log.BlockNumber
 
 		// return broadcast to iris
-		txRes, err := cp.txBroadcaster.BroadcastToIris(msg, event)
+		txRes, err := cp.txBroadcaster.BroadcastToIrisWithContext(ctx, msg, event)
 		if err != nil {
 			cp.Logger.Error("Error while broadcasting checkpoint-ack to iris", "error", err)
 			return err
@@ -393,8 +399,31 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *Checkpo
 
 	// get diff
 	diff := latestChildBlock - start + 1
-	// process if diff > 0 (positive)
-	if diff > 0 {
+
+	adaptive := checkpointParams.AdaptiveCheckpoint
+	if adaptive.Enabled {
+		// range chosen from the zena block rate and the L1 base fee
+		next, err := checkpointTypes.NextAdaptiveCheckpoint(*checkpointParams, start, latestChildBlock, &cp.contractConnector)
+		if err != nil {
+			cp.Logger.Error("Error while choosing the adaptive checkpoint range", "error", err)
+			return nil, err
+		}
+
+		if next.Ready {
+			end = next.EndBlock
+		}
+
+		cp.Logger.Debug("Calculating adaptive checkpoint eligibility",
+			"latest", latestChildBlock,
+			"start", start,
+			"end", next.EndBlock,
+			"ready", next.Ready,
+			"sampleBlocks", next.SampleBlocks,
+			"sampleDuration", next.SampleDuration,
+			"baseFee", next.BaseFee,
+		)
+	} else if diff > 0 {
+		// process if diff > 0 (positive)
 		expectedDiff := diff - diff%checkpointParams.AvgCheckpointLength
 		if expectedDiff > 0 {
 			expectedDiff = expectedDiff - 1
@@ -412,12 +441,20 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *Checkpo
 		)
 	}
 
+	forcePush := end == 0 || end == start || (0 < diff && diff < checkpointParams.AvgCheckpointLength)
+	defaultForcePushInterval := checkpointParams.MaxCheckpointLength * 2 // in seconds (1024 * 2 seconds)
+
+	// shorter adaptive checkpoints are accepted by the side handler two target intervals after the last one
+	if adaptive.Enabled {
+		forcePush = end == 0 || end == start
+		defaultForcePushInterval = uint64(2 * adaptive.TargetInterval / time.Second)
+	}
+
 	// Handle when block producers go down
-	if end == 0 || end == start || (0 < diff && diff < checkpointParams.AvgCheckpointLength) {
+	if forcePush {
 		cp.Logger.Debug("Fetching last header block to calculate time")
 
 		currentTime := time.Now().UTC().Unix()
-		defaultForcePushInterval := checkpointParams.MaxCheckpointLength * 2 // in seconds (1024 * 2 seconds)
 
 		//nolint:gosec
 		if currentTime-int64(lastCheckpointTime) > int64(defaultForcePushInterval) {
@@ -551,17 +588,20 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(checkpointCont
 		chainParams := checkpointContext.ChainmanagerParams.ChainParams
 		// root chain address
 		rootChainAddress := chainParams.RootChainAddress.EthAddress()
-		// root chain instance
-		rootChainInstance, err := cp.contractConnector.GetRootChainInstance(rootChainAddress)
+
+		data, err := cp.rootchainAbi.Pack("submitCheckpoint", sideTxData, sigs)
 		if err != nil {
-			cp.Logger.Info("Error while creating rootchain instance", "error", err)
+			cp.Logger.Error("Unable to pack tx for submitCheckpoint", "error", err)
 			return err
 		}
 
-		if err := cp.contractConnector.SendCheckpoint(sideTxData, sigs, rootChainAddress, rootChainInstance); err != nil {
+		txHash, err := cp.txManager.SubmitCheckpoint(rootChainAddress, data, checkpointContext.CheckpointParams.ChildBlockInterval)
+		if err != nil {
 			cp.Logger.Info("Error submitting checkpoint to rootchain", "error", err)
 			return err
 		}
+
+		cp.Logger.Info("Submitted new checkpoint to rootchain successfully", "txHash", txHash.String())
 	}
 
 	return nil
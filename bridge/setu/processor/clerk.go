@@ -3,12 +3,14 @@ package processor
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	jsoniter "github.com/json-iterator/go"
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/zenanetwork/go-zenanet/accounts/abi"
 	"github.com/zenanetwork/go-zenanet/core/types"
@@ -50,7 +52,7 @@ func (cp *ClerkProcessor) Start() error {
 func (cp *ClerkProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering clerk tasks")
 
-	if err := cp.queueConnector.Server.RegisterTask("sendStateSyncedToIris", cp.sendStateSyncedToIris); err != nil {
+	if err := cp.registerTask("sendStateSyncedToIris", cp.sendStateSyncedToIris); err != nil {
 		cp.Logger.Error("RegisterTasks | sendStateSyncedToIris", "error", err)
 	}
 }
@@ -58,11 +60,9 @@ func (cp *ClerkProcessor) RegisterTasks() {
 // HandleStateSyncEvent - handle state sync event from rootchain
 // 1. check if this deposit event has to be broadcasted to iris
 // 2. create and broadcast  record transaction to iris
-func (cp *ClerkProcessor) sendStateSyncedToIris(eventName string, logBytes string) error {
-	otelCtx := tracing.WithTracer(context.Background(), otel.Tracer("State-Sync"))
-	// work begins
-	sendStateSyncedToIrisCtx, sendStateSyncedToIrisSpan := tracing.StartSpan(otelCtx, "sendStateSyncedToIris")
-	defer tracing.EndSpan(sendStateSyncedToIrisSpan)
+func (cp *ClerkProcessor) sendStateSyncedToIris(ctx context.Context, eventName string, logBytes string) error {
+	// span of the task, continuing the trace of the event log
+	sendStateSyncedToIrisSpan := trace.SpanFromContext(ctx)
 
 	start := time.Now()
 
@@ -91,7 +91,7 @@ func (cp *ClerkProcessor) sendStateSyncedToIris(eventName string, logBytes strin
 			attribute.String("contract", event.ContractAddress.String()),
 		}...)
 
-		_, isOldTxSpan := tracing.StartSpan(sendStateSyncedToIrisCtx, "isOldTx")
+		_, isOldTxSpan := tracing.StartSpan(ctx, "isOldTx")
 		isOld, _ := cp.isOldTx(cp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.ClerkEvent, event)
 		tracing.EndSpan(isOldTxSpan)
 
@@ -122,8 +122,27 @@ func (cp *ClerkProcessor) sendStateSyncedToIris(eventName string, logBytes strin
 			"blockNumber", vLog.BlockNumber,
 		)
 
-		_, maxStateSyncSizeCheckSpan := tracing.StartSpan(sendStateSyncedToIrisCtx, "maxStateSyncSizeCheck")
-		if util.GetBlockHeight(cp.cliCtx) > helper.GetSpanOverrideHeight() && len(event.Data) > helper.MaxStateSyncSize {
+		_, maxStateSyncSizeCheckSpan := tracing.StartSpan(ctx, "maxStateSyncSizeCheck")
+
+		spanOverride, err := util.GetUpgradePlan(cp.cliCtx, helper.SpanOverrideUpgrade)
+		if err != nil {
+			tracing.EndSpan(maxStateSyncSizeCheckSpan)
+			return err
+		}
+
+		if util.GetBlockHeight(cp.cliCtx) > spanOverride.Height && len(event.Data) > helper.MaxStateSyncSize {
+			// records up to the max chunked record size are synced in chunks, with their data
+			clerkParams, err := util.GetClerkParams(cp.cliCtx)
+			if err != nil {
+				tracing.EndSpan(maxStateSyncSizeCheckSpan)
+				return err
+			}
+
+			if clerkParams.IsChunked(uint64(len(event.Data))) {
+				tracing.EndSpan(maxStateSyncSizeCheckSpan)
+				return cp.sendStateSyncedChunksToIris(ctx, *clerkParams, vLog, event, chainParams.ZenaChainID)
+			}
+
 			cp.Logger.Info(`Data is too large to process, Resetting to ""`, "data", hex.EncodeToString(event.Data))
 			event.Data = hmTypes.HexToHexBytes("")
 		} else if len(event.Data) > helper.LegacyMaxStateSyncSize {
@@ -143,7 +162,7 @@ func (cp *ClerkProcessor) sendStateSyncedToIris(eventName string, logBytes strin
 			chainParams.ZenaChainID,
 		)
 
-		_, checkTxAgainstMempoolSpan := tracing.StartSpan(sendStateSyncedToIrisCtx, "checkTxAgainstMempool")
+		_, checkTxAgainstMempoolSpan := tracing.StartSpan(ctx, "checkTxAgainstMempool")
 		// Check if we have the same transaction in mempool or not
 		// Don't drop the transaction. Keep retrying after `util.RetryStateSyncTaskDelay = 24 seconds`,
 		// until the transaction in mempool is processed or cancelled.
@@ -155,10 +174,8 @@ func (cp *ClerkProcessor) sendStateSyncedToIris(eventName string, logBytes strin
 			return tasks.NewErrRetryTaskLater("transaction already in mempool", util.RetryStateSyncTaskDelay)
 		}
 
-		_, BroadcastToIrisSpan := tracing.StartSpan(sendStateSyncedToIrisCtx, "BroadcastToIris")
 		// return broadcast to iris
-		_, err = cp.txBroadcaster.BroadcastToIris(msg, event)
-		tracing.EndSpan(BroadcastToIrisSpan)
+		_, err = cp.txBroadcaster.BroadcastToIrisWithContext(ctx, msg, event)
 
 		if err != nil {
 			cp.Logger.Error("Error while broadcasting clerk Record to iris", "error", err)
@@ -184,3 +201,48 @@ func (cp *ClerkProcessor) getClerkContext() (*ClerkContext, error) {
 		ChainmanagerParams: chainmanagerParams,
 	}, nil
 }
+
+// sendStateSyncedChunksToIris broadcasts the data of a state synced event in chunks of the chunk size.
+// The chunks already synced are skipped, the record being assembled once the last chunk is synced.
+func (cp *ClerkProcessor) sendStateSyncedChunksToIris(ctx context.Context, params clerkTypes.Params, vLog types.Log, event *statesender.StatesenderStateSynced, zenaChainID string) error {
+	chunkCount := params.ChunkCount(uint64(len(event.Data)))
+
+	cp.Logger.Info("Data is too large for a single record, syncing it in chunks", "id", event.Id, "size", len(event.Data), "chunks", chunkCount)
+
+	for i := uint64(0); i < chunkCount; i++ {
+		end := (i + 1) * params.ChunkSize
+		if end > uint64(len(event.Data)) {
+			end = uint64(len(event.Data))
+		}
+
+		msg := clerkTypes.NewMsgEventRecordChunk(
+			hmTypes.BytesToIrisAddress(helper.GetAddress()),
+			hmTypes.BytesToIrisHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+			event.Id.Uint64(),
+			hmTypes.BytesToIrisAddress(event.ContractAddress.Bytes()),
+			event.Data[i*params.ChunkSize:end],
+			zenaChainID,
+			i,
+			chunkCount,
+		)
+
+		txRes, err := cp.txBroadcaster.BroadcastToIrisWithContext(ctx, msg, event)
+		if err != nil {
+			cp.Logger.Error("Error while broadcasting clerk record chunk to iris", "id", event.Id, "chunkIndex", i, "error", err)
+			return err
+		}
+
+		if txRes.Code == uint32(clerkTypes.CodeChunkAlreadySynced) {
+			cp.Logger.Debug("Clerk record chunk already synced", "id", event.Id, "chunkIndex", i)
+			continue
+		}
+
+		if txRes.Code != uint32(sdk.CodeOK) {
+			return fmt.Errorf("broadcast of clerk record chunk %d of record %d failed with code %d", i, event.Id, txRes.Code)
+		}
+	}
+
+	return nil
+}
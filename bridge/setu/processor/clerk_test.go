@@ -2,6 +2,7 @@ package processor
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"io"
 	"math/big"
@@ -355,7 +356,7 @@ func BenchmarkSendStateSyncedToIris(b *testing.B) {
 			// when
 			b.StartTimer()
 
-			if err = cp.sendStateSyncedToIris("StateSynced", dlb.String()); err != nil {
+			if err = cp.sendStateSyncedToIris(context.Background(), "StateSynced", dlb.String()); err != nil {
 				b.Fatal(err)
 			}
 
@@ -435,7 +436,7 @@ func BenchmarkSendTaskWithDelay(b *testing.B) {
 			b.StartTimer()
 			// This will trigger 'error="Set state pending error: dial tcp 127.0.0.1:6379: connect: connection refused'
 			// it's fine as long as we don't want to test the actual sendTask to rabbitmq
-			rcl.SendTaskWithDelay(
+			rcl.SendTaskWithDelay(context.Background(),
 				"sendStateSyncedToIris", "StateSynced",
 				logs.Bytes(), ts[i], nil)
 			b.StopTimer()
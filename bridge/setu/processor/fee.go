@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"context"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,13 +40,13 @@ func (fp *FeeProcessor) Start() error {
 func (fp *FeeProcessor) RegisterTasks() {
 	fp.Logger.Info("Registering fee related tasks")
 
-	if err := fp.queueConnector.Server.RegisterTask("sendTopUpFeeToIris", fp.sendTopUpFeeToIris); err != nil {
+	if err := fp.registerTask("sendTopUpFeeToIris", fp.sendTopUpFeeToIris); err != nil {
 		fp.Logger.Error("RegisterTasks | sendTopUpFeeToIris", "error", err)
 	}
 }
 
 // processTopupFeeEvent - processes topup fee event
-func (fp *FeeProcessor) sendTopUpFeeToIris(eventName string, logBytes string) error {
+func (fp *FeeProcessor) sendTopUpFeeToIris(ctx context.Context, eventName string, logBytes string) error {
 	var vLog = types.Log{}
 	if err := jsoniter.ConfigFastest.Unmarshal([]byte(logBytes), &vLog); err != nil {
 		fp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
@@ -81,7 +82,7 @@ func (fp *FeeProcessor) sendTopUpFeeToIris(eventName string, logBytes string) er
 		msg := topupTypes.NewMsgTopup(helper.GetFromAddress(fp.cliCtx), hmTypes.BytesToIrisAddress(event.User.Bytes()), sdk.NewIntFromBigInt(event.Fee), hmTypes.BytesToIrisHash(vLog.TxHash.Bytes()), uint64(vLog.Index), vLog.BlockNumber)
 
 		// return broadcast to iris
-		txRes, err := fp.txBroadcaster.BroadcastToIris(msg, event)
+		txRes, err := fp.txBroadcaster.BroadcastToIrisWithContext(ctx, msg, event)
 		if err != nil {
 			fp.Logger.Error("Error while broadcasting TopupFee msg to iris", "msg", msg, "error", err)
 			return err
@@ -0,0 +1,91 @@
+package processor
+
+import (
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Results of a processor task
+const (
+	taskSucceeded = "succeeded"
+	taskFailed    = "failed"
+	taskRetried   = "retried"
+)
+
+var (
+	processorTasksReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "iris",
+		Subsystem: "bridge_processor",
+		Name:      "tasks_received_total",
+		Help:      "The total number of tasks received by the processor",
+	}, []string{"processor", "task"})
+
+	processorTasksCompleted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "iris",
+		Subsystem: "bridge_processor",
+		Name:      "tasks_completed_total",
+		Help:      "The total number of tasks run by the processor, by result (succeeded, failed or retried later)",
+	}, []string{"processor", "task", "result"})
+
+	processorTaskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "iris",
+		Subsystem: "bridge_processor",
+		Name:      "task_duration_seconds",
+		Help:      "The time taken by the processor to run a task",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"processor", "task"})
+)
+
+// registerTask registers the task function with machinery under taskName, recording its metrics
+// and its trace
+func (bp *BaseProcessor) registerTask(taskName string, taskFunc interface{}) error {
+	return bp.queueConnector.Server.RegisterTask(taskName, instrumentTask(bp.name, taskName, traceTask(taskName, taskFunc)))
+}
+
+// instrumentTask wraps a machinery task function, which returns an error as its last
+// result, to record the metrics of each run. The wrapper keeps the function type, as
+// machinery passes the task arguments by reflection.
+func instrumentTask(processorName string, taskName string, taskFunc interface{}) interface{} {
+	fn := reflect.ValueOf(taskFunc)
+	if fn.Kind() != reflect.Func || fn.Type().NumOut() == 0 {
+		// let machinery reject it
+		return taskFunc
+	}
+
+	return reflect.MakeFunc(fn.Type(), func(args []reflect.Value) []reflect.Value {
+		start := time.Now()
+
+		processorTasksReceived.WithLabelValues(processorName, taskName).Inc()
+
+		results := fn.Call(args)
+
+		var err error
+		if last := results[len(results)-1]; last.Kind() == reflect.Interface && !last.IsNil() {
+			err, _ = last.Interface().(error)
+		}
+
+		processorTasksCompleted.WithLabelValues(processorName, taskName, taskResult(err)).Inc()
+		processorTaskDuration.WithLabelValues(processorName, taskName).Observe(time.Since(start).Seconds())
+
+		return results
+	}).Interface()
+}
+
+func taskResult(err error) string {
+	if err == nil {
+		return taskSucceeded
+	}
+
+	// machinery retries the tasks failing with a retriable error later
+	var retriable tasks.Retriable
+	if errors.As(err, &retriable) {
+		return taskRetried
+	}
+
+	return taskFailed
+}
@@ -0,0 +1,44 @@
+package processor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrumentTask(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+
+	task := func(eventName string, logBytes string) error {
+		calls = append(calls, eventName+logBytes)
+
+		switch logBytes {
+		case "retry":
+			return tasks.NewErrRetryTaskLater("not yet", time.Second)
+		case "fail":
+			return errors.New("failed")
+		default:
+			return nil
+		}
+	}
+
+	instrumented, ok := instrumentTask("test", "sendTestToIris", task).(func(string, string) error)
+	require.True(t, ok, "instrumented task keeps its type")
+
+	require.NoError(t, instrumented("event", ""))
+	require.Error(t, instrumented("event", "retry"))
+	require.Error(t, instrumented("event", "fail"))
+	require.Equal(t, []string{"event", "eventretry", "eventfail"}, calls)
+
+	require.InDelta(t, 3, testutil.ToFloat64(processorTasksReceived.WithLabelValues("test", "sendTestToIris")), 0)
+
+	for _, result := range []string{taskSucceeded, taskRetried, taskFailed} {
+		require.InDelta(t, 1, testutil.ToFloat64(processorTasksCompleted.WithLabelValues("test", "sendTestToIris", result)), 0, result)
+	}
+}
@@ -22,13 +22,24 @@ import (
 type MilestoneProcessor struct {
 	BaseProcessor
 
+	// zena child chain of the milestones, empty for the chain of the chain params
+	chainID string
+
 	// header listener subscription
 	cancelMilestoneService context.CancelFunc
 }
 
+// NewChildChainMilestoneProcessor returns a milestone processor proposing the milestones of a zena child chain
+func NewChildChainMilestoneProcessor(chainID string) *MilestoneProcessor {
+	return &MilestoneProcessor{
+		chainID: chainID,
+	}
+}
+
 // MilestoneContext represents milestone context
 type MilestoneContext struct {
 	ChainmanagerParams *chainmanagerTypes.Params
+	CheckpointParams   *milestoneTypes.Params
 }
 
 // Start starts new block subscription
@@ -41,9 +52,9 @@ func (mp *MilestoneProcessor) Start() error {
 	mp.cancelMilestoneService = cancelMilestoneService
 
 	// start polling for milestone
-	mp.Logger.Info("Start polling for milestone", "milestoneLength", helper.MilestoneLength, "pollInterval", helper.GetConfig().MilestonePollInterval)
+	mp.Logger.Info("Start polling for milestone", "chainID", mp.chainID, "pollInterval", helper.GetConfig().MilestonePollInterval)
 
-	go mp.startPolling(milestoneCtx, helper.MilestoneLength, helper.GetConfig().MilestonePollInterval)
+	go mp.startPolling(milestoneCtx, helper.GetConfig().MilestonePollInterval)
 	go mp.startPollingMilestoneTimeout(milestoneCtx, 2*helper.GetConfig().MilestonePollInterval)
 
 	return nil
@@ -55,7 +66,7 @@ func (mp *MilestoneProcessor) RegisterTasks() {
 }
 
 // startPolling - polls iris and checks if new milestone needs to be proposed
-func (mp *MilestoneProcessor) startPolling(ctx context.Context, milestoneLength uint64, interval time.Duration) {
+func (mp *MilestoneProcessor) startPolling(ctx context.Context, interval time.Duration) {
 	ticker := time.NewTicker(interval)
 	// stop ticker when everything done
 	defer ticker.Stop()
@@ -63,7 +74,7 @@ func (mp *MilestoneProcessor) startPolling(ctx context.Context, milestoneLength
 	for {
 		select {
 		case <-ticker.C:
-			err := mp.checkAndPropose(milestoneLength)
+			err := mp.checkAndPropose()
 			if err != nil {
 				mp.Logger.Error("Error in proposing the milestone", "error", err)
 			}
@@ -78,10 +89,15 @@ func (mp *MilestoneProcessor) startPolling(ctx context.Context, milestoneLength
 // 1. check if i am the proposer for next milestone
 // 2. check if milestone has to be proposed
 // 3. if so, propose milestone to iris.
-func (mp *MilestoneProcessor) checkAndPropose(milestoneLength uint64) (err error) {
+func (mp *MilestoneProcessor) checkAndPropose() (err error) {
 	//Milestone proposing mechanism will work only after specific block height
-	if util.GetBlockHeight(mp.cliCtx) < helper.GetAalborgHardForkHeight() {
-		mp.Logger.Debug("Block height Less than fork height", "current block height", util.GetBlockHeight(mp.cliCtx), "milestone hard fork height", helper.GetAalborgHardForkHeight())
+	plan, err := util.GetUpgradePlan(mp.cliCtx, helper.AalborgUpgrade)
+	if err != nil {
+		return err
+	}
+
+	if height := util.GetBlockHeight(mp.cliCtx); !plan.IsActive(height) {
+		mp.Logger.Debug("Block height Less than fork height", "current block height", height, "milestone hard fork height", plan.Height)
 		return nil
 	}
 
@@ -99,7 +115,7 @@ func (mp *MilestoneProcessor) checkAndPropose(milestoneLength uint64) (err error
 	}
 
 	if isProposer {
-		result, err := util.GetMilestoneCount(mp.cliCtx)
+		result, err := util.GetMilestoneCount(mp.cliCtx, mp.chainID)
 		if err != nil {
 			return err
 		}
@@ -110,9 +126,14 @@ func (mp *MilestoneProcessor) checkAndPropose(milestoneLength uint64) (err error
 
 		var start = helper.GetMilestoneZenaBlockHeight()
 
+		// the milestones of a child chain start from its first block
+		if mp.chainID != "" {
+			start = 0
+		}
+
 		if result.Count != 0 {
 			// fetch latest milestone
-			latestMilestone, err := util.GetLatestMilestone(mp.cliCtx)
+			latestMilestone, err := util.GetLatestMilestone(mp.cliCtx, mp.chainID)
 			if err != nil {
 				return err
 			}
@@ -126,7 +147,7 @@ func (mp *MilestoneProcessor) checkAndPropose(milestoneLength uint64) (err error
 		}
 
 		//send the milestone to iris chain
-		if err := mp.createAndSendMilestoneToIris(milestoneContext, start, milestoneLength); err != nil {
+		if err := mp.createAndSendMilestoneToIris(milestoneContext, start); err != nil {
 			mp.Logger.Error("Error sending milestone to iris", "error", err)
 			return err
 		}
@@ -138,13 +159,20 @@ func (mp *MilestoneProcessor) checkAndPropose(milestoneLength uint64) (err error
 }
 
 // sendMilestoneToIris - creates milestone msg and broadcasts to iris
-func (mp *MilestoneProcessor) createAndSendMilestoneToIris(milestoneContext *MilestoneContext, startNum uint64, milestoneLength uint64) error {
+func (mp *MilestoneProcessor) createAndSendMilestoneToIris(milestoneContext *MilestoneContext, startNum uint64) error {
+	// milestone length and confirmations are checkpoint params
+	milestoneLength := milestoneContext.CheckpointParams.MilestoneLength
+	blocksConfirmation := milestoneContext.CheckpointParams.MilestoneTxConfirmations
+
 	mp.Logger.Debug("Initiating milestone to Iris", "start", startNum, "milestoneLength", milestoneLength)
 
-	blocksConfirmation := helper.MaticChainMilestoneConfirmation
+	contractCaller, err := chainContractCaller(&mp.contractConnector, mp.chainID)
+	if err != nil {
+		return err
+	}
 
 	// Get latest matic block
-	block, err := mp.contractConnector.GetMaticChainBlock(nil)
+	block, err := contractCaller.GetMaticChainBlock(nil)
 	if err != nil {
 		return err
 	}
@@ -158,7 +186,7 @@ func (mp *MilestoneProcessor) createAndSendMilestoneToIris(milestoneContext *Mil
 	endNum := latestNum - blocksConfirmation
 
 	//fetch the endBlock+1 number instead of endBlock so that we can directly get the hash of endBlock using parent hash
-	block, err = mp.contractConnector.GetMaticChainBlock(big.NewInt(int64(endNum + 1)))
+	block, err = contractCaller.GetMaticChainBlock(big.NewInt(int64(endNum + 1)))
 	if err != nil {
 		return fmt.Errorf("error while fetching block %d: %w", endNum+1, err)
 	}
@@ -177,7 +205,10 @@ func (mp *MilestoneProcessor) createAndSendMilestoneToIris(milestoneContext *Mil
 		"milestoneLength", milestoneLength,
 	)
 
-	chainParams := milestoneContext.ChainmanagerParams.ChainParams
+	zenaChainID := milestoneContext.ChainmanagerParams.ChainParams.ZenaChainID
+	if mp.chainID != "" {
+		zenaChainID = mp.chainID
+	}
 
 	// create and send milestone message
 	msg := milestoneTypes.NewMsgMilestoneBlock(
@@ -185,7 +216,7 @@ func (mp *MilestoneProcessor) createAndSendMilestoneToIris(milestoneContext *Mil
 		startNum,
 		endNum,
 		hmTypes.BytesToIrisHash(endHash[:]),
-		chainParams.ZenaChainID,
+		zenaChainID,
 		milestoneId,
 	)
 
@@ -232,8 +263,13 @@ func (mp *MilestoneProcessor) startPollingMilestoneTimeout(ctx context.Context,
 // 3. if so, propose milestone to iris.
 func (mp *MilestoneProcessor) checkAndProposeMilestoneTimeout() (err error) {
 	//Milestone proposing mechanism will work only after specific block height
-	if util.GetBlockHeight(mp.cliCtx) < helper.GetAalborgHardForkHeight() {
-		mp.Logger.Debug("Block height Less than fork height", "current block height", util.GetBlockHeight(mp.cliCtx), "milestone hard fork height", helper.GetAalborgHardForkHeight())
+	plan, err := util.GetUpgradePlan(mp.cliCtx, helper.AalborgUpgrade)
+	if err != nil {
+		return err
+	}
+
+	if height := util.GetBlockHeight(mp.cliCtx); !plan.IsActive(height) {
+		mp.Logger.Debug("Block height Less than fork height", "current block height", height, "milestone hard fork height", plan.Height)
 		return nil
 	}
 
@@ -275,6 +311,7 @@ func (mp *MilestoneProcessor) createAndSendMilestoneTimeoutToIris() error {
 	msg := milestoneTypes.NewMsgMilestoneTimeout(
 		hmTypes.BytesToIrisAddress(helper.GetAddress()),
 	)
+	msg.ZenaChainID = mp.chainID
 
 	// return broadcast to iris
 	txRes, err := mp.txBroadcaster.BroadcastToIris(msg, nil)
@@ -292,7 +329,7 @@ func (mp *MilestoneProcessor) createAndSendMilestoneTimeoutToIris() error {
 }
 
 func (mp *MilestoneProcessor) checkIfMilestoneTimeoutIsRequired() (bool, error) {
-	latestMilestone, err := util.GetLatestMilestone(mp.cliCtx)
+	latestMilestone, err := util.GetLatestMilestone(mp.cliCtx, mp.chainID)
 	if err != nil || latestMilestone == nil {
 		return false, err
 	}
@@ -304,7 +341,12 @@ func (mp *MilestoneProcessor) checkIfMilestoneTimeoutIsRequired() (bool, error)
 		return false, err
 	}
 
-	if (currentChildBlockNumber - lastMilestoneEndBlock) > helper.MilestoneBufferLength {
+	checkpointParams, err := util.GetCheckpointParams(mp.cliCtx)
+	if err != nil {
+		return false, err
+	}
+
+	if (currentChildBlockNumber - lastMilestoneEndBlock) > checkpointParams.MilestoneBufferLength {
 		return true, nil
 	}
 
@@ -313,7 +355,12 @@ func (mp *MilestoneProcessor) checkIfMilestoneTimeoutIsRequired() (bool, error)
 
 // getCurrentChildBlock gets the current child block
 func (mp *MilestoneProcessor) getCurrentChildBlock() (uint64, error) {
-	childBlock, err := mp.contractConnector.GetMaticChainBlock(nil)
+	contractCaller, err := chainContractCaller(&mp.contractConnector, mp.chainID)
+	if err != nil {
+		return 0, err
+	}
+
+	childBlock, err := contractCaller.GetMaticChainBlock(nil)
 	if err != nil {
 		return 0, err
 	}
@@ -328,8 +375,15 @@ func (mp *MilestoneProcessor) getMilestoneContext() (*MilestoneContext, error) {
 		return nil, err
 	}
 
+	checkpointParams, err := util.GetCheckpointParams(mp.cliCtx)
+	if err != nil {
+		mp.Logger.Error("Error while fetching checkpoint params", "error", err)
+		return nil, err
+	}
+
 	return &MilestoneContext{
 		ChainmanagerParams: chainmanagerParams,
+		CheckpointParams:   checkpointParams,
 	}, nil
 }
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/zenanetwork/iris/bridge/setu/broadcaster"
 	"github.com/zenanetwork/iris/bridge/setu/queue"
+	"github.com/zenanetwork/iris/bridge/setu/txmgr"
 	"github.com/zenanetwork/iris/bridge/setu/util"
 	"github.com/zenanetwork/iris/helper"
 )
@@ -33,6 +34,7 @@ func NewProcessorService(
 	queueConnector *queue.QueueConnector,
 	httpClient *httpClient.HTTP,
 	txBroadcaster *broadcaster.TxBroadcaster,
+	txManager *txmgr.Manager,
 ) *ProcessorService {
 	var logger = util.Logger().With("module", processorServiceStr)
 	// creating processor object
@@ -52,7 +54,7 @@ func NewProcessorService(
 	//
 
 	// initialize checkpoint processor
-	checkpointProcessor := NewCheckpointProcessor(&contractCaller.RootChainABI)
+	checkpointProcessor := NewCheckpointProcessor(&contractCaller.RootChainABI, txManager)
 	checkpointProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "checkpoint", checkpointProcessor)
 
 	// initialize checkpoint processor
@@ -76,9 +78,22 @@ func NewProcessorService(
 	spanProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "span", spanProcessor)
 
 	// initialize slashing processor
-	slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI)
+	slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI, txManager)
 	slashingProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "slashing", slashingProcessor)
 
+	// initialize span and milestone processors of the zena child chains
+	var childSpanProcessors, childMilestoneProcessors []Processor
+
+	for _, chainID := range helper.GetChildChainIDs() {
+		childSpanProcessor := NewChildChainSpanProcessor(chainID)
+		childSpanProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "span-"+chainID, childSpanProcessor)
+		childSpanProcessors = append(childSpanProcessors, childSpanProcessor)
+
+		childMilestoneProcessor := NewChildChainMilestoneProcessor(chainID)
+		childMilestoneProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "milestone-"+chainID, childMilestoneProcessor)
+		childMilestoneProcessors = append(childMilestoneProcessors, childMilestoneProcessor)
+	}
+
 	//
 	// Select processors
 	//
@@ -97,6 +112,8 @@ func NewProcessorService(
 			spanProcessor,
 			slashingProcessor,
 		)
+		processorService.processors = append(processorService.processors, childSpanProcessors...)
+		processorService.processors = append(processorService.processors, childMilestoneProcessors...)
 	} else {
 		for _, service := range onlyServices {
 			switch service {
@@ -104,6 +121,7 @@ func NewProcessorService(
 				processorService.processors = append(processorService.processors, checkpointProcessor)
 			case "milestone":
 				processorService.processors = append(processorService.processors, milestoneProcessor)
+				processorService.processors = append(processorService.processors, childMilestoneProcessors...)
 			case "staking":
 				processorService.processors = append(processorService.processors, stakingProcessor)
 			case "clerk":
@@ -112,6 +130,7 @@ func NewProcessorService(
 				processorService.processors = append(processorService.processors, feeProcessor)
 			case "span":
 				processorService.processors = append(processorService.processors, spanProcessor)
+				processorService.processors = append(processorService.processors, childSpanProcessors...)
 			case "slashing":
 				processorService.processors = append(processorService.processors, slashingProcessor)
 			}
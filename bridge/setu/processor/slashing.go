@@ -2,6 +2,7 @@ package processor
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 	"github.com/zenanetwork/go-zenanet/core/types"
 
 	authTypes "github.com/zenanetwork/iris/auth/types"
+	"github.com/zenanetwork/iris/bridge/setu/txmgr"
 	"github.com/zenanetwork/iris/bridge/setu/util"
 	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
 	"github.com/zenanetwork/iris/contracts/stakinginfo"
@@ -26,6 +28,9 @@ import (
 type SlashingProcessor struct {
 	BaseProcessor
 	stakingInfoAbi *abi.ABI
+
+	// mainchain tx manager
+	txManager *txmgr.Manager
 }
 
 // SlashingContext represents slashing context
@@ -34,9 +39,10 @@ type SlashingContext struct {
 }
 
 // NewSlashingProcessor - add  abi to slashing processor
-func NewSlashingProcessor(stakingInfoAbi *abi.ABI) *SlashingProcessor {
+func NewSlashingProcessor(stakingInfoAbi *abi.ABI, txManager *txmgr.Manager) *SlashingProcessor {
 	return &SlashingProcessor{
 		stakingInfoAbi: stakingInfoAbi,
+		txManager:      txManager,
 	}
 }
 
@@ -51,19 +57,19 @@ func (sp *SlashingProcessor) Start() error {
 func (sp *SlashingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering slashing related tasks")
 
-	if err := sp.queueConnector.Server.RegisterTask("sendTickToIris", sp.sendTickToIris); err != nil {
+	if err := sp.registerTask("sendTickToIris", sp.sendTickToIris); err != nil {
 		sp.Logger.Error("Failed to register sendTickToIris task", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendTickToRootchain", sp.sendTickToRootchain); err != nil {
+	if err := sp.registerTask("sendTickToRootchain", sp.sendTickToRootchain); err != nil {
 		sp.Logger.Error("Failed to register sendTickToRootchain task", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendTickAckToIris", sp.sendTickAckToIris); err != nil {
+	if err := sp.registerTask("sendTickAckToIris", sp.sendTickAckToIris); err != nil {
 		sp.Logger.Error("Failed to register sendTickAckToIris task", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendUnjailToIris", sp.sendUnjailToIris); err != nil {
+	if err := sp.registerTask("sendUnjailToIris", sp.sendUnjailToIris); err != nil {
 		sp.Logger.Error("Failed to register sendUnjailToIris task", "error", err)
 	}
 }
@@ -198,7 +204,7 @@ func (sp *SlashingProcessor) sendTickToRootchain(eventBytes string, blockHeight
 /*
 sendTickAckToIris - sends tick ack msg to iris
 */
-func (sp *SlashingProcessor) sendTickAckToIris(eventName string, logBytes string) error {
+func (sp *SlashingProcessor) sendTickAckToIris(ctx context.Context, eventName string, logBytes string) error {
 	var vLog = types.Log{}
 	if err := jsoniter.ConfigFastest.Unmarshal([]byte(logBytes), &vLog); err != nil {
 		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
@@ -237,7 +243,7 @@ func (sp *SlashingProcessor) sendTickAckToIris(eventName string, logBytes string
 		msg := slashingTypes.NewMsgTickAck(helper.GetFromAddress(sp.cliCtx), event.Nonce.Uint64(), event.Amount.Uint64(), hmTypes.BytesToIrisHash(vLog.TxHash.Bytes()), uint64(vLog.Index), vLog.BlockNumber)
 
 		// return broadcast to iris
-		txRes, err := sp.txBroadcaster.BroadcastToIris(msg, event)
+		txRes, err := sp.txBroadcaster.BroadcastToIrisWithContext(ctx, msg, event)
 		if err != nil {
 			sp.Logger.Error("Error while broadcasting tick-ack to iris", "error", err)
 			return err
@@ -256,7 +262,7 @@ func (sp *SlashingProcessor) sendTickAckToIris(eventName string, logBytes string
 /*
 sendUnjailToIris - sends unjail msg to iris
 */
-func (sp *SlashingProcessor) sendUnjailToIris(eventName string, logBytes string) error {
+func (sp *SlashingProcessor) sendUnjailToIris(ctx context.Context, eventName string, logBytes string) error {
 	var vLog = types.Log{}
 	if err := jsoniter.ConfigFastest.Unmarshal([]byte(logBytes), &vLog); err != nil {
 		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
@@ -299,7 +305,7 @@ func (sp *SlashingProcessor) sendUnjailToIris(eventName string, logBytes string)
 		)
 
 		// return broadcast to iris
-		txRes, err := sp.txBroadcaster.BroadcastToIris(msg, event)
+		txRes, err := sp.txBroadcaster.BroadcastToIrisWithContext(ctx, msg, event)
 		if err != nil {
 			sp.Logger.Error("Error while broadcasting unjail to iris", "error", err)
 			return err
@@ -365,19 +371,21 @@ func (sp *SlashingProcessor) createAndSendTickToRootchain(height int64, txHash [
 	chainParams := slashingContrext.ChainmanagerParams.ChainParams
 	slashManagerAddress := chainParams.SlashManagerAddress.EthAddress()
 
-	// slashmanage instance
-	slashManagerInstance, err := sp.contractConnector.GetSlashManagerInstance(slashManagerAddress)
+	// TODO pass sigs in proper form in `SendTick` for slashing
+	data, err := sp.contractConnector.SlashManagerABI.Pack("updateSlashedAmounts", sideTxData, []byte(nil))
 	if err != nil {
-		sp.Logger.Info("Error while creating slashmanager instance", "error", err)
+		sp.Logger.Error("Unable to pack tx for updateSlashedAmounts", "error", err)
 		return err
 	}
 
-	// TODO pass sigs in proper form in `SendTick` for slashing
-	if err := sp.contractConnector.SendTick(sideTxData, nil, slashManagerAddress, slashManagerInstance); err != nil {
+	tickTxHash, err := sp.txManager.SubmitTick(slashManagerAddress, data)
+	if err != nil {
 		sp.Logger.Info("Error submitting tick to slashManager contract", "error", err)
 		return err
 	}
 
+	sp.Logger.Info("Submitted new tick to slashmanager successfully", "txHash", tickTxHash.String())
+
 	return nil
 }
 
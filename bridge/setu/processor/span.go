@@ -23,10 +23,20 @@ import (
 type SpanProcessor struct {
 	BaseProcessor
 
+	// zena child chain of the spans, empty for the chain of the chain params
+	chainID string
+
 	// header listener subscription
 	cancelSpanService context.CancelFunc
 }
 
+// NewChildChainSpanProcessor returns a span processor proposing the spans of a zena child chain
+func NewChildChainSpanProcessor(chainID string) *SpanProcessor {
+	return &SpanProcessor{
+		chainID: chainID,
+	}
+}
+
 // Start starts new block subscription
 func (sp *SpanProcessor) Start() error {
 	sp.Logger.Info("Starting")
@@ -37,7 +47,7 @@ func (sp *SpanProcessor) Start() error {
 	sp.cancelSpanService = cancelSpanService
 
 	// start polling for span
-	sp.Logger.Info("Start polling for span", "pollInterval", helper.GetConfig().SpanPollInterval)
+	sp.Logger.Info("Start polling for span", "chainID", sp.chainID, "pollInterval", helper.GetConfig().SpanPollInterval)
 
 	go sp.startPolling(spanCtx, helper.GetConfig().SpanPollInterval)
 
@@ -87,8 +97,20 @@ func (sp *SpanProcessor) checkAndPropose() {
 		return
 	}
 
-	if nodeStatus.SyncInfo.LatestBlockHeight >= helper.GetDanelawHeight() {
-		latestBlock, e := sp.contractConnector.GetMaticChainBlock(nil)
+	danelaw, err := util.GetUpgradePlan(sp.cliCtx, helper.DanelawUpgrade)
+	if err != nil {
+		sp.Logger.Error("Error while fetching the danelaw upgrade plan", "error", err)
+		return
+	}
+
+	if danelaw.IsActive(nodeStatus.SyncInfo.LatestBlockHeight) {
+		contractCaller, e := chainContractCaller(&sp.contractConnector, sp.chainID)
+		if e != nil {
+			sp.Logger.Error("Error getting the contract caller of the zena chain", "chainID", sp.chainID, "error", e)
+			return
+		}
+
+		latestBlock, e := contractCaller.GetMaticChainBlock(nil)
 		if e != nil {
 			sp.Logger.Error("Error fetching current child block", "error", e)
 			return
@@ -139,9 +161,15 @@ func (sp *SpanProcessor) propose(lastSpan *types.Span, nextSpanMsg *types.Span)
 			return
 		}
 
+		danelaw, err := util.GetUpgradePlan(sp.cliCtx, helper.DanelawUpgrade)
+		if err != nil {
+			sp.Logger.Error("Error while fetching the danelaw upgrade plan", "error", err)
+			return
+		}
+
 		var txRes sdk.TxResponse
 
-		if nodeStatus.SyncInfo.LatestBlockHeight < helper.GetDanelawHeight() {
+		if !danelaw.IsActive(nodeStatus.SyncInfo.LatestBlockHeight) {
 			// broadcast to iris
 			msg := zenaTypes.MsgProposeSpan{
 				ID:         nextSpanMsg.ID,
@@ -187,7 +215,7 @@ func (sp *SpanProcessor) propose(lastSpan *types.Span, nextSpanMsg *types.Span)
 // checks span status
 func (sp *SpanProcessor) getLastSpan() (*types.Span, error) {
 	// fetch latest start block from iris via rest query
-	result, err := helper.FetchFromAPI(sp.cliCtx, helper.GetIrisServerEndpoint(util.LatestSpanURL))
+	result, err := helper.FetchFromAPI(sp.cliCtx, helper.GetIrisServerEndpoint(util.WithChainID(util.LatestSpanURL, sp.chainID)))
 	if err != nil {
 		sp.Logger.Error("Error while fetching latest span")
 		return nil, err
@@ -204,7 +232,12 @@ func (sp *SpanProcessor) getLastSpan() (*types.Span, error) {
 
 // getCurrentChildBlock gets the current child block
 func (sp *SpanProcessor) getCurrentChildBlock() (uint64, error) {
-	childBlock, err := sp.contractConnector.GetMaticChainBlock(nil)
+	contractCaller, err := chainContractCaller(&sp.contractConnector, sp.chainID)
+	if err != nil {
+		return 0, err
+	}
+
+	childBlock, err := contractCaller.GetMaticChainBlock(nil)
 	if err != nil {
 		return 0, err
 	}
@@ -241,7 +274,12 @@ func (sp *SpanProcessor) fetchNextSpanDetails(id uint64, start uint64) (*types.S
 	q := req.URL.Query()
 	q.Add("span_id", strconv.FormatUint(id, 10))
 	q.Add("start_block", strconv.FormatUint(start, 10))
-	q.Add("chain_id", configParams.ChainParams.ZenaChainID)
+	chainID := configParams.ChainParams.ZenaChainID
+	if sp.chainID != "" {
+		chainID = sp.chainID
+	}
+
+	q.Add("chain_id", chainID)
 	q.Add("proposer", helper.GetFromAddress(sp.cliCtx).String())
 	req.URL.RawQuery = q.Encode()
 
@@ -267,7 +305,7 @@ func (sp *SpanProcessor) fetchNextSpanDetails(id uint64, start uint64) (*types.S
 func (sp *SpanProcessor) fetchNextSpanSeed(id uint64) (common.Hash, common.Address, error) {
 	sp.Logger.Info("Sending Rest call to Get Seed for next span")
 
-	response, err := helper.FetchFromAPI(sp.cliCtx, helper.GetIrisServerEndpoint(fmt.Sprintf(util.NextSpanSeedURL, strconv.FormatUint(id, 10))))
+	response, err := helper.FetchFromAPI(sp.cliCtx, helper.GetIrisServerEndpoint(util.WithChainID(fmt.Sprintf(util.NextSpanSeedURL, strconv.FormatUint(id, 10)), sp.chainID)))
 	if err != nil {
 		sp.Logger.Error("Error Fetching nextspanseed from IrisServer ", "error", err)
 		return common.Hash{}, common.Address{}, err
@@ -290,3 +328,13 @@ func (sp *SpanProcessor) Stop() {
 	// cancel span polling
 	sp.cancelSpanService()
 }
+
+// chainContractCaller returns the contract caller reading a zena child chain, the contract
+// connector itself for an empty chain id
+func chainContractCaller(contractConnector *helper.ContractCaller, chainID string) (helper.IContractCaller, error) {
+	if chainID == "" {
+		return contractConnector, nil
+	}
+
+	return contractConnector.ForChildChain(chainID)
+}
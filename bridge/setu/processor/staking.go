@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -48,24 +49,24 @@ func (sp *StakingProcessor) Start() error {
 func (sp *StakingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering staking related tasks")
 
-	if err := sp.queueConnector.Server.RegisterTask("sendValidatorJoinToIris", sp.sendValidatorJoinToIris); err != nil {
+	if err := sp.registerTask("sendValidatorJoinToIris", sp.sendValidatorJoinToIris); err != nil {
 		sp.Logger.Error("RegisterTasks | sendValidatorJoinToIris", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendUnstakeInitToIris", sp.sendUnstakeInitToIris); err != nil {
+	if err := sp.registerTask("sendUnstakeInitToIris", sp.sendUnstakeInitToIris); err != nil {
 		sp.Logger.Error("RegisterTasks | sendUnstakeInitToIris", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendStakeUpdateToIris", sp.sendStakeUpdateToIris); err != nil {
+	if err := sp.registerTask("sendStakeUpdateToIris", sp.sendStakeUpdateToIris); err != nil {
 		sp.Logger.Error("RegisterTasks | sendStakeUpdateToIris", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendSignerChangeToIris", sp.sendSignerChangeToIris); err != nil {
+	if err := sp.registerTask("sendSignerChangeToIris", sp.sendSignerChangeToIris); err != nil {
 		sp.Logger.Error("RegisterTasks | sendSignerChangeToIris", "error", err)
 	}
 }
 
-func (sp *StakingProcessor) sendValidatorJoinToIris(eventName string, logBytes string) error {
+func (sp *StakingProcessor) sendValidatorJoinToIris(ctx context.Context, eventName string, logBytes string) error {
 	var vLog = types.Log{}
 	if err := jsoniter.ConfigFastest.Unmarshal([]byte(logBytes), &vLog); err != nil {
 		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
@@ -134,7 +135,7 @@ func (sp *StakingProcessor) sendValidatorJoinToIris(eventName string, logBytes s
 		)
 
 		// return broadcast to iris
-		txRes, err := sp.txBroadcaster.BroadcastToIris(msg, event)
+		txRes, err := sp.txBroadcaster.BroadcastToIrisWithContext(ctx, msg, event)
 		if err != nil {
 			sp.Logger.Error("Error while broadcasting unstakeInit to iris", "validatorId", event.ValidatorId.Uint64(), "error", err)
 			return err
@@ -150,7 +151,7 @@ func (sp *StakingProcessor) sendValidatorJoinToIris(eventName string, logBytes s
 	return nil
 }
 
-func (sp *StakingProcessor) sendUnstakeInitToIris(eventName string, logBytes string) error {
+func (sp *StakingProcessor) sendUnstakeInitToIris(ctx context.Context, eventName string, logBytes string) error {
 	var vLog = types.Log{}
 	if err := jsoniter.ConfigFastest.Unmarshal([]byte(logBytes), &vLog); err != nil {
 		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
@@ -217,7 +218,7 @@ func (sp *StakingProcessor) sendUnstakeInitToIris(eventName string, logBytes str
 		)
 
 		// return broadcast to iris
-		txRes, err := sp.txBroadcaster.BroadcastToIris(msg, event)
+		txRes, err := sp.txBroadcaster.BroadcastToIrisWithContext(ctx, msg, event)
 		if err != nil {
 			sp.Logger.Error("Error while broadcasting unstakeInit to iris", "validatorId", event.ValidatorId.Uint64(), "error", err)
 			return err
@@ -233,7 +234,7 @@ func (sp *StakingProcessor) sendUnstakeInitToIris(eventName string, logBytes str
 	return nil
 }
 
-func (sp *StakingProcessor) sendStakeUpdateToIris(eventName string, logBytes string) error {
+func (sp *StakingProcessor) sendStakeUpdateToIris(ctx context.Context, eventName string, logBytes string) error {
 	var vLog = types.Log{}
 	if err := jsoniter.ConfigFastest.Unmarshal([]byte(logBytes), &vLog); err != nil {
 		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
@@ -296,7 +297,7 @@ func (sp *StakingProcessor) sendStakeUpdateToIris(eventName string, logBytes str
 		)
 
 		// return broadcast to iris
-		txRes, err := sp.txBroadcaster.BroadcastToIris(msg, event)
+		txRes, err := sp.txBroadcaster.BroadcastToIrisWithContext(ctx, msg, event)
 		if err != nil {
 			sp.Logger.Error("Error while broadcasting stakeupdate to iris", "validatorId", event.ValidatorId.Uint64(), "error", err)
 			return err
@@ -311,7 +312,7 @@ func (sp *StakingProcessor) sendStakeUpdateToIris(eventName string, logBytes str
 	return nil
 }
 
-func (sp *StakingProcessor) sendSignerChangeToIris(eventName string, logBytes string) error {
+func (sp *StakingProcessor) sendSignerChangeToIris(ctx context.Context, eventName string, logBytes string) error {
 	var vLog = types.Log{}
 	if err := jsoniter.ConfigFastest.Unmarshal([]byte(logBytes), &vLog); err != nil {
 		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
@@ -383,7 +384,7 @@ func (sp *StakingProcessor) sendSignerChangeToIris(eventName string, logBytes st
 		)
 
 		// return broadcast to iris
-		txRes, err := sp.txBroadcaster.BroadcastToIris(msg, event)
+		txRes, err := sp.txBroadcaster.BroadcastToIrisWithContext(ctx, msg, event)
 		if err != nil {
 			sp.Logger.Error("Error while broadcasting signerChainge to iris", "msg", msg, "validatorId", event.ValidatorId.Uint64(), "error", err)
 			return err
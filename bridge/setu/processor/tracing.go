@@ -0,0 +1,61 @@
+package processor
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zenanetwork/iris/bridge/setu/util"
+	"github.com/zenanetwork/iris/common/tracing"
+)
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// traceTask wraps a machinery task function taking a context first, so that it gets the span
+// of the task, continuing the trace carried in the task headers by the listener that sent it.
+// Other task functions are returned as is.
+func traceTask(taskName string, taskFunc interface{}) interface{} {
+	fn := reflect.ValueOf(taskFunc)
+	if fn.Kind() != reflect.Func || fn.Type().NumOut() == 0 || fn.Type().NumIn() == 0 || fn.Type().In(0) != contextType {
+		return taskFunc
+	}
+
+	return reflect.MakeFunc(fn.Type(), func(args []reflect.Value) []reflect.Value {
+		ctx, _ := args[0].Interface().(context.Context)
+		ctx, span := startTaskSpan(ctx, taskName)
+		args[0] = reflect.ValueOf(&ctx).Elem()
+
+		defer span.End()
+
+		results := fn.Call(args)
+
+		var err error
+		if last := results[len(results)-1]; last.Kind() == reflect.Interface && !last.IsNil() {
+			err, _ = last.Interface().(error)
+		}
+
+		if taskResult(err) == taskFailed {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return results
+	}).Interface()
+}
+
+// startTaskSpan starts the span of a task, continuing the trace carried in its headers
+func startTaskSpan(ctx context.Context, taskName string) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if signature := tasks.SignatureFromContext(ctx); signature != nil && signature.Headers != nil {
+		ctx = tracing.Extract(ctx, util.TaskHeadersCarrier(signature.Headers))
+	}
+
+	return tracing.StartSpan(tracing.WithTracer(ctx, otel.Tracer(util.BridgeTracerName)), taskName)
+}
@@ -0,0 +1,36 @@
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceTask(t *testing.T) {
+	t.Parallel()
+
+	const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	var traceID trace.TraceID
+
+	task := func(ctx context.Context, eventName string) error {
+		traceID = trace.SpanContextFromContext(ctx).TraceID()
+		return nil
+	}
+
+	signature := &tasks.Signature{
+		Name:    "sendTestToIris",
+		Args:    []tasks.Arg{{Type: "string", Value: "event"}},
+		Headers: tasks.Headers{"traceparent": traceParent},
+	}
+
+	machineryTask, err := tasks.NewWithSignature(instrumentTask("test", signature.Name, traceTask(signature.Name, task)), signature)
+	require.NoError(t, err)
+
+	_, err = machineryTask.Call()
+	require.NoError(t, err)
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", traceID.String())
+}
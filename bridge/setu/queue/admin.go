@@ -0,0 +1,155 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+
+	"github.com/zenanetwork/iris/helper"
+)
+
+// storeFailedTasks stores the tasks failing for good in store, to be retried or dead-lettered later
+func (qc *QueueConnector) storeFailedTasks(store *TaskStore) {
+	qc.store = store
+	qc.Server.SetBackend(&failureRecordingBackend{
+		Backend: qc.Server.GetBackend(),
+		logger:  qc.logger,
+		store:   store,
+	})
+}
+
+// ListTasks returns the tasks in the given state. Pending and delayed tasks are only
+// listed by the embedded queue backend, AMQP queues are to be inspected on the broker.
+func (qc *QueueConnector) ListTasks(state string) ([]*TaskInfo, error) {
+	switch state {
+	case TaskPending, TaskDelayed:
+		if qc.embedded == nil {
+			return nil, fmt.Errorf("listing %s tasks is only supported by the %s queue backend", state, helper.QueueBackendEmbedded)
+		}
+
+		var (
+			signatures []*tasks.Signature
+			err        error
+		)
+
+		if state == TaskPending {
+			signatures, err = qc.embedded.GetPendingTasks(QueueName)
+		} else {
+			signatures, err = qc.embedded.GetDelayedTasks()
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*TaskInfo, 0, len(signatures))
+
+		for _, signature := range signatures {
+			task := &TaskInfo{State: state, Signature: signature}
+			if signature.ETA != nil {
+				task.Time = *signature.ETA
+			}
+
+			result = append(result, task)
+		}
+
+		return result, nil
+	case TaskFailed, TaskDeadLetter:
+		if qc.store == nil {
+			return nil, errors.New("tasks are not stored")
+		}
+
+		return qc.store.List(state)
+	default:
+		return nil, fmt.Errorf("unknown task state %q", state)
+	}
+}
+
+// RetryTask enqueues the failed or dead-letter task with the given uuid again, to run now.
+// A task queued by the embedded backend is run now instead of at its ETA.
+func (qc *QueueConnector) RetryTask(uuid string) (*TaskInfo, error) {
+	task, err := qc.takeTask(uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Signature.ETA = nil
+
+	if _, err = qc.Server.SendTask(task.Signature); err != nil {
+		// keep the task where it was
+		if task.State == TaskFailed || task.State == TaskDeadLetter {
+			if e := qc.store.Put(task); e != nil {
+				qc.logger.Error("Error restoring task", "uuid", uuid, "error", e)
+			}
+		}
+
+		return nil, err
+	}
+
+	qc.logger.Info("Retrying task", "taskName", task.Signature.Name, "uuid", uuid, "state", task.State)
+
+	task.State = TaskPending
+
+	return task, nil
+}
+
+// DeadLetterTask moves the failed or queued task with the given uuid to the dead-letter store,
+// so that it is not run unless retried
+func (qc *QueueConnector) DeadLetterTask(uuid string) (*TaskInfo, error) {
+	if qc.store == nil {
+		return nil, errors.New("tasks are not stored")
+	}
+
+	task, err := qc.takeTask(uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.State == TaskDeadLetter {
+		return task, qc.store.Put(task)
+	}
+
+	task.State = TaskDeadLetter
+	task.Time = time.Now()
+
+	if err = qc.store.Put(task); err != nil {
+		return nil, err
+	}
+
+	qc.logger.Info("Moved task to dead-letter", "taskName", task.Signature.Name, "uuid", uuid)
+
+	return task, nil
+}
+
+// takeTask removes the task with the given uuid from the store or from the embedded queue
+func (qc *QueueConnector) takeTask(uuid string) (*TaskInfo, error) {
+	if qc.store != nil {
+		for _, state := range []string{TaskFailed, TaskDeadLetter} {
+			task, err := qc.store.Get(state, uuid)
+			if errors.Is(err, ErrTaskNotFound) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+
+			if err = qc.store.Delete(state, uuid); err != nil {
+				return nil, err
+			}
+
+			return task, nil
+		}
+	}
+
+	if qc.embedded != nil {
+		signature, err := qc.embedded.Remove(uuid)
+		if err != nil {
+			return nil, err
+		}
+
+		return &TaskInfo{State: TaskPending, Signature: signature, Time: time.Now()}, nil
+	}
+
+	return nil, ErrTaskNotFound
+}
@@ -0,0 +1,83 @@
+package queue
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+func TestAdminTasks(t *testing.T) {
+	t.Parallel()
+
+	db, err := leveldb.OpenFile(filepath.Join(t.TempDir(), "bridge"), nil)
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	connector := NewEmbeddedQueueConnector(db)
+	connector.storeFailedTasks(NewTaskStore(db))
+
+	require.NoError(t, connector.Server.RegisterTask("sendStateSyncedToIris", func(eventName string, logBytes string) error {
+		return errors.New("failed")
+	}))
+
+	signature := &tasks.Signature{
+		UUID: "1",
+		Name: "sendStateSyncedToIris",
+		Args: []tasks.Arg{
+			{Type: "string", Value: "StateSynced"},
+			{Type: "string", Value: `{"transactionHash":"0x01"}`},
+		},
+	}
+
+	// the task fails for good, without retries left
+	require.NoError(t, connector.Server.NewWorker("test", 1).Process(signature))
+
+	failed, err := connector.ListTasks(TaskFailed)
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.Equal(t, "failed", failed[0].Error)
+	require.Equal(t, signature.Args, failed[0].Signature.Args)
+
+	// failed -> dead-letter
+	task, err := connector.DeadLetterTask("1")
+	require.NoError(t, err)
+	require.Equal(t, TaskDeadLetter, task.State)
+
+	failed, err = connector.ListTasks(TaskFailed)
+	require.NoError(t, err)
+	require.Empty(t, failed)
+
+	deadLetter, err := connector.ListTasks(TaskDeadLetter)
+	require.NoError(t, err)
+	require.Len(t, deadLetter, 1)
+
+	// dead-letter -> pending
+	task, err = connector.RetryTask("1")
+	require.NoError(t, err)
+	require.Equal(t, TaskPending, task.State)
+
+	deadLetter, err = connector.ListTasks(TaskDeadLetter)
+	require.NoError(t, err)
+	require.Empty(t, deadLetter)
+
+	pending, err := connector.ListTasks(TaskPending)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	// pending -> dead-letter, out of the queue and its journal
+	_, err = connector.DeadLetterTask("1")
+	require.NoError(t, err)
+
+	pending, err = connector.ListTasks(TaskPending)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+	require.NoError(t, NewEmbeddedQueueConnector(db).embedded.loadJournal())
+
+	_, err = connector.RetryTask("2")
+	require.ErrorIs(t, err, ErrTaskNotFound)
+}
@@ -1,18 +1,30 @@
 package queue
 
 import (
+	"fmt"
+
 	"github.com/streadway/amqp"
+	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/RichardKnop/machinery/v1"
+	nullBackend "github.com/RichardKnop/machinery/v1/backends/null"
 	"github.com/RichardKnop/machinery/v1/config"
+	eagerLock "github.com/RichardKnop/machinery/v1/locks/eager"
 
 	"github.com/zenanetwork/iris/bridge/setu/util"
+	"github.com/zenanetwork/iris/helper"
 )
 
 type QueueConnector struct {
 	logger log.Logger
 	Server *machinery.Server
+
+	// embedded broker, nil when backed by AMQP
+	embedded *EmbeddedBroker
+
+	// failed and dead-letter tasks, nil when not backed by the bridge db
+	store *TaskStore
 }
 
 const (
@@ -20,6 +32,25 @@ const (
 	QueueName = "machinery_tasks"
 )
 
+// NewQueueConnectorFromConfig returns the queue connector for the configured queue backend.
+// The tasks failing for good are stored in db.
+func NewQueueConnectorFromConfig(db *leveldb.DB) *QueueConnector {
+	var connector *QueueConnector
+
+	switch backend := helper.GetConfig().QueueBackend; backend {
+	case helper.QueueBackendEmbedded:
+		connector = NewEmbeddedQueueConnector(db)
+	case helper.QueueBackendAmqp, "":
+		connector = NewQueueConnector(helper.GetConfig().AmqpURL)
+	default:
+		panic(fmt.Sprintf("unknown queue backend %q", backend))
+	}
+
+	connector.storeFailedTasks(NewTaskStore(db))
+
+	return connector
+}
+
 func NewQueueConnector(dialer string) *QueueConnector {
 	// amqp dialer
 	_, err := amqp.Dial(dialer)
@@ -53,6 +84,28 @@ func NewQueueConnector(dialer string) *QueueConnector {
 	return &connector
 }
 
+// NewEmbeddedQueueConnector returns a queue connector running the tasks in-process.
+// Tasks are journaled in db when it is not nil.
+func NewEmbeddedQueueConnector(db *leveldb.DB) *QueueConnector {
+	var cnf = &config.Config{
+		Broker:        EmbeddedBrokerURL,
+		DefaultQueue:  QueueName,
+		ResultBackend: EmbeddedBrokerURL,
+		NoUnixSignals: true,
+	}
+
+	broker := NewEmbeddedBroker(cnf, db)
+
+	// queue connector
+	connector := QueueConnector{
+		logger:   util.Logger().With("module", "QueueConnector"),
+		Server:   machinery.NewServerWithBrokerBackendLock(cnf, broker, nullBackend.New(), eagerLock.New()),
+		embedded: broker,
+	}
+
+	return &connector
+}
+
 // StartWorker - starts worker to process registered tasks
 func (qc *QueueConnector) StartWorker() {
 	worker := qc.Server.NewWorker("invoke-processor", 10)
@@ -63,3 +116,12 @@ func (qc *QueueConnector) StartWorker() {
 
 	worker.LaunchAsync(errors)
 }
+
+// Purge removes all queued tasks of the embedded backend
+func (qc *QueueConnector) Purge() error {
+	if qc.embedded == nil {
+		return fmt.Errorf("purge is only supported by the %s queue backend", helper.QueueBackendEmbedded)
+	}
+
+	return qc.embedded.Purge()
+}
@@ -0,0 +1,411 @@
+package queue
+
+import (
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/brokers/iface"
+	"github.com/RichardKnop/machinery/v1/common"
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/zenanetwork/iris/bridge/setu/util"
+)
+
+const (
+	// EmbeddedBrokerURL is the broker url reported by the embedded backend
+	EmbeddedBrokerURL = "embedded://"
+
+	// embeddedTaskPrefix is the bridge db prefix of journaled tasks
+	embeddedTaskPrefix = "queue-task-"
+)
+
+// ErrEmbeddedBrokerStopped is returned when a task is published after the broker stopped
+var ErrEmbeddedBrokerStopped = errors.New("embedded broker stopped")
+
+// embeddedTask is a task waiting in the embedded broker
+type embeddedTask struct {
+	key       []byte
+	eta       time.Time
+	signature *tasks.Signature
+}
+
+// embeddedTaskHeap orders tasks by ETA, oldest first
+type embeddedTaskHeap []*embeddedTask
+
+func (h embeddedTaskHeap) Len() int { return len(h) }
+
+func (h embeddedTaskHeap) Less(i, j int) bool { return h[i].eta.Before(h[j].eta) }
+
+func (h embeddedTaskHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *embeddedTaskHeap) Push(x interface{}) { *h = append(*h, x.(*embeddedTask)) }
+
+func (h *embeddedTaskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+
+	return item
+}
+
+// EmbeddedBroker is an in-process machinery broker.
+// Tasks are kept in memory ordered by ETA and, when a db is provided,
+// journaled so that pending and delayed tasks survive a restart.
+type EmbeddedBroker struct {
+	common.Broker
+
+	logger log.Logger
+
+	// journal, optional
+	db *leveldb.DB
+
+	mu      sync.Mutex
+	queue   embeddedTaskHeap
+	running map[string]*embeddedTask
+	loaded  bool
+	stopped bool
+
+	// wakeup signals the dispatcher that the queue changed
+	wakeup chan struct{}
+
+	consumingWG sync.WaitGroup
+}
+
+// NewEmbeddedBroker creates an embedded broker journaling to db (nil for memory only)
+func NewEmbeddedBroker(cnf *config.Config, db *leveldb.DB) *EmbeddedBroker {
+	return &EmbeddedBroker{
+		Broker:  common.NewBroker(cnf),
+		logger:  util.Logger().With("module", "EmbeddedBroker"),
+		db:      db,
+		running: make(map[string]*embeddedTask),
+		wakeup:  make(chan struct{}, 1),
+	}
+}
+
+// StartConsuming dispatches due tasks to the task processor until StopConsuming is called
+func (b *EmbeddedBroker) StartConsuming(consumerTag string, concurrency int, taskProcessor iface.TaskProcessor) (bool, error) {
+	b.consumingWG.Add(1)
+	defer b.consumingWG.Done()
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	b.Broker.StartConsuming(consumerTag, concurrency, taskProcessor)
+
+	if err := b.loadJournal(); err != nil {
+		return b.GetRetry(), err
+	}
+
+	pool := make(chan struct{}, concurrency)
+	for i := 0; i < concurrency; i++ {
+		pool <- struct{}{}
+	}
+
+	var processWG sync.WaitGroup
+
+	defer processWG.Wait()
+
+	for {
+		task, wait := b.nextDueTask()
+		if task == nil {
+			timer := time.NewTimer(wait)
+
+			select {
+			case <-b.GetStopChan():
+				timer.Stop()
+				return b.GetRetry(), nil
+			case <-b.wakeup:
+				timer.Stop()
+			case <-timer.C:
+			}
+
+			continue
+		}
+
+		select {
+		case <-b.GetStopChan():
+			b.requeue(task)
+			return b.GetRetry(), nil
+		case <-pool:
+		}
+
+		processWG.Add(1)
+
+		go func(task *embeddedTask) {
+			defer func() {
+				pool <- struct{}{}
+
+				processWG.Done()
+			}()
+
+			b.process(task, taskProcessor)
+		}(task)
+	}
+}
+
+// StopConsuming stops the dispatcher and waits for it to return
+func (b *EmbeddedBroker) StopConsuming() {
+	b.mu.Lock()
+	b.stopped = true
+	b.mu.Unlock()
+
+	b.Broker.StopConsuming()
+
+	b.consumingWG.Wait()
+}
+
+// Publish journals the task and schedules it for its ETA
+func (b *EmbeddedBroker) Publish(_ context.Context, signature *tasks.Signature) error {
+	b.AdjustRoutingKey(signature)
+
+	eta := time.Now()
+	if signature.ETA != nil && signature.ETA.After(eta) {
+		eta = *signature.ETA
+	}
+
+	task := &embeddedTask{
+		key:       embeddedTaskKey(eta, signature.UUID),
+		eta:       eta,
+		signature: signature,
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.stopped {
+		return ErrEmbeddedBrokerStopped
+	}
+
+	if b.db != nil {
+		message, err := json.Marshal(signature)
+		if err != nil {
+			return fmt.Errorf("JSON marshal error: %s", err)
+		}
+
+		if err = b.db.Put(task.key, message, nil); err != nil {
+			return err
+		}
+	}
+
+	heap.Push(&b.queue, task)
+	b.notify()
+
+	return nil
+}
+
+// GetPendingTasks returns the tasks which are due but not yet processed
+func (b *EmbeddedBroker) GetPendingTasks(_ string) ([]*tasks.Signature, error) {
+	now := time.Now()
+
+	return b.filterTasks(func(task *embeddedTask) bool {
+		return !task.eta.After(now)
+	}), nil
+}
+
+// GetDelayedTasks returns the tasks scheduled in the future
+func (b *EmbeddedBroker) GetDelayedTasks() ([]*tasks.Signature, error) {
+	now := time.Now()
+
+	return b.filterTasks(func(task *embeddedTask) bool {
+		return task.eta.After(now)
+	}), nil
+}
+
+// Purge removes every queued task from memory and from the journal
+func (b *EmbeddedBroker) Purge() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.queue = nil
+
+	if b.db == nil {
+		return nil
+	}
+
+	return PurgeEmbeddedJournal(b.db)
+}
+
+// Remove removes the queued task with the given uuid from memory and from the journal
+func (b *EmbeddedBroker) Remove(uuid string) (*tasks.Signature, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, task := range b.queue {
+		if task.signature.UUID != uuid {
+			continue
+		}
+
+		heap.Remove(&b.queue, i)
+
+		if b.db != nil {
+			if err := b.db.Delete(task.key, nil); err != nil {
+				return nil, err
+			}
+		}
+
+		return task.signature, nil
+	}
+
+	return nil, ErrTaskNotFound
+}
+
+// PurgeEmbeddedJournal deletes all journaled tasks from the bridge db
+func PurgeEmbeddedJournal(db *leveldb.DB) error {
+	batch := new(leveldb.Batch)
+
+	iter := db.NewIterator(levelUtil.BytesPrefix([]byte(embeddedTaskPrefix)), nil)
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+
+	iter.Release()
+
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return db.Write(batch, nil)
+}
+
+// loadJournal restores journaled tasks on first start
+func (b *EmbeddedBroker) loadJournal() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.loaded || b.db == nil {
+		b.loaded = true
+		return nil
+	}
+
+	iter := b.db.NewIterator(levelUtil.BytesPrefix([]byte(embeddedTaskPrefix)), nil)
+	defer iter.Release()
+
+	restored := 0
+
+	for iter.Next() {
+		key := append([]byte{}, iter.Key()...)
+
+		signature := new(tasks.Signature)
+		if err := json.Unmarshal(iter.Value(), signature); err != nil {
+			b.logger.Error("Dropping undecodable journaled task", "key", string(key), "error", err)
+
+			if err = b.db.Delete(key, nil); err != nil {
+				b.logger.Error("Error deleting journaled task", "error", err)
+			}
+
+			continue
+		}
+
+		eta := time.Now()
+		if signature.ETA != nil {
+			eta = *signature.ETA
+		}
+
+		heap.Push(&b.queue, &embeddedTask{key: key, eta: eta, signature: signature})
+		restored++
+	}
+
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	b.loaded = true
+
+	if restored > 0 {
+		b.logger.Info("Restored journaled tasks", "count", restored)
+	}
+
+	return nil
+}
+
+// nextDueTask pops the first due task, or returns how long to wait for one
+func (b *EmbeddedBroker) nextDueTask() (*embeddedTask, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.queue) == 0 {
+		return nil, time.Minute
+	}
+
+	if wait := time.Until(b.queue[0].eta); wait > 0 {
+		return nil, wait
+	}
+
+	task, _ := heap.Pop(&b.queue).(*embeddedTask)
+	b.running[string(task.key)] = task
+
+	return task, 0
+}
+
+// process runs the task and removes it from the journal.
+// Retries are re-published by the machinery worker with a new ETA.
+func (b *EmbeddedBroker) process(task *embeddedTask, taskProcessor iface.TaskProcessor) {
+	if err := taskProcessor.Process(task.signature); err != nil {
+		b.logger.Error("Error processing task", "taskName", task.signature.Name, "uuid", task.signature.UUID, "error", err)
+	}
+
+	b.mu.Lock()
+	delete(b.running, string(task.key))
+	b.mu.Unlock()
+
+	if b.db != nil {
+		if err := b.db.Delete(task.key, nil); err != nil {
+			b.logger.Error("Error deleting journaled task", "taskName", task.signature.Name, "error", err)
+		}
+	}
+}
+
+// requeue puts a popped but undispatched task back in the queue
+func (b *EmbeddedBroker) requeue(task *embeddedTask) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.running, string(task.key))
+	heap.Push(&b.queue, task)
+}
+
+func (b *EmbeddedBroker) filterTasks(fn func(*embeddedTask) bool) []*tasks.Signature {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	result := make([]*tasks.Signature, 0, len(b.queue))
+
+	for _, task := range b.queue {
+		if fn(task) {
+			result = append(result, task.signature)
+		}
+	}
+
+	return result
+}
+
+func (b *EmbeddedBroker) notify() {
+	select {
+	case b.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// embeddedTaskKey orders journaled tasks by ETA
+func embeddedTaskKey(eta time.Time, uuid string) []byte {
+	key := make([]byte, 0, len(embeddedTaskPrefix)+8+1+len(uuid))
+	key = append(key, embeddedTaskPrefix...)
+	key = binary.BigEndian.AppendUint64(key, uint64(eta.UnixNano()))
+	key = append(key, '-')
+	key = append(key, uuid...)
+
+	return key
+}
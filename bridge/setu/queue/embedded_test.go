@@ -0,0 +1,108 @@
+package queue
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+type recordingProcessor struct {
+	mu    sync.Mutex
+	names []string
+	done  chan struct{}
+}
+
+func (p *recordingProcessor) Process(signature *tasks.Signature) error {
+	p.mu.Lock()
+	p.names = append(p.names, signature.Name)
+	p.mu.Unlock()
+
+	p.done <- struct{}{}
+
+	return nil
+}
+
+func (p *recordingProcessor) CustomQueue() string { return "" }
+
+func (p *recordingProcessor) PreConsumeHandler() bool { return true }
+
+func TestEmbeddedBrokerDelayedTasks(t *testing.T) {
+	t.Parallel()
+
+	connector := NewEmbeddedQueueConnector(nil)
+	broker := connector.embedded
+
+	delayed := time.Now().Add(200 * time.Millisecond)
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "2", Name: "delayed", ETA: &delayed}))
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "1", Name: "immediate"}))
+
+	pending, err := broker.GetPendingTasks(QueueName)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	delayedTasks, err := broker.GetDelayedTasks()
+	require.NoError(t, err)
+	require.Len(t, delayedTasks, 1)
+	require.Equal(t, "delayed", delayedTasks[0].Name)
+
+	processor := &recordingProcessor{done: make(chan struct{}, 2)}
+
+	go func() {
+		_, _ = broker.StartConsuming("test", 1, processor)
+	}()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-processor.done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("task was not processed")
+		}
+	}
+
+	broker.StopConsuming()
+
+	require.Equal(t, []string{"immediate", "delayed"}, processor.names)
+	require.False(t, time.Now().Before(delayed))
+}
+
+func TestEmbeddedBrokerJournal(t *testing.T) {
+	t.Parallel()
+
+	db, err := leveldb.OpenFile(filepath.Join(t.TempDir(), "bridge"), nil)
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	// publish without consuming, as if the bridge stopped before running the task
+	first := NewEmbeddedQueueConnector(db).embedded
+	require.NoError(t, first.Publish(context.Background(), &tasks.Signature{UUID: "1", Name: "sendStateSyncedToIris"}))
+
+	// a new broker on the same db restores the task
+	second := NewEmbeddedQueueConnector(db).embedded
+	processor := &recordingProcessor{done: make(chan struct{}, 1)}
+
+	go func() {
+		_, _ = second.StartConsuming("test", 1, processor)
+	}()
+
+	select {
+	case <-processor.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("journaled task was not restored")
+	}
+
+	second.StopConsuming()
+
+	require.Equal(t, []string{"sendStateSyncedToIris"}, processor.names)
+
+	// processed tasks are removed from the journal
+	third := NewEmbeddedQueueConnector(db).embedded
+	require.NoError(t, third.loadJournal())
+	require.Empty(t, third.queue)
+}
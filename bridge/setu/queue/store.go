@@ -0,0 +1,152 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	backendsiface "github.com/RichardKnop/machinery/v1/backends/iface"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// States of a task, as listed by the bridge admin API
+const (
+	TaskPending    = "pending"
+	TaskDelayed    = "delayed"
+	TaskFailed     = "failed"
+	TaskDeadLetter = "dead-letter"
+)
+
+// bridge db prefixes of the stored tasks, by state
+var storedTaskPrefixes = map[string]string{
+	TaskFailed:     "queue-failed-",
+	TaskDeadLetter: "queue-dead-letter-",
+}
+
+// ErrTaskNotFound is returned when no task has the given uuid
+var ErrTaskNotFound = errors.New("task not found")
+
+// TaskInfo is a queued or stored task. The event payload of the task is in its signature args.
+type TaskInfo struct {
+	State     string           `json:"state"`
+	Signature *tasks.Signature `json:"signature"`
+	Error     string           `json:"error,omitempty"`
+	Time      time.Time        `json:"time"`
+}
+
+// TaskStore keeps the failed and dead-letter tasks in the bridge db, by uuid
+type TaskStore struct {
+	db *leveldb.DB
+}
+
+// NewTaskStore returns the task store of the bridge db
+func NewTaskStore(db *leveldb.DB) *TaskStore {
+	return &TaskStore{db: db}
+}
+
+// Put stores the task in the given state, failed or dead-letter
+func (s *TaskStore) Put(task *TaskInfo) error {
+	key, err := storedTaskKey(task.State, task.Signature.UUID)
+	if err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	return s.db.Put(key, value, nil)
+}
+
+// Get returns the task stored in the given state
+func (s *TaskStore) Get(state string, uuid string) (*TaskInfo, error) {
+	key, err := storedTaskKey(state, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := s.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, ErrTaskNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	task := new(TaskInfo)
+	if err = json.Unmarshal(value, task); err != nil {
+		return nil, fmt.Errorf("JSON unmarshal error: %s", err)
+	}
+
+	return task, nil
+}
+
+// Delete removes the task stored in the given state
+func (s *TaskStore) Delete(state string, uuid string) error {
+	key, err := storedTaskKey(state, uuid)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Delete(key, nil)
+}
+
+// List returns the tasks stored in the given state
+func (s *TaskStore) List(state string) ([]*TaskInfo, error) {
+	prefix, ok := storedTaskPrefixes[state]
+	if !ok {
+		return nil, fmt.Errorf("tasks are not stored in state %q", state)
+	}
+
+	iter := s.db.NewIterator(levelUtil.BytesPrefix([]byte(prefix)), nil)
+	defer iter.Release()
+
+	result := make([]*TaskInfo, 0)
+
+	for iter.Next() {
+		task := new(TaskInfo)
+		if err := json.Unmarshal(iter.Value(), task); err != nil {
+			return nil, fmt.Errorf("JSON unmarshal error: %s", err)
+		}
+
+		result = append(result, task)
+	}
+
+	return result, iter.Error()
+}
+
+func storedTaskKey(state string, uuid string) ([]byte, error) {
+	prefix, ok := storedTaskPrefixes[state]
+	if !ok {
+		return nil, fmt.Errorf("tasks are not stored in state %q", state)
+	}
+
+	return []byte(prefix + uuid), nil
+}
+
+// failureRecordingBackend stores the tasks failing for good, after their retries,
+// which machinery reports to the result backend
+type failureRecordingBackend struct {
+	backendsiface.Backend
+
+	logger log.Logger
+	store  *TaskStore
+}
+
+// SetStateFailure stores the failed task before updating its state in the result backend
+func (b *failureRecordingBackend) SetStateFailure(signature *tasks.Signature, err string) error {
+	if e := b.store.Put(&TaskInfo{
+		State:     TaskFailed,
+		Signature: signature,
+		Error:     err,
+		Time:      time.Now(),
+	}); e != nil {
+		b.logger.Error("Error storing failed task", "taskName", signature.Name, "uuid", signature.UUID, "error", e)
+	}
+
+	return b.Backend.SetStateFailure(signature, err)
+}
@@ -0,0 +1,393 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/tendermint/tendermint/libs/common"
+	zenanet "github.com/zenanetwork/go-zenanet"
+	ethCommon "github.com/zenanetwork/go-zenanet/common"
+	"github.com/zenanetwork/go-zenanet/core/types"
+	"github.com/zenanetwork/go-zenanet/ethclient"
+
+	"github.com/zenanetwork/iris/bridge/setu/util"
+	"github.com/zenanetwork/iris/helper"
+	"github.com/zenanetwork/iris/signer"
+)
+
+const (
+	txManagerServiceStr = "txmgr-service"
+
+	// interval the tracked txs are checked at
+	pollInterval = 30 * time.Second
+
+	// gas limit of a zero-value self-transfer
+	cancelGasLimit = uint64(21000)
+)
+
+// Reasons a tx is sent again
+const (
+	reasonTimeout = "timeout"
+	reasonDropped = "dropped"
+	reasonLanded  = "landed"
+)
+
+// Manager sends the mainchain txs of the bridge and tracks them in the bridge db until they are mined.
+// It replaces the txs stuck in the mempool with bumped EIP-1559 fees, fills in nonce gaps and cancels
+// the checkpoints another validator already submitted.
+type Manager struct {
+	// Base service
+	common.BaseService
+
+	// storage client
+	storageClient *leveldb.DB
+
+	// contract caller
+	contractCaller helper.ContractCaller
+
+	// serializes the nonces and the replacements
+	mu sync.Mutex
+
+	cancelPolling context.CancelFunc
+}
+
+// NewManager returns the mainchain tx manager of the bridge
+func NewManager(storageClient *leveldb.DB) *Manager {
+	logger := util.Logger().With("module", txManagerServiceStr)
+
+	contractCaller, err := helper.NewContractCaller()
+	if err != nil {
+		panic(err)
+	}
+
+	manager := &Manager{
+		storageClient:  storageClient,
+		contractCaller: contractCaller,
+	}
+
+	manager.BaseService = *common.NewBaseService(logger, txManagerServiceStr, manager)
+
+	return manager
+}
+
+// OnStart starts tracking the submitted txs
+func (m *Manager) OnStart() error {
+	if err := m.BaseService.OnStart(); err != nil {
+		m.Logger.Error("OnStart | OnStart", "Error", err)
+	} // Always call the overridden method.
+
+	ctx, cancelPolling := context.WithCancel(context.Background())
+	m.cancelPolling = cancelPolling
+
+	go m.startPolling(ctx, pollInterval)
+
+	return nil
+}
+
+// OnStop stops tracking the submitted txs
+func (m *Manager) OnStop() {
+	m.BaseService.OnStop() // Always call the overridden method.
+
+	if m.cancelPolling != nil {
+		m.cancelPolling()
+	}
+}
+
+func (m *Manager) startPolling(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.manageTxs()
+		case <-ctx.Done():
+			m.Logger.Info("Tx manager polling stopped")
+			return
+		}
+	}
+}
+
+// SubmitCheckpoint sends the submitCheckpoint tx of data to the root chain contract.
+// It is cancelled if the current header block advances before it is mined.
+func (m *Manager) SubmitCheckpoint(rootChainAddress ethCommon.Address, data []byte, childBlockInterval uint64) (ethCommon.Hash, error) {
+	rootChainInstance, err := m.contractCaller.GetRootChainInstance(rootChainAddress)
+	if err != nil {
+		return ethCommon.Hash{}, err
+	}
+
+	headerBlock, err := m.contractCaller.CurrentHeaderBlock(rootChainInstance, childBlockInterval)
+	if err != nil {
+		return ethCommon.Hash{}, err
+	}
+
+	return m.submit(&Tx{
+		Kind:               KindCheckpoint,
+		To:                 rootChainAddress,
+		Data:               data,
+		HeaderBlock:        headerBlock,
+		ChildBlockInterval: childBlockInterval,
+	})
+}
+
+// SubmitTick sends the updateSlashedAmounts tx of data to the slash manager contract
+func (m *Manager) SubmitTick(slashManagerAddress ethCommon.Address, data []byte) (ethCommon.Hash, error) {
+	return m.submit(&Tx{
+		Kind: KindTick,
+		To:   slashManagerAddress,
+		Data: data,
+	})
+}
+
+func (m *Manager) submit(tx *Tx) (ethCommon.Hash, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), helper.GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	client := helper.GetMainClient()
+	from := signer.Address(helper.GetSigner())
+
+	nonce, err := client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return ethCommon.Hash{}, err
+	}
+
+	txs, err := getTxs(m.storageClient)
+	if err != nil {
+		return ethCommon.Hash{}, err
+	}
+
+	// a tx dropped from the mempool leaves its nonce behind the pending nonce; it is sent again, not reused
+	if len(txs) > 0 && txs[len(txs)-1].Nonce >= nonce {
+		nonce = txs[len(txs)-1].Nonce + 1
+	}
+
+	gasLimit, err := client.EstimateGas(ctx, zenanet.CallMsg{From: from, To: &tx.To, Data: tx.Data})
+	if err != nil {
+		return ethCommon.Hash{}, err
+	}
+
+	gasTipCap, gasFeeCap, err := helper.SuggestDynamicFees(client)
+	if err != nil {
+		return ethCommon.Hash{}, err
+	}
+
+	tx.Nonce = nonce
+	tx.GasLimit = gasLimit
+	tx.GasTipCap = gasTipCap
+	tx.GasFeeCap = gasFeeCap
+
+	if err = m.send(ctx, client, tx); err != nil {
+		return ethCommon.Hash{}, err
+	}
+
+	return tx.Hashes[len(tx.Hashes)-1], nil
+}
+
+// manageTxs goes over the tracked txs: it drops the mined ones, fills in the nonce gaps,
+// cancels the checkpoints that landed already and replaces the stuck txs.
+func (m *Manager) manageTxs() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	txs, err := getTxs(m.storageClient)
+	if err != nil {
+		m.Logger.Error("Error fetching tracked txs", "error", err)
+		return
+	}
+
+	pendingTxs.Set(float64(len(txs)))
+
+	if len(txs) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), helper.GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	client := helper.GetMainClient()
+	from := signer.Address(helper.GetSigner())
+
+	minedNonce, err := client.NonceAt(ctx, from, nil)
+	if err != nil {
+		m.Logger.Error("Error fetching mined nonce", "error", err)
+		return
+	}
+
+	pendingNonce, err := client.PendingNonceAt(ctx, from)
+	if err != nil {
+		m.Logger.Error("Error fetching pending nonce", "error", err)
+		return
+	}
+
+	gaps := nonceGaps(txs, pendingNonce)
+	for _, nonce := range gaps {
+		m.Logger.Info("Filling nonce gap", "nonce", nonce)
+
+		if err := m.replace(client, &Tx{Nonce: nonce, GasTipCap: new(big.Int), GasFeeCap: new(big.Int)}, reasonDropped, true); err != nil {
+			m.Logger.Error("Error filling nonce gap", "nonce", nonce, "error", err)
+		}
+	}
+
+	for _, tx := range txs {
+		switch {
+		case tx.Nonce < minedNonce:
+			m.confirm(client, tx)
+		case tx.Kind == KindCheckpoint && m.checkpointLanded(tx):
+			m.Logger.Info("Checkpoint submitted by another validator, cancelling tx", "nonce", tx.Nonce, "txHash", tx.Hashes[len(tx.Hashes)-1])
+
+			if err := m.replace(client, tx, reasonLanded, true); err != nil {
+				m.Logger.Error("Error cancelling checkpoint tx", "nonce", tx.Nonce, "error", err)
+			}
+		case len(gaps) == 0 && tx.Nonce >= pendingNonce:
+			m.Logger.Info("Tx dropped from the mempool, sending it again", "kind", tx.Kind, "nonce", tx.Nonce)
+
+			if err := m.replace(client, tx, reasonDropped, false); err != nil {
+				m.Logger.Error("Error sending dropped tx", "nonce", tx.Nonce, "error", err)
+			}
+		case time.Since(tx.SentAt) > helper.GetConfig().MainchainTxTimeout:
+			m.Logger.Info("Tx stuck in the mempool, replacing it", "kind", tx.Kind, "nonce", tx.Nonce, "sentAt", tx.SentAt)
+
+			if err := m.replace(client, tx, reasonTimeout, false); err != nil {
+				m.Logger.Error("Error replacing stuck tx", "nonce", tx.Nonce, "error", err)
+			}
+		}
+	}
+}
+
+// confirm drops the tx once its nonce is mined, by the tx, one of its replacements or another tx
+func (m *Manager) confirm(client *ethclient.Client, tx *Tx) {
+	ctx, cancel := context.WithTimeout(context.Background(), helper.GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	outcome := "replaced"
+
+	for _, hash := range tx.Hashes {
+		receipt, err := client.TransactionReceipt(ctx, hash)
+		if err != nil {
+			continue
+		}
+
+		outcome = "mined"
+		if receipt.Status == types.ReceiptStatusFailed {
+			outcome = "failed"
+		}
+
+		m.Logger.Info("Tx mined", "kind", tx.Kind, "nonce", tx.Nonce, "txHash", hash, "status", receipt.Status)
+
+		break
+	}
+
+	minedTxs.WithLabelValues(tx.Kind, outcome).Inc()
+
+	if err := deleteTx(m.storageClient, tx.Nonce); err != nil {
+		m.Logger.Error("Error dropping mined tx", "nonce", tx.Nonce, "error", err)
+	}
+}
+
+// checkpointLanded checks if the current header block of the root chain contract advanced since the checkpoint was submitted
+func (m *Manager) checkpointLanded(tx *Tx) bool {
+	rootChainInstance, err := m.contractCaller.GetRootChainInstance(tx.To)
+	if err != nil {
+		m.Logger.Error("Error while creating rootchain instance", "error", err)
+		return false
+	}
+
+	headerBlock, err := m.contractCaller.CurrentHeaderBlock(rootChainInstance, tx.ChildBlockInterval)
+	if err != nil {
+		return false
+	}
+
+	return headerBlock > tx.HeaderBlock
+}
+
+// replace sends the tx again with bumped fees, or a zero-value self-transfer in its place if cancel is set
+func (m *Manager) replace(client *ethclient.Client, tx *Tx, reason string, cancelTx bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), helper.GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	suggestedTipCap, suggestedFeeCap, err := helper.SuggestDynamicFees(client)
+	if err != nil {
+		return err
+	}
+
+	gasTipCap, gasFeeCap, err := helper.BumpDynamicFees(
+		tx.GasTipCap,
+		tx.GasFeeCap,
+		suggestedTipCap,
+		suggestedFeeCap,
+		helper.GetConfig().MainchainTxFeeBump,
+		helper.GetMainchainMaxGasPrice(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if cancelTx {
+		tx.Kind = KindCancel
+		tx.To = signer.Address(helper.GetSigner())
+		tx.Data = nil
+		tx.GasLimit = cancelGasLimit
+	}
+
+	tx.GasTipCap = gasTipCap
+	tx.GasFeeCap = gasFeeCap
+
+	replacedTxs.WithLabelValues(tx.Kind, reason).Inc()
+
+	return m.send(ctx, client, tx)
+}
+
+// send signs and sends the tx, then tracks it
+func (m *Manager) send(ctx context.Context, client *ethclient.Client, tx *Tx) error {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	auth, err := signer.NewTransactor(helper.GetSigner(), chainID)
+	if err != nil {
+		return err
+	}
+
+	to := tx.To
+
+	signedTx, err := auth.Signer(auth.From, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     tx.Nonce,
+		GasTipCap: tx.GasTipCap,
+		GasFeeCap: tx.GasFeeCap,
+		Gas:       tx.GasLimit,
+		To:        &to,
+		Data:      tx.Data,
+	}))
+	if err != nil {
+		return err
+	}
+
+	// the node may still have the tx, when sent again with the same fees
+	if err = client.SendTransaction(ctx, signedTx); err != nil && !isAlreadyKnown(err) {
+		return err
+	}
+
+	if len(tx.Hashes) == 0 || tx.Hashes[len(tx.Hashes)-1] != signedTx.Hash() {
+		tx.Hashes = append(tx.Hashes, signedTx.Hash())
+	}
+
+	tx.SentAt = time.Now()
+
+	m.Logger.Info("Sent mainchain tx", "kind", tx.Kind, "nonce", tx.Nonce, "txHash", signedTx.Hash(), "gasTipCap", tx.GasTipCap, "gasFeeCap", tx.GasFeeCap)
+
+	return putTx(m.storageClient, tx)
+}
+
+// isAlreadyKnown checks if the mempool rejected the tx for having it already
+func isAlreadyKnown(err error) bool {
+	return strings.Contains(err.Error(), "already known")
+}
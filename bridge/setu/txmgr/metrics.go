@@ -0,0 +1,29 @@
+package txmgr
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	pendingTxs = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "iris",
+		Subsystem: "bridge_txmgr",
+		Name:      "pending_txs",
+		Help:      "The number of mainchain txs of the bridge waiting to be mined",
+	})
+
+	replacedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "iris",
+		Subsystem: "bridge_txmgr",
+		Name:      "replaced_txs_total",
+		Help:      "The total number of mainchain txs sent again with bumped fees, by kind and reason",
+	}, []string{"kind", "reason"})
+
+	minedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "iris",
+		Subsystem: "bridge_txmgr",
+		Name:      "mined_txs_total",
+		Help:      "The total number of mainchain txs whose nonce was mined, by kind and outcome (mined, failed or replaced)",
+	}, []string{"kind", "outcome"})
+)
@@ -0,0 +1,105 @@
+package txmgr
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/zenanetwork/go-zenanet/common"
+	"github.com/zenanetwork/go-zenanet/common/hexutil"
+)
+
+// bridge db prefix of the tracked txs, keyed by nonce
+const txKeyPrefix = "txmgr-tx-"
+
+// Kinds of the txs the manager tracks
+const (
+	KindCheckpoint = "checkpoint"
+	KindTick       = "tick"
+	KindCancel     = "cancel" // zero-value self-transfer, cancelling a tx that is not needed anymore or filling a nonce gap
+)
+
+// Tx is a mainchain tx of the bridge, tracked until its nonce is mined
+type Tx struct {
+	Kind      string         `json:"kind"`
+	Nonce     uint64         `json:"nonce"`
+	To        common.Address `json:"to"`
+	Data      hexutil.Bytes  `json:"data"`
+	GasLimit  uint64         `json:"gas_limit"`
+	GasTipCap *big.Int       `json:"gas_tip_cap"`
+	GasFeeCap *big.Int       `json:"gas_fee_cap"`
+
+	// hashes of the tx and of its replacements, the last one being the latest
+	Hashes []common.Hash `json:"hashes"`
+	SentAt time.Time     `json:"sent_at"`
+
+	// current header block of the root chain contract when the checkpoint was submitted.
+	// The checkpoint landed once it advances.
+	HeaderBlock        uint64 `json:"header_block,omitempty"`
+	ChildBlockInterval uint64 `json:"child_block_interval,omitempty"`
+}
+
+// putTx stores the tx under its nonce
+func putTx(db *leveldb.DB, tx *Tx) error {
+	value, err := json.Marshal(tx)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	return db.Put(txKey(tx.Nonce), value, nil)
+}
+
+// deleteTx drops the tx tracked under nonce
+func deleteTx(db *leveldb.DB, nonce uint64) error {
+	return db.Delete(txKey(nonce), nil)
+}
+
+// getTxs returns the tracked txs, by nonce
+func getTxs(db *leveldb.DB) ([]*Tx, error) {
+	iter := db.NewIterator(levelUtil.BytesPrefix([]byte(txKeyPrefix)), nil)
+	defer iter.Release()
+
+	var txs []*Tx
+
+	for iter.Next() {
+		tx := new(Tx)
+		if err := json.Unmarshal(iter.Value(), tx); err != nil {
+			return nil, fmt.Errorf("JSON unmarshal error: %s", err)
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, iter.Error()
+}
+
+// txKey pads the nonce, for the keys to sort by nonce
+func txKey(nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", txKeyPrefix, nonce))
+}
+
+// nonceGaps returns the nonces from pendingNonce up to the last tracked tx that no tx is tracked for.
+// The txs above a gap are never mined until it is filled.
+func nonceGaps(txs []*Tx, pendingNonce uint64) []uint64 {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	tracked := make(map[uint64]bool, len(txs))
+	for _, tx := range txs {
+		tracked[tx.Nonce] = true
+	}
+
+	var gaps []uint64
+
+	for nonce := pendingNonce; nonce < txs[len(txs)-1].Nonce; nonce++ {
+		if !tracked[nonce] {
+			gaps = append(gaps, nonce)
+		}
+	}
+
+	return gaps
+}
@@ -0,0 +1,52 @@
+package txmgr
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/zenanetwork/go-zenanet/common"
+)
+
+func TestStoredTxs(t *testing.T) {
+	t.Parallel()
+
+	db, err := leveldb.OpenFile(filepath.Join(t.TempDir(), "bridge"), nil)
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	for _, nonce := range []uint64{12, 3, 10} {
+		require.NoError(t, putTx(db, &Tx{
+			Kind:      KindCheckpoint,
+			Nonce:     nonce,
+			Data:      []byte{1},
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(2),
+			Hashes:    []common.Hash{common.BigToHash(new(big.Int).SetUint64(nonce))},
+		}))
+	}
+
+	txs, err := getTxs(db)
+	require.NoError(t, err)
+	require.Len(t, txs, 3)
+
+	// by nonce
+	require.Equal(t, uint64(3), txs[0].Nonce)
+	require.Equal(t, uint64(10), txs[1].Nonce)
+	require.Equal(t, uint64(12), txs[2].Nonce)
+	require.Equal(t, big.NewInt(2), txs[2].GasFeeCap)
+
+	// 3 is pending, 4-9 and 11 are missing
+	require.Equal(t, []uint64{4, 5, 6, 7, 8, 9, 11}, nonceGaps(txs, 3))
+	require.Equal(t, []uint64{11}, nonceGaps(txs, 10))
+	require.Empty(t, nonceGaps(txs, 13))
+
+	require.NoError(t, deleteTx(db, 3))
+
+	txs, err = getTxs(db)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+}
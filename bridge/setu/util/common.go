@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/zenanetwork/iris/helper"
 	"github.com/zenanetwork/iris/types"
 	hmtypes "github.com/zenanetwork/iris/types"
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
 )
 
 type BridgeEvent string
@@ -60,10 +62,12 @@ const (
 	TopupTxStatusURL        = "/topup/isoldtx"
 	ClerkTxStatusURL        = "/clerk/isoldtx"
 	ClerkEventRecordURL     = "/clerk/event-record/%d"
+	ClerkParamsURL          = "/clerk/params"
 	LatestSlashInfoBytesURL = "/slashing/latest_slash_info_bytes"
 	TickSlashInfoListURL    = "/slashing/tick_slash_infos"
 	SlashingTxStatusURL     = "/slashing/isoldtx"
 	SlashingTickCountURL    = "/slashing/tick-count"
+	UpgradePlanURL          = "/upgrade/plan/%v"
 
 	TendermintUnconfirmedTxsURL      = "/unconfirmed_txs"
 	TendermintUnconfirmedTxsCountURL = "/num_unconfirmed_txs"
@@ -435,6 +439,26 @@ func GetChainmanagerParams(cliCtx cliContext.CLIContext) (*chainManagerTypes.Par
 	return &params, nil
 }
 
+// GetClerkParams return params
+func GetClerkParams(cliCtx cliContext.CLIContext) (*clerktypes.Params, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetIrisServerEndpoint(ClerkParamsURL),
+	)
+	if err != nil {
+		logger.Error("Error fetching clerk params", "err", err)
+		return nil, err
+	}
+
+	var params clerktypes.Params
+	if err = jsoniter.ConfigFastest.Unmarshal(response.Result, &params); err != nil {
+		logger.Error("Error unmarshalling clerk params", "url", ClerkParamsURL, "err", err)
+		return nil, err
+	}
+
+	return &params, nil
+}
+
 // GetCheckpointParams return params
 func GetCheckpointParams(cliCtx cliContext.CLIContext) (*checkpointTypes.Params, error) {
 	response, err := helper.FetchFromAPI(
@@ -477,6 +501,26 @@ func GetMilestoneParams(cliCtx cliContext.CLIContext) (*milestoneTypes.Params, e
 	return &params, nil
 }
 
+// GetUpgradePlan returns the plan of an upgrade, which tells the height it is active from
+func GetUpgradePlan(cliCtx cliContext.CLIContext, name string) (*upgradeTypes.Plan, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetIrisServerEndpoint(fmt.Sprintf(UpgradePlanURL, name)),
+	)
+	if err != nil {
+		logger.Error("Error fetching upgrade plan", "name", name, "err", err)
+		return nil, err
+	}
+
+	var plan upgradeTypes.Plan
+	if err := jsoniter.ConfigFastest.Unmarshal(response.Result, &plan); err != nil {
+		logger.Error("Error unmarshalling upgrade plan", "name", name, "err", err)
+		return nil, err
+	}
+
+	return &plan, nil
+}
+
 // GetBufferedCheckpoint return checkpoint from bueffer
 func GetBufferedCheckpoint(cliCtx cliContext.CLIContext) (*hmtypes.Checkpoint, error) {
 	response, err := helper.FetchFromAPI(
@@ -520,10 +564,10 @@ func GetLatestCheckpoint(cliCtx cliContext.CLIContext) (*hmtypes.Checkpoint, err
 }
 
 // GetLatestMilestone return last successful milestone
-func GetLatestMilestone(cliCtx cliContext.CLIContext) (*hmtypes.Milestone, error) {
+func GetLatestMilestone(cliCtx cliContext.CLIContext, chainID string) (*hmtypes.Milestone, error) {
 	response, err := helper.FetchFromAPI(
 		cliCtx,
-		helper.GetIrisServerEndpoint(LatestMilestoneURL),
+		helper.GetIrisServerEndpoint(WithChainID(LatestMilestoneURL, chainID)),
 	)
 
 	if err != nil {
@@ -541,10 +585,10 @@ func GetLatestMilestone(cliCtx cliContext.CLIContext) (*hmtypes.Milestone, error
 }
 
 // GetMilestoneCount return params
-func GetMilestoneCount(cliCtx cliContext.CLIContext) (*milestoneTypes.Count, error) {
+func GetMilestoneCount(cliCtx cliContext.CLIContext, chainID string) (*milestoneTypes.Count, error) {
 	response, err := helper.FetchFromAPI(
 		cliCtx,
-		helper.GetIrisServerEndpoint(MilestoneCountURL),
+		helper.GetIrisServerEndpoint(WithChainID(MilestoneCountURL, chainID)),
 	)
 
 	if err != nil {
@@ -561,6 +605,21 @@ func GetMilestoneCount(cliCtx cliContext.CLIContext) (*milestoneTypes.Count, err
 	return &count, nil
 }
 
+// WithChainID scopes a span, checkpoint or milestone query url to a zena child chain, it is
+// unchanged for an empty chain id
+func WithChainID(rawURL string, chainID string) string {
+	if chainID == "" {
+		return rawURL
+	}
+
+	separator := "?"
+	if strings.Contains(rawURL, "?") {
+		separator = "&"
+	}
+
+	return rawURL + separator + "chain_id=" + url.QueryEscape(chainID)
+}
+
 // AppendPrefix returns publickey in uncompressed format
 func AppendPrefix(signerPubKey []byte) []byte {
 	// append prefix - "0x04" as iris uses publickey in uncompressed format. Refer below link
@@ -646,6 +705,48 @@ func GetClerkEventRecord(cliCtx cliContext.CLIContext, stateId int64) (*clerktyp
 	return &eventRecord, nil
 }
 
+// IsOldTx checks if iris has already processed the event log, in the sequence store of the module of the event
+func IsOldTx(cliCtx cliContext.CLIContext, txHash string, logIndex uint64, eventType BridgeEvent) (bool, error) {
+	queryParam := map[string]interface{}{
+		"txhash":   txHash,
+		"logindex": logIndex,
+	}
+
+	// define the endpoint based on the type of event
+	var endpoint string
+
+	switch eventType {
+	case StakingEvent:
+		endpoint = helper.GetIrisServerEndpoint(StakingTxStatusURL)
+	case TopupEvent:
+		endpoint = helper.GetIrisServerEndpoint(TopupTxStatusURL)
+	case ClerkEvent:
+		endpoint = helper.GetIrisServerEndpoint(ClerkTxStatusURL)
+	case SlashingEvent:
+		endpoint = helper.GetIrisServerEndpoint(SlashingTxStatusURL)
+	}
+
+	url, err := CreateURLWithQuery(endpoint, queryParam)
+	if err != nil {
+		logger.Error("Error in creating url", "endpoint", endpoint, "error", err)
+		return false, err
+	}
+
+	res, err := helper.FetchFromAPI(cliCtx, url)
+	if err != nil {
+		logger.Error("Error fetching tx status", "url", url, "error", err)
+		return false, err
+	}
+
+	var status bool
+	if err := jsoniter.ConfigFastest.Unmarshal(res.Result, &status); err != nil {
+		logger.Error("Error unmarshalling tx status received from Iris Server", "error", err)
+		return false, err
+	}
+
+	return status, nil
+}
+
 func GetUnconfirmedTxnCount(event interface{}) int {
 	defer LogElapsedTimeForStateSyncedEvent(event, "GetUnconfirmedTxnCount", time.Now())
 
@@ -0,0 +1,35 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// skippedLogPrefix is the bridge db prefix of the L1 logs the bridge skips
+const skippedLogPrefix = "skipped-log-"
+
+// SkipLog marks the L1 log as skipped, so that the bridge does not process it anymore
+func SkipLog(db *leveldb.DB, txHash string, logIndex uint64) error {
+	return db.Put(skippedLogKey(txHash, logIndex), []byte{}, nil)
+}
+
+// IsLogSkipped checks if the L1 log is skipped
+func IsLogSkipped(db *leveldb.DB, txHash string, logIndex uint64) bool {
+	if db == nil {
+		return false
+	}
+
+	skipped, err := db.Has(skippedLogKey(txHash, logIndex), nil)
+	if err != nil {
+		logger.Error("Error checking if log is skipped", "txHash", txHash, "logIndex", logIndex, "error", err)
+		return false
+	}
+
+	return skipped
+}
+
+func skippedLogKey(txHash string, logIndex uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s-%d", skippedLogPrefix, strings.ToLower(txHash), logIndex))
+}
@@ -0,0 +1,32 @@
+package util
+
+import (
+	"github.com/RichardKnop/machinery/v1/tasks"
+)
+
+// BridgeTracerName is the name of the tracer of the bridge spans
+const BridgeTracerName = "iris-bridge"
+
+// TaskHeadersCarrier carries a trace context in the headers of a machinery task
+type TaskHeadersCarrier tasks.Headers
+
+// Get returns the value of the header key, empty if it is missing or not a string
+func (c TaskHeadersCarrier) Get(key string) string {
+	value, _ := c[key].(string)
+	return value
+}
+
+// Set sets the header key to value
+func (c TaskHeadersCarrier) Set(key string, value string) {
+	c[key] = value
+}
+
+// Keys returns the header keys
+func (c TaskHeadersCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
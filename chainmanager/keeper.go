@@ -55,6 +55,11 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // GetParams gets the chainmanager module's parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	k.paramSpace.Get(ctx, types.KeyMainchainTxConfirmations, &params.MainchainTxConfirmations)
+	k.paramSpace.Get(ctx, types.KeyMaticchainTxConfirmations, &params.MaticchainTxConfirmations)
+	k.paramSpace.Get(ctx, types.KeyChainParams, &params.ChainParams)
+	// child chains are not stored until set by governance
+	k.paramSpace.GetIfExists(ctx, types.KeyChildChains, &params.ChildChains)
+
 	return
 }
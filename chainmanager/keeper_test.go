@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/zenanetwork/iris/app"
 	"github.com/zenanetwork/iris/chainmanager/types"
+	hmTypes "github.com/zenanetwork/iris/types"
 )
 
 type KeeperTestSuite struct {
@@ -38,3 +39,42 @@ func (suite *KeeperTestSuite) TestParamsGetterSetter() {
 
 	require.Equal(t, params, actualParams)
 }
+
+func (suite *KeeperTestSuite) TestChildChainParams() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	params := types.DefaultParams()
+	childChain := types.ChildChainParams{
+		ZenaChainID:          "15002",
+		RootChainAddress:     hmTypes.HexToIrisAddress("0x0000000000000000000000000000000000000011"),
+		StateReceiverAddress: hmTypes.HexToIrisAddress("0x0000000000000000000000000000000000000012"),
+		ValidatorSetAddress:  hmTypes.HexToIrisAddress("0x0000000000000000000000000000000000000013"),
+	}
+	params.ChildChains = []types.ChildChainParams{childChain}
+	require.NoError(t, params.Validate())
+
+	app.ChainKeeper.SetParams(ctx, params)
+	actualParams := app.ChainKeeper.GetParams(ctx)
+	require.Equal(t, params, actualParams)
+
+	require.True(t, actualParams.IsChildChain(childChain.ZenaChainID))
+	require.False(t, actualParams.IsChildChain(params.ChainParams.ZenaChainID))
+	require.Equal(t, []string{params.ChainParams.ZenaChainID, childChain.ZenaChainID}, actualParams.GetChainIDs())
+
+	chainParams, ok := actualParams.GetChainParams(childChain.ZenaChainID)
+	require.True(t, ok)
+	require.Equal(t, childChain.RootChainAddress, chainParams.RootChainAddress)
+	require.Equal(t, childChain.StateReceiverAddress, chainParams.StateReceiverAddress)
+	require.Equal(t, childChain.ValidatorSetAddress, chainParams.ValidatorSetAddress)
+	require.Equal(t, params.ChainParams.StakingManagerAddress, chainParams.StakingManagerAddress)
+
+	_, ok = actualParams.GetChainParams("15003")
+	require.False(t, ok)
+
+	// a child chain can't reuse the chain id of another chain
+	params.ChildChains = append(params.ChildChains, childChain)
+	require.Error(t, params.Validate())
+
+	childChain.ZenaChainID = params.ChainParams.ZenaChainID
+	params.ChildChains = []types.ChildChainParams{childChain}
+	require.Error(t, params.Validate())
+}
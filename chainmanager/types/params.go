@@ -12,9 +12,8 @@ import (
 
 // Default parameter values
 const (
-	DefaultMainchainTxConfirmations           uint64 = 6
-	DefaultMaticchainTxConfirmations          uint64 = 10
-	DefaultMaticchainMilestoneTxConfirmations uint64 = 16
+	DefaultMainchainTxConfirmations  uint64 = 6
+	DefaultMaticchainTxConfirmations uint64 = 10
 )
 
 var (
@@ -27,6 +26,7 @@ var (
 	KeyMainchainTxConfirmations  = []byte("MainchainTxConfirmations")
 	KeyMaticchainTxConfirmations = []byte("MaticchainTxConfirmations")
 	KeyChainParams               = []byte("ChainParams")
+	KeyChildChains               = []byte("ChildChains")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -60,11 +60,33 @@ func (cp ChainParams) String() string {
 		cp.ZenaChainID, cp.MaticTokenAddress, cp.StakingManagerAddress, cp.SlashManagerAddress, cp.RootChainAddress, cp.StakingInfoAddress, cp.StateSenderAddress, cp.StateReceiverAddress, cp.ValidatorSetAddress)
 }
 
+// ChildChainParams are the params of a zena child chain secured in addition to the chain of the
+// chain params, by the same validator set. It has its own root chain contract on the main chain,
+// and shares the other main chain contracts.
+type ChildChainParams struct {
+	ZenaChainID      string              `json:"zena_chain_id" yaml:"zena_chain_id"`
+	RootChainAddress hmTypes.IrisAddress `json:"root_chain_address" yaml:"root_chain_address"`
+
+	// Zena Chain Contracts
+	StateReceiverAddress hmTypes.IrisAddress `json:"state_receiver_address" yaml:"state_receiver_address"`
+	ValidatorSetAddress  hmTypes.IrisAddress `json:"validator_set_address" yaml:"validator_set_address"`
+}
+
+func (cp ChildChainParams) String() string {
+	return fmt.Sprintf(`
+	ZenaChainID:          %s
+	RootChainAddress:     %s
+	StateReceiverAddress: %s
+	ValidatorSetAddress:  %s`,
+		cp.ZenaChainID, cp.RootChainAddress, cp.StateReceiverAddress, cp.ValidatorSetAddress)
+}
+
 // Params defines the parameters for the chainmanager module.
 type Params struct {
-	MainchainTxConfirmations  uint64      `json:"mainchain_tx_confirmations" yaml:"mainchain_tx_confirmations"`
-	MaticchainTxConfirmations uint64      `json:"maticchain_tx_confirmations" yaml:"maticchain_tx_confirmations"`
-	ChainParams               ChainParams `json:"chain_params" yaml:"chain_params"`
+	MainchainTxConfirmations  uint64             `json:"mainchain_tx_confirmations" yaml:"mainchain_tx_confirmations"`
+	MaticchainTxConfirmations uint64             `json:"maticchain_tx_confirmations" yaml:"maticchain_tx_confirmations"`
+	ChainParams               ChainParams        `json:"chain_params" yaml:"chain_params"`
+	ChildChains               []ChildChainParams `json:"child_chains" yaml:"child_chains"`
 }
 
 // NewParams creates a new Params object
@@ -84,7 +106,55 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyMainchainTxConfirmations, &p.MainchainTxConfirmations},
 		{KeyMaticchainTxConfirmations, &p.MaticchainTxConfirmations},
 		{KeyChainParams, &p.ChainParams},
+		{KeyChildChains, &p.ChildChains},
+	}
+}
+
+// IsChildChain returns true if chainID is a child chain secured in addition to the chain of the chain params
+func (p Params) IsChildChain(chainID string) bool {
+	for _, childChain := range p.ChildChains {
+		if childChain.ZenaChainID == chainID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetChainParams returns the chain params of a zena chain, those of a child chain made of its
+// own contracts and the shared main chain contracts
+func (p Params) GetChainParams(chainID string) (ChainParams, bool) {
+	if chainID == p.ChainParams.ZenaChainID {
+		return p.ChainParams, true
+	}
+
+	for _, childChain := range p.ChildChains {
+		if childChain.ZenaChainID != chainID {
+			continue
+		}
+
+		chainParams := p.ChainParams
+		chainParams.ZenaChainID = childChain.ZenaChainID
+		chainParams.RootChainAddress = childChain.RootChainAddress
+		chainParams.StateReceiverAddress = childChain.StateReceiverAddress
+		chainParams.ValidatorSetAddress = childChain.ValidatorSetAddress
+
+		return chainParams, true
 	}
+
+	return ChainParams{}, false
+}
+
+// GetChainIDs returns the ids of all secured zena chains, the chain of the chain params first
+func (p Params) GetChainIDs() []string {
+	chainIDs := make([]string, 0, len(p.ChildChains)+1)
+	chainIDs = append(chainIDs, p.ChainParams.ZenaChainID)
+
+	for _, childChain := range p.ChildChains {
+		chainIDs = append(chainIDs, childChain.ZenaChainID)
+	}
+
+	return chainIDs
 }
 
 // Equal returns a boolean determining if two Params types are identical.
@@ -104,6 +174,10 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("MaticchainTxConfirmations: %d\n", p.MaticchainTxConfirmations))
 	sb.WriteString(fmt.Sprintf("ChainParams: %s\n", p.ChainParams.String()))
 
+	for _, childChain := range p.ChildChains {
+		sb.WriteString(fmt.Sprintf("ChildChain: %s\n", childChain.String()))
+	}
+
 	return sb.String()
 }
 
@@ -141,6 +215,32 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	chainIDs := map[string]bool{p.ChainParams.ZenaChainID: true}
+
+	for _, childChain := range p.ChildChains {
+		if childChain.ZenaChainID == "" {
+			return fmt.Errorf("Invalid empty zena_chain_id in child_chains")
+		}
+
+		if chainIDs[childChain.ZenaChainID] {
+			return fmt.Errorf("Duplicate zena_chain_id %s in child_chains", childChain.ZenaChainID)
+		}
+
+		chainIDs[childChain.ZenaChainID] = true
+
+		if err := validateIrisAddress("root_chain_address", childChain.RootChainAddress); err != nil {
+			return err
+		}
+
+		if err := validateIrisAddress("state_receiver_address", childChain.StateReceiverAddress); err != nil {
+			return err
+		}
+
+		if err := validateIrisAddress("validator_set_address", childChain.ValidatorSetAddress); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
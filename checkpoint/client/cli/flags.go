@@ -14,4 +14,8 @@ const (
 	FlagAutoConfigure      = "auto-configure"
 	FlagLimit              = "limit"
 	FlagPage               = "page"
+	FlagBlockNumber        = "block"
+	FlagBlockHash          = "hash"
+	FlagFromBlock          = "from-block"
+	FlagToBlock            = "to-block"
 )
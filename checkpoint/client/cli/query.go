@@ -43,6 +43,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetCheckpointBuffer(cdc),
 			GetLastNoACK(cdc),
 			GetCheckpointByNumber(cdc),
+			GetCheckpointBlock(cdc),
+			GetFinality(cdc),
 			GetCheckpointCount(cdc),
 			GetCheckpointLatest(cdc),
 			GetCheckpointList(cdc),
@@ -180,6 +182,118 @@ func GetCheckpointByNumber(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCheckpointBlock get checkpoint covering a zena block with the block header proof
+func GetCheckpointBlock(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-block",
+		Short: "get checkpoint covering a zena block and the merkle path of the block header in the checkpoint root hash",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			blockNumber := viper.GetUint64(FlagBlockNumber)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointBlockParams(blockNumber))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoint covering the block
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBlock), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var checkpointBlock types.CheckpointBlock
+			if err = jsoniter.ConfigFastest.Unmarshal(res, &checkpointBlock); err != nil {
+				return err
+			}
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// build the block proof from the zena headers
+			blockProof, err := types.GetBlockProof(checkpointBlock, &contractCallerObj)
+			if err != nil {
+				return err
+			}
+
+			if err = blockProof.Verify(); err != nil {
+				return err
+			}
+
+			out, err := jsoniter.ConfigFastest.Marshal(blockProof)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block=<zena-block-number>")
+
+	if err := cmd.MarkFlagRequired(FlagBlockNumber); err != nil {
+		logger.Error("GetCheckpointBlock | MarkFlagRequired | FlagBlockNumber", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetFinality get the finality of a zena block or of a range of zena blocks
+func GetFinality(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality",
+		Short: "get the finality of a zena block (none, milestone, checkpointed or l1-acked) by number or hash, or of a range of zena blocks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			query, params := types.QueryFinality, interface{}(nil)
+
+			switch {
+			case viper.GetUint64(FlagToBlock) != 0:
+				query = types.QueryFinalityRange
+				params = types.NewQueryFinalityRangeParams(viper.GetUint64(FlagFromBlock), viper.GetUint64(FlagToBlock))
+			case viper.GetString(FlagBlockHash) != "":
+				params = types.NewQueryFinalityParams(0, viper.GetString(FlagBlockHash))
+			case viper.GetUint64(FlagBlockNumber) != 0:
+				params = types.NewQueryFinalityParams(viper.GetUint64(FlagBlockNumber), "")
+			default:
+				return errors.New("one of --block, --hash or --from-block and --to-block is required")
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query)
+			if chainID := viper.GetString(FlagZenaChainID); chainID != "" {
+				path = fmt.Sprintf("%s/%s", path, chainID)
+			}
+
+			res, _, err := cliCtx.QueryWithData(path, queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block=<zena-block-number>")
+	cmd.Flags().String(FlagBlockHash, "", "--hash=<zena-block-hash>")
+	cmd.Flags().Uint64(FlagFromBlock, 0, "--from-block=<first-zena-block-number>")
+	cmd.Flags().Uint64(FlagToBlock, 0, "--to-block=<last-zena-block-number>")
+	cmd.Flags().String(FlagZenaChainID, "", "--zena-chain-id=<zena-child-chain-id>, the main zena chain if not set")
+
+	return cmd
+}
+
 // GetCheckpointCount get number of checkpoint received count
 func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
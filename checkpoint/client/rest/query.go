@@ -41,6 +41,44 @@ type params struct {
 	AvgCheckpointLength     int `json:"avg_checkpoint_length"`
 	MaxCheckPoint           int `json:"max_checkpoint_length"`
 	ChildChainBlockInterval int `json:"child_chain_block_interval"`
+
+	MilestoneLength          int `json:"milestone_length"`
+	MilestoneBufferLength    int `json:"milestone_buffer_length"`
+	MilestoneTxConfirmations int `json:"milestone_tx_confirmations"`
+	MilestoneBufferTime      int `json:"milestone_buffer_time"`
+
+	AdaptiveCheckpoint adaptiveCheckpointParams `json:"adaptive_checkpoint"`
+}
+
+type adaptiveCheckpointParams struct {
+	Enabled             bool   `json:"enabled"`
+	TargetInterval      int    `json:"target_interval"`
+	MinCheckpointLength int    `json:"min_checkpoint_length"`
+	BaseFeeThreshold    string `json:"base_fee_threshold"`
+}
+
+// It represents the next checkpoint range chosen by the adaptive checkpoint sizing
+//
+//swagger:response adaptiveCheckpointPreviewResponse
+type adaptiveCheckpointPreviewResponse struct {
+	//in:body
+	Output adaptiveCheckpointPreviewStructure `json:"output"`
+}
+
+type adaptiveCheckpointPreviewStructure struct {
+	Height string             `json:"height"`
+	Result adaptiveCheckpoint `json:"result"`
+}
+
+type adaptiveCheckpoint struct {
+	Enabled        bool   `json:"enabled"`
+	StartBlock     int64  `json:"start_block"`
+	EndBlock       int64  `json:"end_block"`
+	Ready          bool   `json:"ready"`
+	LatestBlock    int64  `json:"latest_block"`
+	SampleBlocks   int64  `json:"sample_blocks"`
+	SampleDuration int64  `json:"sample_duration"`
+	BaseFee        string `json:"base_fee"`
 }
 
 // It represents the checkpoint
@@ -170,6 +208,28 @@ type validator struct {
 	Accum        int    `json:"accum"`
 }
 
+// It represents the checkpoint of a zena block with the block header proof
+//
+//swagger:response checkpointBlockResponse
+type checkpointBlockResponse struct {
+	//in:body
+	Output checkpointBlockStructure `json:"output"`
+}
+
+type checkpointBlockStructure struct {
+	Height string          `json:"height"`
+	Result checkpointBlock `json:"result"`
+}
+
+type checkpointBlock struct {
+	BlockNumber int64      `json:"block_number"`
+	Number      int64      `json:"number"`
+	Checkpoint  checkpoint `json:"checkpoint"`
+	Leaf        string     `json:"leaf"`
+	Index       int64      `json:"index"`
+	Proof       string     `json:"proof"`
+}
+
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/checkpoints/params", paramsHandlerFn(cliCtx)).Methods("GET")
 
@@ -181,15 +241,31 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 	r.HandleFunc("/checkpoints/prepare", prepareCheckpointHandlerFn(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/adaptive-preview", adaptiveCheckpointPreviewHandlerFn(cliCtx)).Methods("GET")
+
 	r.HandleFunc("/checkpoints/latest", latestCheckpointHandlerFunc(cliCtx)).Methods("GET")
 
 	r.HandleFunc("/checkpoints/last-no-ack", noackHandlerFn(cliCtx)).Methods("GET")
 
 	r.HandleFunc("/checkpoints/list", checkpointListhandlerFn(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/block/{blockNumber}", checkpointBlockHandlerFn(cliCtx)).Methods("GET")
+
 	r.HandleFunc("/checkpoints/{number}", checkpointByNumberHandlerFunc(cliCtx)).Methods("GET")
 
 	registerQueryMilestoneRoutes(cliCtx, r)
+	registerQueryFinalityRoutes(cliCtx, r)
+}
+
+// checkpointQueryPath returns the querier path of a checkpoint or milestone query, of the zena
+// child chain in the chain_id query param if any
+func checkpointQueryPath(r *http.Request, query string) string {
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query)
+	if chainID := r.URL.Query().Get("chain_id"); chainID != "" {
+		path = fmt.Sprintf("%s/%s", path, chainID)
+	}
+
+	return path
 }
 
 // swagger:route GET /checkpoints/params checkpoint checkpointParams
@@ -232,7 +308,31 @@ func checkpointBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// fetch checkpoint
-		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
+		result, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryCheckpointBuffer), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// swagger:route GET /checkpoints/adaptive-preview checkpoint adaptiveCheckpointPreview
+// It returns the range of the next checkpoint the adaptive checkpoint sizing would choose from the zena block rate and the L1 base fee, enabled or not
+// responses:
+//
+//	200: adaptiveCheckpointPreviewResponse
+func adaptiveCheckpointPreviewHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// choose next checkpoint range
+		result, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryAdaptiveCheckpoint), nil)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -257,7 +357,7 @@ func checkpointCountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		RestLogger.Debug("Fetching number of checkpoints from state")
 
-		ackCountBytes, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+		ackCountBytes, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryAckCount), nil)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -430,7 +530,7 @@ func noackHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastNoAck), nil)
+		res, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryLastNoAck), nil)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -593,7 +693,7 @@ func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		// Get ack count
 		//
 
-		ackcountBytes, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+		ackcountBytes, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryAckCount), nil)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -628,7 +728,7 @@ func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		// Get checkpoint
 		//
 
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+		res, _, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryCheckpoint), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -703,7 +803,7 @@ func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// query checkpoint
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+		res, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryCheckpoint), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -741,6 +841,92 @@ func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+//swagger:parameters checkpointBlock
+type checkpointBlockParams struct {
+
+	//Zena block number
+	//required:true
+	//in:path
+	BlockNumber int64 `json:"blockNumber"`
+}
+
+// swagger:route GET /checkpoints/block/{blockNumber} checkpoint checkpointBlock
+// It returns the checkpoint covering a zena block and the merkle path of the block header in the checkpoint root hash
+// responses:
+//
+//	200: checkpointBlockResponse
+func checkpointBlockHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get zena block number
+		blockNumber, ok := rest.ParseUint64OrReturnBadRequest(w, vars["blockNumber"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointBlockParams(blockNumber))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query checkpoint covering the block
+		res, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryCheckpointBlock), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoint found"); !ok {
+			return
+		}
+
+		var checkpointBlock types.CheckpointBlock
+		if err = jsoniter.ConfigFastest.Unmarshal(res, &checkpointBlock); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		contractCallerObj, err := helper.NewContractCaller()
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var contractCaller helper.IContractCaller = &contractCallerObj
+		if chainID := r.URL.Query().Get("chain_id"); chainID != "" {
+			if contractCaller, err = contractCallerObj.ForChildChain(chainID); err != nil {
+				hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		// build the block proof from the zena headers
+		blockProof, err := types.GetBlockProof(checkpointBlock, contractCaller)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		result, err := jsoniter.ConfigFastest.Marshal(blockProof)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
 //swagger:parameters checkpointList
 type checkpointListParams struct {
 
@@ -790,7 +976,7 @@ func checkpointListhandlerFn(
 		}
 
 		// query checkpoint
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointList), queryParams)
+		res, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryCheckpointList), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -807,10 +993,18 @@ func checkpointListhandlerFn(
 	}
 }
 
-//swagger:parameters checkpointList checkpointById checkpointLatest overview checkpointLastNoAck checkpointPrepare checkpointCount checkpointParams checkpointBuffer
+//swagger:parameters checkpointList checkpointById checkpointLatest overview checkpointLastNoAck checkpointPrepare checkpointCount checkpointParams checkpointBuffer adaptiveCheckpointPreview
 type Height struct {
 
 	//Block Height
 	//in:query
 	Height string `json:"height"`
 }
+
+//swagger:parameters checkpointList checkpointById checkpointLatest checkpointLastNoAck checkpointCount checkpointBuffer checkpointBlock milestoneCount finality finalityRange adaptiveCheckpointPreview
+type ZenaChainID struct {
+
+	//Chain ID of a zena child chain, the chain of the chain params if empty
+	//in:query
+	ChainID string `json:"chain_id"`
+}
@@ -0,0 +1,162 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/zenanetwork/iris/checkpoint/types"
+	hmRest "github.com/zenanetwork/iris/types/rest"
+)
+
+// It represents the finality of a zena block
+//
+//swagger:response finalityResponse
+type finalityResponse struct {
+	//in:body
+	Output finalityStructure `json:"output"`
+}
+
+type finalityStructure struct {
+	Height string        `json:"height"`
+	Result blockFinality `json:"result"`
+}
+
+// It represents the finality of a range of zena blocks
+//
+//swagger:response finalityRangeResponse
+type finalityRangeResponse struct {
+	//in:body
+	Output finalityRangeStructure `json:"output"`
+}
+
+type finalityRangeStructure struct {
+	Height string          `json:"height"`
+	Result []blockFinality `json:"result"`
+}
+
+type blockFinality struct {
+	BlockNumber      int64  `json:"block_number"`
+	BlockHash        string `json:"block_hash"`
+	Level            string `json:"level"`
+	MilestoneNumber  int64  `json:"milestone_number"`
+	MilestoneID      string `json:"milestone_id"`
+	CheckpointNumber int64  `json:"checkpoint_number"`
+	FinalizedAt      int64  `json:"finalized_at"`
+}
+
+func registerQueryFinalityRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/finality", finalityRangeHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/finality/{block}", finalityHandlerFn(cliCtx)).Methods("GET")
+}
+
+//swagger:parameters finality
+type finalityParams struct {
+
+	//Zena block number, or block hash starting with 0x
+	//required:true
+	//in:path
+	Block string `json:"block"`
+}
+
+// swagger:route GET /finality/{block} checkpoint finality
+// It returns the finality of a zena block (none, milestone, checkpointed or l1-acked), the milestone and checkpoint covering it and the iris height it became final at
+// responses:
+//
+//	200: finalityResponse
+func finalityHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// zena block number or hash
+		var params types.QueryFinalityParams
+		if strings.HasPrefix(vars["block"], "0x") {
+			params = types.NewQueryFinalityParams(0, vars["block"])
+		} else {
+			blockNumber, ok := rest.ParseUint64OrReturnBadRequest(w, vars["block"])
+			if !ok {
+				return
+			}
+
+			params = types.NewQueryFinalityParams(blockNumber, "")
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryFinality), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//swagger:parameters finalityRange
+type finalityRangeParams struct {
+
+	//First zena block of the range
+	//required:true
+	//in:query
+	FromBlock int64 `json:"from_block"`
+
+	//Last zena block of the range, at most 1000 blocks after from_block
+	//required:true
+	//in:query
+	ToBlock int64 `json:"to_block"`
+}
+
+// swagger:route GET /finality checkpoint finalityRange
+// It returns the finality of every zena block of a range
+// responses:
+//
+//	200: finalityRangeResponse
+func finalityRangeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		fromBlock, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("from_block"))
+		if !ok {
+			return
+		}
+
+		toBlock, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("to_block"))
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryFinalityRangeParams(fromBlock, toBlock))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryFinalityRange), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -1,7 +1,6 @@
 package rest
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -12,6 +11,7 @@ import (
 	"github.com/zenanetwork/iris/checkpoint/types"
 	"github.com/zenanetwork/iris/helper"
 	hmRest "github.com/zenanetwork/iris/types/rest"
+	upgradeUtils "github.com/zenanetwork/iris/upgrade/client/utils"
 )
 
 func registerQueryMilestoneRoutes(cliCtx context.CLIContext, r *mux.Router) {
@@ -31,10 +31,10 @@ func milestoneLatestHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 		}
 
 		// Fetch latest milestone
-		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLatestMilestone), nil)
+		result, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryLatestMilestone), nil)
 
 		// Return status code 503 (Service Unavailable) if HF hasn't been activated
-		if height < helper.GetAalborgHardForkHeight() {
+		if !isAalborgActive(cliCtx, height) {
 			hmRest.WriteErrorResponse(w, http.StatusServiceUnavailable, "Aalborg hardfork not activated yet")
 
 			return
@@ -64,10 +64,10 @@ func milestoneCountHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		countBytes, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCount), nil)
+		countBytes, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryCount), nil)
 
 		// Return status code 503 (Service Unavailable) if HF hasn't been activated
-		if height < helper.GetAalborgHardForkHeight() {
+		if !isAalborgActive(cliCtx, height) {
 			hmRest.WriteErrorResponse(w, http.StatusServiceUnavailable, "Aalborg hardfork not activated yet")
 
 			return
@@ -124,10 +124,10 @@ func milestoneByNumberHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 		}
 
 		// query milestone
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneByNumber), queryParams)
+		res, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryMilestoneByNumber), queryParams)
 
 		// Return status code 503 (Service Unavailable) if HF hasn't been activated
-		if height < helper.GetAalborgHardForkHeight() {
+		if !isAalborgActive(cliCtx, height) {
 			hmRest.WriteErrorResponse(w, http.StatusServiceUnavailable, "Aalborg hardfork not activated yet")
 
 			return
@@ -151,10 +151,10 @@ func latestNoAckMilestoneHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLatestNoAckMilestone), nil)
+		result, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryLatestNoAckMilestone), nil)
 
 		// Return status code 503 (Service Unavailable) if HF hasn't been activated
-		if height < helper.GetAalborgHardForkHeight() {
+		if !isAalborgActive(cliCtx, height) {
 			hmRest.WriteErrorResponse(w, http.StatusServiceUnavailable, "Aalborg hardfork not activated yet")
 
 			return
@@ -207,10 +207,10 @@ func noAckMilestoneByIDHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNoAckMilestoneByID), queryID)
+		result, height, err := cliCtx.QueryWithData(checkpointQueryPath(r, types.QueryNoAckMilestoneByID), queryID)
 
 		// Return status code 503 (Service Unavailable) if HF hasn't been activated
-		if height < helper.GetAalborgHardForkHeight() {
+		if !isAalborgActive(cliCtx, height) {
 			hmRest.WriteErrorResponse(w, http.StatusServiceUnavailable, "Aalborg hardfork not activated yet")
 
 			return
@@ -269,3 +269,9 @@ func milestoneByIDHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// isAalborgActive returns true if the aalborg upgrade, which adds the milestones, is active at height
+func isAalborgActive(cliCtx context.CLIContext, height int64) bool {
+	plan, err := upgradeUtils.QueryPlan(cliCtx, helper.AalborgUpgrade)
+	return err == nil && plan.IsActive(height)
+}
@@ -2,6 +2,7 @@ package checkpoint
 
 import (
 	"errors"
+	"fmt"
 	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,26 +13,47 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
-	keeper.SetParams(ctx, data.Params)
+	keeper.SetParams(ctx, data.Params.WithMilestoneDefaults())
 
+	initChainGenesis(ctx, keeper, data.BufferedCheckpoint, data.LastNoACK, data.AckCount, data.Checkpoints)
+
+	for _, childChain := range data.ChildChains {
+		chainKeeper, err := keeper.WithChainID(ctx, childChain.ZenaChainID)
+		if err != nil || chainKeeper.ChainID() == "" {
+			panic(fmt.Sprintf("checkpoints of zena chain %s which is not a child chain", childChain.ZenaChainID))
+		}
+
+		initChainGenesis(ctx, chainKeeper, childChain.BufferedCheckpoint, childChain.LastNoACK, childChain.AckCount, childChain.Checkpoints)
+	}
+}
+
+// initChainGenesis sets the checkpoint state of the zena chain the keeper is scoped to
+func initChainGenesis(
+	ctx sdk.Context,
+	keeper Keeper,
+	bufferedCheckpoint *hmTypes.Checkpoint,
+	lastNoACK uint64,
+	ackCount uint64,
+	checkpoints []hmTypes.Checkpoint,
+) {
 	// Set last no-ack
-	if data.LastNoACK > 0 {
-		keeper.SetLastNoAck(ctx, data.LastNoACK)
+	if lastNoACK > 0 {
+		keeper.SetLastNoAck(ctx, lastNoACK)
 	}
 
 	// Add finalised checkpoints to state
-	if len(data.Checkpoints) != 0 {
-		if data.AckCount > math.MaxInt {
+	if len(checkpoints) != 0 {
+		if ackCount > math.MaxInt {
 			panic(errors.New("AckCount value out of range for int"))
 		}
 		// check if we are provided all the headers
-		if int(data.AckCount) != len(data.Checkpoints) {
+		if int(ackCount) != len(checkpoints) {
 			panic(errors.New("Incorrect state in state-dump , Please Check "))
 		}
 		// sort headers before loading to state
-		data.Checkpoints = hmTypes.SortHeaders(data.Checkpoints)
+		checkpoints = hmTypes.SortHeaders(checkpoints)
 		// load checkpoints to state
-		for i, checkpoint := range data.Checkpoints {
+		for i, checkpoint := range checkpoints {
 			//nolint:gosec
 			checkpointIndex := uint64(i) + 1
 			if err := keeper.AddCheckpoint(ctx, checkpointIndex, checkpoint); err != nil {
@@ -44,14 +66,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	}
 
 	// Add checkpoint in buffer
-	if data.BufferedCheckpoint != nil {
-		if err := keeper.SetCheckpointBuffer(ctx, *data.BufferedCheckpoint); err != nil {
+	if bufferedCheckpoint != nil {
+		if err := keeper.SetCheckpointBuffer(ctx, *bufferedCheckpoint); err != nil {
 			keeper.Logger(ctx).Error("InitGenesis | SetCheckpointBuffer", "error", err)
 		}
 	}
 
 	// Set initial ack count
-	keeper.UpdateACKCountWithValue(ctx, data.AckCount)
+	keeper.UpdateACKCountWithValue(ctx, ackCount)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -60,11 +82,30 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 
 	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
 
-	return types.NewGenesisState(
+	genesisState := types.NewGenesisState(
 		params,
 		bufferedCheckpoint,
 		keeper.GetLastNoAck(ctx),
 		keeper.GetACKCount(ctx),
 		hmTypes.SortHeaders(keeper.GetCheckpoints(ctx)),
 	)
+
+	for _, childChain := range keeper.ck.GetParams(ctx).ChildChains {
+		chainKeeper, err := keeper.WithChainID(ctx, childChain.ZenaChainID)
+		if err != nil {
+			panic(err)
+		}
+
+		childBufferedCheckpoint, _ := chainKeeper.GetCheckpointFromBuffer(ctx)
+
+		genesisState.ChildChains = append(genesisState.ChildChains, types.ChildChainGenesisState{
+			ZenaChainID:        childChain.ZenaChainID,
+			BufferedCheckpoint: childBufferedCheckpoint,
+			LastNoACK:          chainKeeper.GetLastNoAck(ctx),
+			AckCount:           chainKeeper.GetACKCount(ctx),
+			Checkpoints:        hmTypes.SortHeaders(chainKeeper.GetCheckpoints(ctx)),
+		})
+	}
+
+	return genesisState
 }
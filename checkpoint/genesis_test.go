@@ -82,3 +82,21 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	require.Equal(t, genesisState.Params, actualParams.Params)
 	require.LessOrEqual(t, len(actualParams.Checkpoints), len(genesisState.Checkpoints))
 }
+
+func (suite *GenesisTestSuite) TestInitGenesisMilestoneParamsDefaults() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	// genesis files from before the milestone params leave them zero
+	params := types.DefaultParams()
+	params.MilestoneLength = 0
+	params.MilestoneBufferLength = 0
+	params.MilestoneTxConfirmations = 0
+	params.MilestoneBufferTime = 0
+
+	genesisState := types.NewGenesisState(params, nil, 0, 0, nil)
+	require.NoError(t, types.ValidateGenesis(genesisState))
+
+	checkpoint.InitGenesis(ctx, app.CheckpointKeeper, genesisState)
+
+	require.Equal(t, types.DefaultParams(), app.CheckpointKeeper.GetParams(ctx))
+}
@@ -18,6 +18,14 @@ import (
 func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		// check chain id, the checkpoints and milestones of a child chain are kept apart
+		k, err := k.WithChainID(ctx, msgZenaChainID(msg))
+		if err != nil {
+			k.Logger(ctx).Error("Invalid Zena chain id", "msgChainID", msgZenaChainID(msg))
+			return common.ErrInvalidZenaChainID(k.Codespace()).Result()
+		}
+
 		switch msg := msg.(type) {
 		case types.MsgCheckpointAdjust:
 			return handleMsgCheckpointAdjust(ctx, msg, k, contractCaller)
@@ -37,6 +45,24 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 	}
 }
 
+// msgZenaChainID returns the zena chain id of the checkpoint and milestone msgs, empty for the others
+func msgZenaChainID(msg sdk.Msg) string {
+	switch msg := msg.(type) {
+	case types.MsgCheckpoint:
+		return msg.ZenaChainID
+	case types.MsgCheckpointAck:
+		return msg.ZenaChainID
+	case types.MsgCheckpointNoAck:
+		return msg.ZenaChainID
+	case types.MsgMilestone:
+		return msg.ZenaChainID
+	case types.MsgMilestoneTimeout:
+		return msg.ZenaChainID
+	default:
+		return ""
+	}
+}
+
 // handleMsgCheckpointAdjust adjusts checkpoint
 func handleMsgCheckpointAdjust(ctx sdk.Context, msg types.MsgCheckpointAdjust, k Keeper, _ helper.IContractCaller) sdk.Result {
 	logger := k.Logger(ctx)
@@ -265,7 +291,7 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	}
 
 	//Hardfork to check the validity of the NoAckProposer
-	if ctx.BlockHeight() >= helper.GetAalborgHardForkHeight() {
+	if k.uk.IsUpgradeActive(ctx, helper.AalborgUpgrade) {
 		timeDiff := currentTime.Sub(lastCheckpointTime)
 
 		//count value is calculated based on the time passed since the last checkpoint
@@ -11,12 +11,13 @@ import (
 	"github.com/zenanetwork/iris/checkpoint/types"
 	"github.com/zenanetwork/iris/common"
 	"github.com/zenanetwork/iris/helper"
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
 )
 
 // handleMsgMilestone validates milestone transaction
 func handleMsgMilestone(ctx sdk.Context, msg types.MsgMilestone, k Keeper) sdk.Result {
 	logger := k.MilestoneLogger(ctx)
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := k.GetParams(ctx).MilestoneLength
 
 	//
 	//Get milestone validator set
@@ -120,7 +121,7 @@ func handleMsgMilestoneTimeout(ctx sdk.Context, _ types.MsgMilestoneTimeout, k K
 	currentTime := ctx.BlockTime()
 
 	// Get buffer time from params
-	bufferTime := helper.MilestoneBufferTime
+	bufferTime := k.GetParams(ctx).MilestoneBufferTime
 
 	// Fetch last checkpoint from store
 	// TODO figure out how to handle this error
@@ -165,6 +166,13 @@ func handleMsgMilestoneTimeout(ctx sdk.Context, _ types.MsgMilestoneTimeout, k K
 	k.SetLastMilestoneTimeout(ctx, newLastMilestoneTimeout)
 	logger.Debug("Last milestone-timeout set", "lastMilestoneTimeout", newLastMilestoneTimeout)
 
+	// the current proposer missed its turn
+	if k.uk.IsUpgradeActive(ctx, upgradeTypes.MilestoneParticipationUpgrade) {
+		if proposer := k.sk.GetMilestoneCurrentProposer(ctx); proposer != nil {
+			k.sk.RecordMilestoneParticipation(ctx, proposer.Signer, true)
+		}
+	}
+
 	//
 	// Update to new proposer
 	//
@@ -6,7 +6,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	cmTypes "github.com/zenanetwork/iris/chainmanager/types"
 	chSim "github.com/zenanetwork/iris/checkpoint/simulation"
 	"github.com/zenanetwork/iris/checkpoint/types"
 	errs "github.com/zenanetwork/iris/common"
@@ -28,9 +27,9 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestone() {
 	keeper := app.CheckpointKeeper
 	stakingKeeper := app.StakingKeeper
 	start := uint64(0)
-	zenaChainId := "1234"
+	zenaChainId := helper.DefaultZenaChainID
 	milestoneID := "0000"
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := types.DefaultMilestoneLength
 
 	// check valid milestone
 	// generate proposer for validator set
@@ -88,6 +87,35 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestone() {
 	// add current proposer to header
 	header.Proposer = stakingKeeper.GetMilestoneValidatorSet(ctx).Proposer.Signer
 
+	//Test- When milestone is shorter than the milestone length set by governance
+	suite.Run("Invalid msg based on milestone length param", func() {
+		params := keeper.GetParams(ctx)
+		params.MilestoneLength = milestoneLength + 1
+		keeper.SetParams(ctx, params)
+
+		defer func() {
+			params.MilestoneLength = milestoneLength
+			keeper.SetParams(ctx, params)
+		}()
+
+		msgMilestone := types.NewMsgMilestoneBlock(
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.Hash,
+			zenaChainId,
+			milestoneID,
+		)
+
+		// send milestone to handler
+		got := suite.handler(ctx, msgMilestone)
+		require.True(t, !got.IsOK(), errs.CodeToDefaultMsg(got.Code))
+		require.Equal(t, errs.CodeMilestoneInvalid, got.Code)
+	})
+
+	// add current proposer to header
+	header.Proposer = stakingKeeper.GetMilestoneValidatorSet(ctx).Proposer.Signer
+
 	//Test3- When the first milestone is composed of incorrect start number
 	suite.Run("Failure-Invalid Start Block Number", func() {
 		msgMilestone := types.NewMsgMilestoneBlock(
@@ -219,7 +247,7 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestoneExistInStore() {
 	keeper := app.CheckpointKeeper
 	stakingKeeper := app.StakingKeeper
 	start := uint64(0)
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := types.DefaultMilestoneLength
 
 	chSim.LoadValidatorSet(t, 2, stakingKeeper, ctx, false, 10, 0)
 	stakingKeeper.IncrementAccum(ctx, 1)
@@ -248,7 +276,7 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestoneExistInStore() {
 func (suite *HandlerTestSuite) SendMilestone(header hmTypes.Milestone) (res sdk.Result) {
 	_, ctx := suite.app, suite.ctx
 
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := types.DefaultMilestoneLength
 
 	// keeper := app.MilestoneKeeper
 
@@ -265,7 +293,7 @@ func (suite *HandlerTestSuite) SendMilestone(header hmTypes.Milestone) (res sdk.
 	)
 
 	suite.contractCaller.On("CheckIfBlocksExist", header.EndBlock).Return(true)
-	suite.contractCaller.On("CheckIfBlocksExist", header.EndBlock+cmTypes.DefaultMaticchainMilestoneTxConfirmations).Return(true)
+	suite.contractCaller.On("CheckIfBlocksExist", header.EndBlock+types.DefaultMilestoneTxConfirmations).Return(true)
 	suite.contractCaller.On("GetRootHash", header.StartBlock, header.EndBlock, milestoneLength).Return(header.Hash.Bytes(), nil)
 	suite.contractCaller.On("GetVoteOnHash", header.StartBlock, header.EndBlock, milestoneLength, header.Hash.String(), header.MilestoneID).Return(true, nil)
 
@@ -289,7 +317,7 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestoneTimeout() {
 	hash := hmTypes.HexToIrisHash("123")
 	proposerAddress := hmTypes.HexToIrisAddress("123")
 	timestamp := uint64(0)
-	zenaChainId := "1234"
+	zenaChainId := helper.DefaultZenaChainID
 	milestoneID := "0000"
 
 	proposer := hmTypes.IrisAddress{}
@@ -318,7 +346,7 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestoneTimeout() {
 	)
 	_ = keeper.AddMilestone(ctx, milestone)
 
-	newTime := milestone.TimeStamp + uint64(helper.MilestoneBufferTime) - 1
+	newTime := milestone.TimeStamp + uint64(types.DefaultMilestoneBufferTime) - 1
 	suite.ctx = ctx.WithBlockTime(time.Unix(0, int64(newTime)))
 
 	msgMilestoneTimeout := types.NewMsgMilestoneTimeout(
@@ -330,7 +358,7 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestoneTimeout() {
 	require.True(t, !got.IsOK(), errs.CodeToDefaultMsg(got.Code))
 	require.Equal(t, errs.CodeInvalidMilestoneTimeout, got.Code)
 
-	newTime = milestone.TimeStamp + 2*uint64(helper.MilestoneBufferTime) + 10000000
+	newTime = milestone.TimeStamp + 2*uint64(types.DefaultMilestoneBufferTime) + 10000000
 	suite.ctx = ctx.WithBlockTime(time.Unix(0, int64(newTime)))
 
 	msgMilestoneTimeout = types.NewMsgMilestoneTimeout(
@@ -16,6 +16,7 @@ import (
 	chSim "github.com/zenanetwork/iris/checkpoint/simulation"
 	"github.com/zenanetwork/iris/checkpoint/types"
 	errs "github.com/zenanetwork/iris/common"
+	"github.com/zenanetwork/iris/helper"
 	"github.com/zenanetwork/iris/helper/mocks"
 	hmTypes "github.com/zenanetwork/iris/types"
 )
@@ -62,7 +63,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpoint() {
 	topupKeeper := app.TopupKeeper
 	start := uint64(0)
 	maxSize := uint64(256)
-	zenaChainId := "1234"
+	zenaChainId := helper.DefaultZenaChainID
 	params := keeper.GetParams(ctx)
 	dividendAccount := hmTypes.DividendAccount{
 		User:      hmTypes.HexToIrisAddress("123"),
@@ -166,7 +167,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustCheckpointBuffer() {
 		StartBlock: 0,
 		EndBlock:   256,
 		RootHash:   hmTypes.HexToIrisHash("123"),
-		ZenaChainID: "testchainid",
+		ZenaChainID: helper.DefaultZenaChainID,
 		TimeStamp:  1,
 	}
 
@@ -194,7 +195,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustSameCheckpointAsMsg(
 		StartBlock: 0,
 		EndBlock:   256,
 		RootHash:   hmTypes.HexToIrisHash("123"),
-		ZenaChainID: "testchainid",
+		ZenaChainID: helper.DefaultZenaChainID,
 		TimeStamp:  1,
 	}
 
@@ -515,7 +516,7 @@ func (suite *HandlerTestSuite) SendCheckpoint(header hmTypes.Checkpoint) (res sd
 
 	accountRoot := hmTypes.BytesToIrisHash(accRootHash)
 
-	zenaChainId := "1234"
+	zenaChainId := helper.DefaultZenaChainID
 	// create checkpoint msg
 	msgCheckpoint := types.NewMsgCheckpointBlock(
 		header.Proposer,
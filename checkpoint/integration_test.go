@@ -27,7 +27,7 @@ func createTestApp(isCheckTx bool) (*app.IrisApp, sdk.Context, context.CLIContex
 
 	helper.SetTestConfig(helper.GetDefaultIrisConfig())
 
-	params := types.NewParams(5*time.Second, 256, 1024, 10000)
+	params := types.NewParams(5*time.Second, 256, 1024, 10000, 12, 60, 16, 256*time.Second, types.DefaultAdaptiveCheckpointParams())
 
 	Checkpoints := make([]hmTypes.Checkpoint, 0)
 
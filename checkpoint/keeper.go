@@ -2,6 +2,7 @@ package checkpoint
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -9,11 +10,15 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/zenanetwork/iris/chainmanager"
+	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
 	"github.com/zenanetwork/iris/checkpoint/types"
 	cmn "github.com/zenanetwork/iris/common"
+	"github.com/zenanetwork/iris/helper"
 	"github.com/zenanetwork/iris/params/subspace"
 	"github.com/zenanetwork/iris/staking"
 	hmTypes "github.com/zenanetwork/iris/types"
+	"github.com/zenanetwork/iris/upgrade"
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
 )
 
 const maxCheckpointListLimit = 10_000 // a checkpoint is ~100 bytes => can fit 10k in 1 MB response
@@ -25,6 +30,12 @@ var (
 	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
 	CheckpointKey       = []byte{0x13} // prefix key for when storing checkpoint after ACK
 	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+
+	CheckpointBlockIndexKey = []byte{0x15} // prefix key to index checkpoint numbers by end block
+
+	CheckpointAckHeightKey    = []byte{0x16} // prefix key to store the iris height a checkpoint is acked at
+	BufferCheckpointHeightKey = []byte{0x17} // key to store the iris height the checkpoint in buffer is approved at
+	LastAckBlockKey           = []byte{0x18} // key to store the L1 block of the last ack
 )
 
 // ModuleCommunicator manages different module interaction
@@ -38,6 +49,8 @@ type Keeper struct {
 	// staking keeper
 	sk staking.Keeper
 	ck chainmanager.Keeper
+	// upgrade keeper
+	uk upgrade.Keeper
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 	// codespace
@@ -47,6 +60,9 @@ type Keeper struct {
 
 	// module communicator
 	moduleCommunicator ModuleCommunicator
+
+	// zena child chain the keeper is scoped to, empty for the chain of the chain params
+	chainID string
 }
 
 // NewKeeper create new keeper
@@ -57,6 +73,7 @@ func NewKeeper(
 	codespace sdk.CodespaceType,
 	stakingKeeper staking.Keeper,
 	chainKeeper chainmanager.Keeper,
+	upgradeKeeper upgrade.Keeper,
 	moduleCommunicator ModuleCommunicator,
 ) Keeper {
 	keeper := Keeper{
@@ -66,6 +83,7 @@ func NewKeeper(
 		codespace:          codespace,
 		sk:                 stakingKeeper,
 		ck:                 chainKeeper,
+		uk:                 upgradeKeeper,
 		moduleCommunicator: moduleCommunicator,
 	}
 
@@ -82,6 +100,54 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
+// WithChainID returns the keeper of the checkpoints and milestones of a zena child chain, kept
+// apart from those of the chain of the chain params. The chain id of the chain params, or an empty
+// one for the acks and timeouts from before child chains were added, selects the chain of the chain
+// params, and any other chain id is rejected.
+func (k Keeper) WithChainID(ctx sdk.Context, chainID string) (Keeper, error) {
+	params := k.ck.GetParams(ctx)
+
+	switch {
+	case chainID == "" || chainID == params.ChainParams.ZenaChainID:
+		k.chainID = ""
+	case params.IsChildChain(chainID):
+		k.chainID = chainID
+	default:
+		return k, fmt.Errorf("unknown zena chain id %s", chainID)
+	}
+
+	return k, nil
+}
+
+// ChainID returns the zena child chain the keeper is scoped to, empty for the chain of the chain params
+func (k Keeper) ChainID() string {
+	return k.chainID
+}
+
+// ChainParams returns the chain params of the zena chain the keeper is scoped to
+func (k Keeper) ChainParams(ctx sdk.Context) chainmanagerTypes.ChainParams {
+	params := k.ck.GetParams(ctx)
+	if chainParams, ok := params.GetChainParams(k.chainID); ok {
+		return chainParams
+	}
+
+	return params.ChainParams
+}
+
+// ChainContractCaller returns the contract caller reading the zena chain the keeper is scoped to
+func (k Keeper) ChainContractCaller(contractCaller helper.IContractCaller) (helper.IContractCaller, error) {
+	if k.chainID == "" {
+		return contractCaller, nil
+	}
+
+	return contractCaller.ForChildChain(k.chainID)
+}
+
+// store returns the store of the zena chain the keeper is scoped to
+func (k Keeper) store(ctx sdk.Context) sdk.KVStore {
+	return hmTypes.ChainStore(ctx.KVStore(k.storeKey), k.chainID)
+}
+
 // AddCheckpoint adds checkpoint into final blocks
 func (k *Keeper) AddCheckpoint(ctx sdk.Context, checkpointNumber uint64, checkpoint hmTypes.Checkpoint) error {
 	key := GetCheckpointKey(checkpointNumber)
@@ -89,6 +155,17 @@ func (k *Keeper) AddCheckpoint(ctx sdk.Context, checkpointNumber uint64, checkpo
 		return err
 	}
 
+	// index checkpoint number by block range
+	store := k.store(ctx)
+	if k.uk.IsUpgradeActive(ctx, upgradeTypes.CheckpointBlockIndexUpgrade) {
+		store.Set(GetCheckpointBlockIndexKey(checkpoint.EndBlock), []byte(strconv.FormatUint(checkpointNumber, 10)))
+	}
+
+	// the checkpoints of the genesis are not acked in a block
+	if ctx.BlockHeight() > 0 && k.uk.IsUpgradeActive(ctx, upgradeTypes.FinalityHeightsUpgrade) {
+		store.Set(GetCheckpointAckHeightKey(checkpointNumber), []byte(strconv.FormatInt(ctx.BlockHeight(), 10)))
+	}
+
 	k.Logger(ctx).Info("Adding good checkpoint to state", "checkpoint", checkpoint, "checkpointNumber", checkpointNumber)
 
 	return nil
@@ -101,12 +178,16 @@ func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpo
 		return err
 	}
 
+	if ctx.BlockHeight() > 0 && k.uk.IsUpgradeActive(ctx, upgradeTypes.FinalityHeightsUpgrade) {
+		k.store(ctx).Set(BufferCheckpointHeightKey, []byte(strconv.FormatInt(ctx.BlockHeight(), 10)))
+	}
+
 	return nil
 }
 
 // addCheckpoint adds checkpoint to store
 func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, checkpoint hmTypes.Checkpoint) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// create Checkpoint block and marshall
 	out, err := k.cdc.MarshalBinaryBare(checkpoint)
@@ -123,7 +204,7 @@ func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, checkpoint hmTypes.C
 
 // GetCheckpointByNumber to get checkpoint by checkpoint number
 func (k *Keeper) GetCheckpointByNumber(ctx sdk.Context, number uint64) (hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	checkpointKey := GetCheckpointKey(number)
 
 	var _checkpoint hmTypes.Checkpoint
@@ -141,7 +222,7 @@ func (k *Keeper) GetCheckpointByNumber(ctx sdk.Context, number uint64) (hmTypes.
 
 // GetCheckpointList returns all checkpoints with params like page and limit
 func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) ([]hmTypes.CheckpointWithID, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// create headers
 	var checkpoints []hmTypes.CheckpointWithID
@@ -182,7 +263,7 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) (
 
 // GetLastCheckpoint gets last checkpoint, checkpoint number = TotalACKs
 func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	acksCount := k.GetACKCount(ctx)
 
 	lastCheckpointKey := acksCount
@@ -212,6 +293,101 @@ func GetCheckpointKey(checkpointNumber uint64) []byte {
 	return append(CheckpointKey, checkpointNumberBytes...)
 }
 
+// GetCheckpointBlockIndexKey appends prefix to the end block of a checkpoint
+func GetCheckpointBlockIndexKey(endBlock uint64) []byte {
+	return append(CheckpointBlockIndexKey, sdk.Uint64ToBigEndian(endBlock)...)
+}
+
+// GetCheckpointAckHeightKey appends prefix to checkpointNumber
+func GetCheckpointAckHeightKey(checkpointNumber uint64) []byte {
+	return append(CheckpointAckHeightKey, []byte(strconv.FormatUint(checkpointNumber, 10))...)
+}
+
+// GetCheckpointAckHeight returns the iris height a checkpoint is acked at, zero if not known
+func (k *Keeper) GetCheckpointAckHeight(ctx sdk.Context, number uint64) int64 {
+	return getHeight(k.store(ctx), GetCheckpointAckHeightKey(number))
+}
+
+// GetCheckpointBufferHeight returns the iris height the checkpoint in buffer is approved at, zero if not known
+func (k *Keeper) GetCheckpointBufferHeight(ctx sdk.Context) int64 {
+	return getHeight(k.store(ctx), BufferCheckpointHeightKey)
+}
+
+// getHeight returns the iris height stored at key, zero if none
+func getHeight(store sdk.KVStore, key []byte) int64 {
+	if !store.Has(key) {
+		return 0
+	}
+
+	height, err := strconv.ParseInt(string(store.Get(key)), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return height
+}
+
+// GetCheckpointNumberByBlock returns the number of the checkpoint whose block range covers blockNumber
+func (k *Keeper) GetCheckpointNumberByBlock(ctx sdk.Context, blockNumber uint64) (uint64, error) {
+	store := k.store(ctx)
+
+	// first checkpoint ending at or after blockNumber
+	iterator := store.Iterator(GetCheckpointBlockIndexKey(blockNumber), sdk.PrefixEndBytes(CheckpointBlockIndexKey))
+	defer iterator.Close()
+
+	if iterator.Valid() {
+		number, err := strconv.ParseUint(string(iterator.Value()), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+		if err == nil && checkpoint.StartBlock <= blockNumber {
+			return number, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no checkpoint found for block %v", blockNumber)
+}
+
+// IndexCheckpointBlocks indexes by block range the checkpoints of all zena chains acked before
+// the checkpoint block index upgrade
+func (k *Keeper) IndexCheckpointBlocks(ctx sdk.Context) {
+	keepers := []Keeper{*k}
+	for _, childChain := range k.ck.GetParams(ctx).ChildChains {
+		chainKeeper, err := k.WithChainID(ctx, childChain.ZenaChainID)
+		if err != nil {
+			k.Logger(ctx).Error("IndexCheckpointBlocks | WithChainID", "error", err)
+			continue
+		}
+
+		keepers = append(keepers, chainKeeper)
+	}
+
+	for _, chainKeeper := range keepers {
+		store := chainKeeper.store(ctx)
+
+		iterator := sdk.KVStorePrefixIterator(store, CheckpointKey)
+		for ; iterator.Valid(); iterator.Next() {
+			var checkpoint hmTypes.Checkpoint
+			if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &checkpoint); err != nil {
+				k.Logger(ctx).Error("IndexCheckpointBlocks | UnmarshalBinaryBare", "error", err)
+				continue
+			}
+
+			number, err := GetCheckpointIDFromKey(iterator.Key())
+			if err != nil {
+				k.Logger(ctx).Error("IndexCheckpointBlocks | GetCheckpointIDFromKey", "error", err)
+				continue
+			}
+
+			store.Set(GetCheckpointBlockIndexKey(checkpoint.EndBlock), []byte(strconv.FormatUint(number, 10)))
+		}
+
+		iterator.Close()
+	}
+}
+
 // GetCheckpointIDFromKey get the checkpoint ID from the DB key
 func GetCheckpointIDFromKey(key []byte) (uint64, error) {
 	return strconv.ParseUint(string(key[1:]), 10, 64)
@@ -219,19 +395,23 @@ func GetCheckpointIDFromKey(key []byte) (uint64, error) {
 
 // HasStoreValue check if value exists in store or not
 func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	return store.Has(key)
 }
 
 // FlushCheckpointBuffer flushes Checkpoint Buffer
 func (k *Keeper) FlushCheckpointBuffer(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Delete(BufferCheckpointKey)
+
+	if k.uk.IsUpgradeActive(ctx, upgradeTypes.FinalityHeightsUpgrade) {
+		store.Delete(BufferCheckpointHeightKey)
+	}
 }
 
 // GetCheckpointFromBuffer gets checkpoint in buffer
 func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context) (*hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// checkpoint block header
 	var checkpoint hmTypes.Checkpoint
@@ -247,7 +427,7 @@ func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context) (*hmTypes.Checkpoint,
 
 // SetLastNoAck set last no-ack object
 func (k *Keeper) SetLastNoAck(ctx sdk.Context, timestamp uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// convert timestamp to bytes
 	value := []byte(strconv.FormatUint(timestamp, 10))
 	// set no-ack
@@ -256,7 +436,7 @@ func (k *Keeper) SetLastNoAck(ctx sdk.Context, timestamp uint64) {
 
 // GetLastNoAck returns last no ack
 func (k *Keeper) GetLastNoAck(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// check if ack count is there
 	if store.Has(LastNoACKKey) {
 		// get current ACK count
@@ -271,7 +451,7 @@ func (k *Keeper) GetLastNoAck(ctx sdk.Context) uint64 {
 
 // GetCheckpoints get checkpoint all checkpoints
 func (k *Keeper) GetCheckpoints(ctx sdk.Context) []hmTypes.Checkpoint {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// get checkpoint header iterator
 	iterator := sdk.KVStorePrefixIterator(store, CheckpointKey)
 	defer iterator.Close()
@@ -296,7 +476,7 @@ func (k *Keeper) GetCheckpoints(ctx sdk.Context) []hmTypes.Checkpoint {
 
 // GetACKCount returns current ACK count
 func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// check if ack count is there
 	if store.Has(ACKCountKey) {
 		// get current ACK count
@@ -313,7 +493,7 @@ func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
 
 // UpdateACKCountWithValue updates ACK with value
 func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// convert
 	ackCount := []byte(strconv.FormatUint(value, 10))
@@ -324,7 +504,7 @@ func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64) {
 
 // UpdateACKCount updates ACK count by 1
 func (k Keeper) UpdateACKCount(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// get current ACK Count
 	ACKCount := k.GetACKCount(ctx)
@@ -336,6 +516,22 @@ func (k Keeper) UpdateACKCount(ctx sdk.Context) {
 	store.Set(ACKCountKey, ACKs)
 }
 
+// SetLastAckBlock sets the L1 block of the last ack
+func (k Keeper) SetLastAckBlock(ctx sdk.Context, blockNumber uint64) {
+	k.store(ctx).Set(LastAckBlockKey, []byte(strconv.FormatUint(blockNumber, 10)))
+}
+
+// GetLastAckBlock returns the L1 block of the last ack, zero if not known
+func (k Keeper) GetLastAckBlock(ctx sdk.Context) uint64 {
+	if bz := k.store(ctx).Get(LastAckBlockKey); bz != nil {
+		if blockNumber, err := strconv.ParseUint(string(bz), 10, 64); err == nil {
+			return blockNumber
+		}
+	}
+
+	return 0
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
@@ -346,6 +542,20 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // GetParams gets the auth module's parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
-	return
+	k.paramSpace.Get(ctx, types.KeyCheckpointBufferTime, &params.CheckpointBufferTime)
+	k.paramSpace.Get(ctx, types.KeyAvgCheckpointLength, &params.AvgCheckpointLength)
+	k.paramSpace.Get(ctx, types.KeyMaxCheckpointLength, &params.MaxCheckpointLength)
+	k.paramSpace.Get(ctx, types.KeyChildBlockInterval, &params.ChildBlockInterval)
+
+	// milestone params are not stored on chains started before they were added, until set by governance
+	k.paramSpace.GetIfExists(ctx, types.KeyMilestoneLength, &params.MilestoneLength)
+	k.paramSpace.GetIfExists(ctx, types.KeyMilestoneBufferLength, &params.MilestoneBufferLength)
+	k.paramSpace.GetIfExists(ctx, types.KeyMilestoneTxConfirmations, &params.MilestoneTxConfirmations)
+	k.paramSpace.GetIfExists(ctx, types.KeyMilestoneBufferTime, &params.MilestoneBufferTime)
+
+	// adaptive checkpoint sizing is disabled until set by governance
+	params.AdaptiveCheckpoint = types.DefaultAdaptiveCheckpointParams()
+	k.paramSpace.GetIfExists(ctx, types.KeyAdaptiveCheckpoint, &params.AdaptiveCheckpoint)
+
+	return params.WithMilestoneDefaults()
 }
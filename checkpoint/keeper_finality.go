@@ -0,0 +1,125 @@
+package checkpoint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zenanetwork/iris/checkpoint/types"
+	"github.com/zenanetwork/iris/helper"
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+// finalityView is the state the finality of zena blocks is resolved from, loaded once per query
+type finalityView struct {
+	k   *Keeper
+	ctx sdk.Context
+
+	// kept milestones, oldest first, with their numbers
+	milestones       []hmTypes.Milestone
+	milestoneNumbers []uint64
+
+	buffer       *hmTypes.Checkpoint
+	bufferNumber uint64
+
+	// last checkpoint resolved, reused for the next blocks it covers
+	checkpoint       hmTypes.Checkpoint
+	checkpointNumber uint64
+}
+
+func (k *Keeper) newFinalityView(ctx sdk.Context) *finalityView {
+	view := &finalityView{k: k, ctx: ctx}
+
+	count := k.GetMilestoneCount(ctx)
+	for number := count; number > 0 && count-number < helper.MilestonePruneNumber; number-- {
+		milestone, err := k.GetMilestoneByNumber(ctx, number)
+		if err != nil {
+			break
+		}
+
+		view.milestones = append([]hmTypes.Milestone{*milestone}, view.milestones...)
+		view.milestoneNumbers = append([]uint64{number}, view.milestoneNumbers...)
+	}
+
+	if buffer, err := k.GetCheckpointFromBuffer(ctx); err == nil {
+		view.buffer = buffer
+		view.bufferNumber = k.GetACKCount(ctx) + 1
+	}
+
+	return view
+}
+
+// blockFinality returns the finality of a zena block
+func (v *finalityView) blockFinality(blockNumber uint64) types.BlockFinality {
+	finality := types.BlockFinality{
+		BlockNumber: blockNumber,
+		Level:       types.FinalityNone,
+	}
+
+	// milestones finalize every block up to their end block
+	for i, milestone := range v.milestones {
+		if milestone.EndBlock < blockNumber {
+			continue
+		}
+
+		// the blocks before the oldest milestone kept are covered by a pruned milestone, if any
+		if i == 0 && blockNumber < milestone.StartBlock {
+			if v.milestoneNumbers[0] > 1 {
+				finality.Level = types.FinalityMilestone
+			}
+
+			break
+		}
+
+		finality.Level = types.FinalityMilestone
+		finality.MilestoneNumber = v.milestoneNumbers[i]
+		finality.MilestoneID = milestone.MilestoneID
+		finality.FinalizedAt = v.k.GetMilestoneHeight(v.ctx, v.milestoneNumbers[i])
+
+		break
+	}
+
+	if v.buffer != nil && v.buffer.StartBlock <= blockNumber && blockNumber <= v.buffer.EndBlock {
+		finality.Level = types.FinalityCheckpointed
+		finality.CheckpointNumber = v.bufferNumber
+		finality.FinalizedAt = v.k.GetCheckpointBufferHeight(v.ctx)
+
+		return finality
+	}
+
+	if v.checkpointNumber == 0 || blockNumber < v.checkpoint.StartBlock || v.checkpoint.EndBlock < blockNumber {
+		number, err := v.k.GetCheckpointNumberByBlock(v.ctx, blockNumber)
+		if err != nil {
+			return finality
+		}
+
+		checkpoint, err := v.k.GetCheckpointByNumber(v.ctx, number)
+		if err != nil {
+			return finality
+		}
+
+		v.checkpoint, v.checkpointNumber = checkpoint, number
+	}
+
+	finality.Level = types.FinalityL1Acked
+	finality.CheckpointNumber = v.checkpointNumber
+	finality.FinalizedAt = v.k.GetCheckpointAckHeight(v.ctx, v.checkpointNumber)
+
+	return finality
+}
+
+// GetBlockFinality returns the finality of a zena block: acked on L1 with a checkpoint, in the
+// checkpoint in buffer, covered by a milestone or none
+func (k *Keeper) GetBlockFinality(ctx sdk.Context, blockNumber uint64) types.BlockFinality {
+	return k.newFinalityView(ctx).blockFinality(blockNumber)
+}
+
+// GetFinalityRange returns the finality of the zena blocks from fromBlock to toBlock
+func (k *Keeper) GetFinalityRange(ctx sdk.Context, fromBlock uint64, toBlock uint64) []types.BlockFinality {
+	view := k.newFinalityView(ctx)
+
+	finalities := make([]types.BlockFinality, 0, toBlock-fromBlock+1)
+	for blockNumber := fromBlock; blockNumber <= toBlock; blockNumber++ {
+		finalities = append(finalities, view.blockFinality(blockNumber))
+	}
+
+	return finalities
+}
@@ -9,6 +9,7 @@ import (
 	cmn "github.com/zenanetwork/iris/common"
 	"github.com/zenanetwork/iris/helper"
 	hmTypes "github.com/zenanetwork/iris/types"
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
 )
 
 var (
@@ -18,6 +19,7 @@ var (
 	MilestoneLastNoAckKey = []byte{0x50} //Key to store the Latest NoAckMilestone
 	LastMilestoneTimeout  = []byte{0x60} //Key to store the Last Milestone Timeout
 	BlockNumberKey        = []byte{0x70} //Key to store the count
+	MilestoneHeightKey    = []byte{0x21} //Key to store the iris height a milestone is added at
 )
 
 // Logger returns a module-specific logger
@@ -34,6 +36,10 @@ func (k *Keeper) AddMilestone(ctx sdk.Context, milestone hmTypes.Milestone) erro
 		return err
 	}
 
+	if k.uk.IsUpgradeActive(ctx, upgradeTypes.FinalityHeightsUpgrade) {
+		k.store(ctx).Set(GetMilestoneHeightKey(milestoneNumber), []byte(strconv.FormatInt(ctx.BlockHeight(), 10)))
+	}
+
 	pruningNumber := milestoneNumber - helper.MilestonePruneNumber
 
 	k.PruneMilestone(ctx, pruningNumber) //Prune the old milestone to reduce the memory consumption
@@ -45,7 +51,7 @@ func (k *Keeper) AddMilestone(ctx sdk.Context, milestone hmTypes.Milestone) erro
 
 // addMilestone adds milestone to store
 func (k *Keeper) addMilestone(ctx sdk.Context, key []byte, milestone hmTypes.Milestone) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// create Checkpoint block and marshall
 	out, err := k.cdc.MarshalBinaryBare(milestone)
@@ -66,9 +72,19 @@ func GetMilestoneKey(milestoneNumber uint64) []byte {
 	return append(MilestoneKey, milestoneNumberBytes...)
 }
 
+// GetMilestoneHeightKey appends prefix to milestoneNumber
+func GetMilestoneHeightKey(milestoneNumber uint64) []byte {
+	return append(MilestoneHeightKey, []byte(strconv.FormatUint(milestoneNumber, 10))...)
+}
+
+// GetMilestoneHeight returns the iris height a milestone is added at, zero if not known
+func (k *Keeper) GetMilestoneHeight(ctx sdk.Context, number uint64) int64 {
+	return getHeight(k.store(ctx), GetMilestoneHeightKey(number))
+}
+
 // GetMilestoneByNumber to get milestone by milestone number
 func (k *Keeper) GetMilestoneByNumber(ctx sdk.Context, number uint64) (*hmTypes.Milestone, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	milestoneKey := GetMilestoneKey(number)
 
 	var milestone hmTypes.Milestone
@@ -86,7 +102,7 @@ func (k *Keeper) GetMilestoneByNumber(ctx sdk.Context, number uint64) (*hmTypes.
 
 // GetLastMilestone gets last milestone, milestone number = GetCount()
 func (k *Keeper) GetLastMilestone(ctx sdk.Context) (*hmTypes.Milestone, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	Count := k.GetMilestoneCount(ctx)
 
 	lastMilestoneKey := GetMilestoneKey(Count)
@@ -109,7 +125,7 @@ func (k *Keeper) GetLastMilestone(ctx sdk.Context) (*hmTypes.Milestone, error) {
 
 // SetCount set the count number
 func (k *Keeper) SetMilestoneCount(ctx sdk.Context, number uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// convert timestamp to bytes
 	value := []byte(strconv.FormatUint(number, 10))
 	// set no-ack
@@ -118,7 +134,7 @@ func (k *Keeper) SetMilestoneCount(ctx sdk.Context, number uint64) {
 
 // GetMilestoneCount returns milestone count
 func (k *Keeper) GetMilestoneCount(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// check if count is there
 	if store.Has(CountKey) {
 		// get current count
@@ -133,7 +149,7 @@ func (k *Keeper) GetMilestoneCount(ctx sdk.Context) uint64 {
 
 // SetMilestoneBlockNumber set the block number when the latest milestone enter the handler
 func (k *Keeper) SetMilestoneBlockNumber(ctx sdk.Context, number int64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// convert block number to bytes
 	value := []byte(strconv.FormatInt(number, 10))
 	// set
@@ -142,7 +158,7 @@ func (k *Keeper) SetMilestoneBlockNumber(ctx sdk.Context, number int64) {
 
 // GetMilestoneBlockNumber returns the block number when the latest milestone enter the handler
 func (k *Keeper) GetMilestoneBlockNumber(ctx sdk.Context) int64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// check if block number is there
 	if store.Has(BlockNumberKey) {
 		// get the block number
@@ -157,7 +173,7 @@ func (k *Keeper) GetMilestoneBlockNumber(ctx sdk.Context) int64 {
 
 // PruneMilestone remove the milestone from the db based on number
 func (k *Keeper) PruneMilestone(ctx sdk.Context, number uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	if number <= 0 {
 		return
@@ -170,11 +186,15 @@ func (k *Keeper) PruneMilestone(ctx sdk.Context, number uint64) {
 	}
 
 	store.Delete(milestoneKey)
+
+	if k.uk.IsUpgradeActive(ctx, upgradeTypes.FinalityHeightsUpgrade) {
+		store.Delete(GetMilestoneHeightKey(number))
+	}
 }
 
 // SetLastNoAck set last no-ack object
 func (k *Keeper) SetNoAckMilestone(ctx sdk.Context, milestoneId string) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	milestoneNoAckKey := GetMilestoneNoAckKey(milestoneId)
 	value := []byte(milestoneId)
@@ -186,7 +206,7 @@ func (k *Keeper) SetNoAckMilestone(ctx sdk.Context, milestoneId string) {
 
 // GetLastNoAckMilestone returns last no ack milestone
 func (k *Keeper) GetLastNoAckMilestone(ctx sdk.Context) string {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// check if MilestoneLastNoAckKey key exists
 	if store.Has(MilestoneLastNoAckKey) {
 		// get current ACK count
@@ -199,14 +219,14 @@ func (k *Keeper) GetLastNoAckMilestone(ctx sdk.Context) string {
 
 // GetLastNoAckMilestone returns last no ack milestone
 func (k *Keeper) GetNoAckMilestone(ctx sdk.Context, milestoneId string) bool {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// check if No Ack Milestone is there
 	return store.Has(GetMilestoneNoAckKey(milestoneId))
 }
 
 // SetLastMilestoneTimeout set lastMilestone timeout time
 func (k *Keeper) SetLastMilestoneTimeout(ctx sdk.Context, timestamp uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// convert timestamp to bytes
 	value := []byte(strconv.FormatUint(timestamp, 10))
 	// set no-ack
@@ -215,7 +235,7 @@ func (k *Keeper) SetLastMilestoneTimeout(ctx sdk.Context, timestamp uint64) {
 
 // GetLastMilestoneTimeout returns lastMilestone timeout time
 func (k *Keeper) GetLastMilestoneTimeout(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	//check if lastMilestoneTimeout key exists
 	if store.Has(LastMilestoneTimeout) {
 		// get last milestone timeout
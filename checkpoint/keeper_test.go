@@ -1,14 +1,23 @@
 package checkpoint_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/zenanetwork/iris/app"
+	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
 	"github.com/zenanetwork/iris/checkpoint"
+	"github.com/zenanetwork/iris/checkpoint/types"
+	"github.com/zenanetwork/iris/helper"
+	"github.com/zenanetwork/iris/params"
+	paramsTypes "github.com/zenanetwork/iris/params/types"
 	hmTypes "github.com/zenanetwork/iris/types"
+	"github.com/zenanetwork/iris/upgrade"
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -125,3 +134,264 @@ func (suite *KeeperTestSuite) TestFlushCheckpointBuffer() {
 	result := keeper.HasStoreValue(ctx, key)
 	require.False(t, result)
 }
+
+func (suite *KeeperTestSuite) TestGetCheckpointNumberByBlock() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	for i := uint64(1); i <= 3; i++ {
+		checkpoint := hmTypes.CreateBlock(
+			(i-1)*256,
+			i*256-1,
+			hmTypes.HexToIrisHash("123"),
+			hmTypes.HexToIrisAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+		require.NoError(t, keeper.AddCheckpoint(ctx, i, checkpoint))
+		keeper.UpdateACKCount(ctx)
+	}
+
+	for block, expected := range map[uint64]uint64{0: 1, 255: 1, 256: 2, 300: 2, 511: 2, 512: 3, 767: 3} {
+		number, err := keeper.GetCheckpointNumberByBlock(ctx, block)
+		require.NoError(t, err)
+		require.Equal(t, expected, number, "block %v", block)
+	}
+
+	_, err := keeper.GetCheckpointNumberByBlock(ctx, 768)
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestIndexCheckpointBlocks() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx.WithBlockHeight(5)
+	keeper := app.CheckpointKeeper
+
+	upgrade.InitGenesis(ctx, app.UpgradeKeeper, upgradeTypes.NewGenesisState([]upgradeTypes.Plan{
+		upgradeTypes.NewPlan(upgradeTypes.CheckpointBlockIndexUpgrade, 10, ""),
+	}))
+
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToIrisHash("123"), hmTypes.HexToIrisAddress("123"), "1234", uint64(time.Now().Unix()))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpoint))
+
+	// not indexed before the upgrade
+	_, err := keeper.GetCheckpointNumberByBlock(ctx, 100)
+	require.Error(t, err)
+
+	// indexed by the upgrade handler
+	ctx = ctx.WithBlockHeight(10)
+	upgrade.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.UpgradeKeeper)
+
+	number, err := keeper.GetCheckpointNumberByBlock(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), number)
+}
+
+func (suite *KeeperTestSuite) TestChildChainCheckpoints() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []chainmanagerTypes.ChildChainParams{{ZenaChainID: "15002"}}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	// unknown chains are rejected
+	_, err := keeper.WithChainID(ctx, "15003")
+	require.Error(t, err)
+
+	// the chain of the chain params, or no chain id, selects the chain of the chain params
+	for _, chainID := range []string{"", chainParams.ChainParams.ZenaChainID} {
+		primaryKeeper, err := keeper.WithChainID(ctx, chainID)
+		require.NoError(t, err)
+		require.Empty(t, primaryKeeper.ChainID())
+	}
+
+	childKeeper, err := keeper.WithChainID(ctx, "15002")
+	require.NoError(t, err)
+	require.Equal(t, "15002", childKeeper.ChainID())
+
+	checkpoint := hmTypes.CreateBlock(
+		0,
+		256,
+		hmTypes.HexToIrisHash("123"),
+		hmTypes.HexToIrisAddress("123"),
+		"15002",
+		uint64(time.Now().Unix()),
+	)
+	require.NoError(t, childKeeper.SetCheckpointBuffer(ctx, checkpoint))
+	require.NoError(t, childKeeper.AddCheckpoint(ctx, 1, checkpoint))
+	childKeeper.UpdateACKCount(ctx)
+
+	// the buffer and ack count of the child chain are kept apart
+	require.Equal(t, uint64(1), childKeeper.GetACKCount(ctx))
+	require.Equal(t, uint64(0), keeper.GetACKCount(ctx))
+
+	_, err = keeper.GetCheckpointFromBuffer(ctx)
+	require.Error(t, err)
+
+	buffered, err := childKeeper.GetCheckpointFromBuffer(ctx)
+	require.NoError(t, err)
+	require.Equal(t, checkpoint.EndBlock, buffered.EndBlock)
+
+	_, err = keeper.GetCheckpointByNumber(ctx, 1)
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestBlockFinality() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	// blocks 0-255 acked at height 10
+	acked := hmTypes.CreateBlock(0, 255, hmTypes.HexToIrisHash("123"), hmTypes.HexToIrisAddress("123"), "1234", uint64(time.Now().Unix()))
+	require.NoError(t, keeper.AddCheckpoint(ctx.WithBlockHeight(10), 1, acked))
+	keeper.UpdateACKCount(ctx)
+
+	// blocks 256-511 in buffer since height 20
+	buffered := hmTypes.CreateBlock(256, 511, hmTypes.HexToIrisHash("456"), hmTypes.HexToIrisAddress("123"), "1234", uint64(time.Now().Unix()))
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx.WithBlockHeight(20), buffered))
+
+	// blocks up to 600 covered by a milestone at height 30
+	milestone := hmTypes.CreateMilestone(0, 600, hmTypes.HexToIrisHash("789"), hmTypes.HexToIrisAddress("123"), "1234", "milestoneID", uint64(time.Now().Unix()))
+	require.NoError(t, keeper.AddMilestone(ctx.WithBlockHeight(30), milestone))
+
+	finality := keeper.GetBlockFinality(ctx, 100)
+	require.Equal(t, types.FinalityL1Acked, finality.Level)
+	require.Equal(t, uint64(1), finality.CheckpointNumber)
+	require.Equal(t, uint64(1), finality.MilestoneNumber)
+	require.Equal(t, int64(10), finality.FinalizedAt)
+
+	finality = keeper.GetBlockFinality(ctx, 300)
+	require.Equal(t, types.FinalityCheckpointed, finality.Level)
+	require.Equal(t, uint64(2), finality.CheckpointNumber)
+	require.Equal(t, int64(20), finality.FinalizedAt)
+
+	finality = keeper.GetBlockFinality(ctx, 550)
+	require.Equal(t, types.FinalityMilestone, finality.Level)
+	require.Equal(t, "milestoneID", finality.MilestoneID)
+	require.Equal(t, int64(30), finality.FinalizedAt)
+
+	finality = keeper.GetBlockFinality(ctx, 601)
+	require.Equal(t, types.FinalityNone, finality.Level)
+	require.Zero(t, finality.FinalizedAt)
+
+	finalities := keeper.GetFinalityRange(ctx, 255, 257)
+	require.Len(t, finalities, 3)
+	require.Equal(t, types.FinalityL1Acked, finalities[0].Level)
+	require.Equal(t, types.FinalityCheckpointed, finalities[1].Level)
+	require.Equal(t, uint64(257), finalities[2].BlockNumber)
+}
+
+func (suite *KeeperTestSuite) TestBlockFinalityPrunedMilestone() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	// blocks 100-199 are covered by the first milestone, pruned by the last one
+	for i := uint64(0); i <= helper.MilestonePruneNumber; i++ {
+		milestone := hmTypes.CreateMilestone(100*(i+1), 100*(i+2)-1, hmTypes.HexToIrisHash("789"), hmTypes.HexToIrisAddress("123"), "1234", fmt.Sprintf("milestoneID%d", i), uint64(time.Now().Unix()))
+		require.NoError(t, keeper.AddMilestone(ctx.WithBlockHeight(int64(i+1)), milestone))
+	}
+
+	_, err := keeper.GetMilestoneByNumber(ctx, 1)
+	require.Error(t, err)
+
+	finality := keeper.GetBlockFinality(ctx, 150)
+	require.Equal(t, types.FinalityMilestone, finality.Level)
+	require.Zero(t, finality.MilestoneNumber)
+	require.Empty(t, finality.MilestoneID)
+	require.Zero(t, finality.FinalizedAt)
+
+	finality = keeper.GetBlockFinality(ctx, 250)
+	require.Equal(t, types.FinalityMilestone, finality.Level)
+	require.Equal(t, uint64(2), finality.MilestoneNumber)
+	require.Equal(t, "milestoneID1", finality.MilestoneID)
+	require.Equal(t, int64(2), finality.FinalizedAt)
+}
+
+func (suite *KeeperTestSuite) TestFinalityHeightsUpgrade() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx.WithBlockHeight(5)
+	keeper := app.CheckpointKeeper
+
+	upgrade.InitGenesis(ctx, app.UpgradeKeeper, upgradeTypes.NewGenesisState([]upgradeTypes.Plan{
+		upgradeTypes.NewPlan(upgradeTypes.FinalityHeightsUpgrade, 10, ""),
+	}))
+
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToIrisHash("123"), hmTypes.HexToIrisAddress("123"), "1234", uint64(time.Now().Unix()))
+	milestone := hmTypes.CreateMilestone(0, 255, hmTypes.HexToIrisHash("789"), hmTypes.HexToIrisAddress("123"), "1234", "milestoneID", uint64(time.Now().Unix()))
+
+	// not recorded before the upgrade
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpoint))
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, checkpoint))
+	require.NoError(t, keeper.AddMilestone(ctx, milestone))
+
+	require.Zero(t, keeper.GetCheckpointAckHeight(ctx, 1))
+	require.Zero(t, keeper.GetCheckpointBufferHeight(ctx))
+	require.Zero(t, keeper.GetMilestoneHeight(ctx, 1))
+
+	// recorded from the upgrade height
+	ctx = ctx.WithBlockHeight(10)
+
+	require.NoError(t, keeper.AddCheckpoint(ctx, 2, checkpoint))
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, checkpoint))
+	require.NoError(t, keeper.AddMilestone(ctx, milestone))
+
+	require.Equal(t, int64(10), keeper.GetCheckpointAckHeight(ctx, 2))
+	require.Equal(t, int64(10), keeper.GetCheckpointBufferHeight(ctx))
+	require.Equal(t, int64(10), keeper.GetMilestoneHeight(ctx, 2))
+}
+
+func (suite *KeeperTestSuite) TestMilestoneParamChange() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	handler := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+
+	change := func(changes ...paramsTypes.ParamChange) error {
+		cacheCtx, _ := ctx.CacheContext()
+		return handler(cacheCtx, paramsTypes.NewParameterChangeProposal("Milestone", "description", changes))
+	}
+
+	milestoneLength := func(v string) paramsTypes.ParamChange {
+		return paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyMilestoneLength), v)
+	}
+
+	milestoneBufferLength := func(v string) paramsTypes.ParamChange {
+		return paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyMilestoneBufferLength), v)
+	}
+
+	// zero values are rejected by the validators of the keys
+	require.Error(t, change(milestoneLength(`"0"`)))
+	require.Error(t, change(milestoneBufferLength(`"0"`)))
+	require.Error(t, change(paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyMilestoneTxConfirmations), `"0"`)))
+	require.Error(t, change(paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyMilestoneBufferTime), `"0"`)))
+
+	// a buffer length shorter than the milestone length is rejected across the keys
+	require.Error(t, change(milestoneLength(`"100"`)))
+	require.Error(t, change(milestoneBufferLength(`"100"`), milestoneLength(`"120"`)))
+
+	// both updated together are validated once set
+	require.NoError(t, change(milestoneLength(`"100"`), milestoneBufferLength(`"500"`)))
+	require.NoError(t, handler(ctx, paramsTypes.NewParameterChangeProposal("Milestone", "description", []paramsTypes.ParamChange{
+		milestoneLength(`"100"`), milestoneBufferLength(`"500"`),
+	})))
+
+	checkpointParams := app.CheckpointKeeper.GetParams(ctx)
+	require.Equal(t, uint64(100), checkpointParams.MilestoneLength)
+	require.Equal(t, uint64(500), checkpointParams.MilestoneBufferLength)
+}
+
+func (suite *KeeperTestSuite) TestAdaptiveCheckpointParamChange() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	handler := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+
+	change := func(v string) error {
+		cacheCtx, _ := ctx.CacheContext()
+		return handler(cacheCtx, paramsTypes.NewParameterChangeProposal("Adaptive", "description", []paramsTypes.ParamChange{
+			paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyAdaptiveCheckpoint), v),
+		}))
+	}
+
+	require.Error(t, change(`{"enabled": true, "target_interval": "0", "min_checkpoint_length": "64"}`))
+	require.Error(t, change(`{"enabled": true, "target_interval": "600000000000", "min_checkpoint_length": "0"}`))
+	require.Error(t, change(`{"enabled": true, "target_interval": "600000000000", "min_checkpoint_length": "2048"}`))
+
+	// disabled, the values are not checked
+	require.NoError(t, change(`{"enabled": false, "target_interval": "0", "min_checkpoint_length": "0"}`))
+	require.NoError(t, change(`{"enabled": true, "target_interval": "600000000000", "min_checkpoint_length": "64"}`))
+}
@@ -0,0 +1,22 @@
+package checkpoint
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	checkpointAckCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "iris",
+		Subsystem: "checkpoint",
+		Name:      "ack_count",
+		Help:      "The number of checkpoints acknowledged on the root chain",
+	}, []string{"chain"})
+
+	milestoneCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "iris",
+		Subsystem: "checkpoint",
+		Name:      "milestone_count",
+		Help:      "The number of milestones added",
+	})
+)
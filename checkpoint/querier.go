@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	jsoniter "github.com/json-iterator/go"
 	abci "github.com/tendermint/tendermint/abci/types"
+	ethCommon "github.com/zenanetwork/go-zenanet/common"
+	"github.com/zenanetwork/go-zenanet/common/hexutil"
 
 	"github.com/zenanetwork/iris/checkpoint/types"
 	"github.com/zenanetwork/iris/common"
@@ -18,6 +20,26 @@ import (
 // NewQuerier creates a querier for auth REST endpoints
 func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.Keeper, contractCaller helper.IContractCaller) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		// the keeper and the contract caller of the query, scoped to its chain
+		keeper, contractCaller := keeper, contractCaller
+
+		// queries of a zena child chain end with its chain id
+		if len(path) > 1 {
+			chainKeeper, err := keeper.WithChainID(ctx, path[1])
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(err.Error())
+			}
+
+			keeper = chainKeeper
+
+			chainContractCaller, err := keeper.ChainContractCaller(contractCaller)
+			if err != nil {
+				return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get the contract caller of the zena chain", err.Error()))
+			}
+
+			contractCaller = chainContractCaller
+		}
+
 		switch path[0] {
 		case types.QueryParams:
 			return handleQueryParams(ctx, req, keeper)
@@ -33,6 +55,14 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryCheckpointList(ctx, req, keeper)
 		case types.QueryNextCheckpoint:
 			return handleQueryNextCheckpoint(ctx, req, keeper, stakingKeeper, topupKeeper, contractCaller)
+		case types.QueryCheckpointBlock:
+			return handleQueryCheckpointBlock(ctx, req, keeper)
+		case types.QueryFinality:
+			return handleQueryFinality(ctx, req, keeper, contractCaller)
+		case types.QueryFinalityRange:
+			return handleQueryFinalityRange(ctx, req, keeper)
+		case types.QueryAdaptiveCheckpoint:
+			return handleQueryAdaptiveCheckpoint(ctx, keeper, contractCaller)
 
 		case types.QueryCount:
 			return handleQueryCount(ctx, keeper)
@@ -138,6 +168,89 @@ func handleQueryCheckpointList(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+func handleQueryCheckpointBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	number, err := keeper.GetCheckpointNumberByBlock(ctx, params.BlockNumber)
+	if err != nil {
+		return nil, common.ErrNoCheckpointFound(keeper.Codespace())
+	}
+
+	checkpoint, err := keeper.GetCheckpointByNumber(ctx, number)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint by index %v", number), err.Error()))
+	}
+
+	// the block proof is built by the clients from the zena headers
+	res := types.CheckpointBlock{
+		BlockNumber: params.BlockNumber,
+		Number:      number,
+		Checkpoint:  checkpoint,
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func handleQueryFinality(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+	var params types.QueryFinalityParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	blockNumber := params.BlockNumber
+
+	// a block hash is resolved to its number on the zena chain
+	if params.BlockHash != "" {
+		blockHash, err := hexutil.Decode(params.BlockHash)
+		if err != nil || len(blockHash) != ethCommon.HashLength {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid zena block hash %s", params.BlockHash))
+		}
+
+		header, err := contractCaller.GetMaticChainBlockByHash(ethCommon.BytesToHash(blockHash))
+		if err != nil || header == nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("could not fetch zena block header %s", params.BlockHash))
+		}
+
+		blockNumber = header.Number.Uint64()
+	}
+
+	res := keeper.GetBlockFinality(ctx, blockNumber)
+	res.BlockHash = params.BlockHash
+
+	bz, err := jsoniter.ConfigFastest.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func handleQueryFinalityRange(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryFinalityRangeParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.ToBlock < params.FromBlock || params.ToBlock-params.FromBlock >= types.MaxFinalityRange {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid block range %v-%v, at most %v blocks", params.FromBlock, params.ToBlock, types.MaxFinalityRange))
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(keeper.GetFinalityRange(ctx, params.FromBlock, params.ToBlock))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, sk staking.Keeper, tk topup.Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
 	var queryParams types.QueryZenaChainID
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams); err != nil {
@@ -193,3 +306,39 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 
 	return bz, nil
 }
+
+func handleQueryAdaptiveCheckpoint(ctx sdk.Context, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+	params := keeper.GetParams(ctx)
+
+	// next checkpoint starts after the checkpoint in buffer or the last acked one
+	var start uint64
+
+	if buffer, err := keeper.GetCheckpointFromBuffer(ctx); err == nil {
+		start = buffer.EndBlock + 1
+	} else if lastCheckpoint, err := keeper.GetLastCheckpoint(ctx); err == nil {
+		start = lastCheckpoint.EndBlock + 1
+	}
+
+	header, err := contractCaller.GetMaticChainBlock(nil)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch the latest zena block", err.Error()))
+	}
+
+	// latest block confirmed enough for the side handler
+	confirmations := keeper.ck.GetParams(ctx).MaticchainTxConfirmations
+	if header.Number.Uint64() < confirmations {
+		return nil, sdk.ErrInternal(fmt.Sprintf("less zena blocks than the %v confirmations", confirmations))
+	}
+
+	next, err := types.NextAdaptiveCheckpoint(params, start, header.Number.Uint64()-confirmations, contractCaller)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not choose the next checkpoint range", err.Error()))
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(next)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -13,8 +13,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
+	ethcmn "github.com/zenanetwork/go-zenanet/common"
+	ethTypes "github.com/zenanetwork/go-zenanet/core/types"
+	"github.com/zenanetwork/go-zenanet/crypto"
 
 	"github.com/zenanetwork/iris/app"
+	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
 	"github.com/zenanetwork/iris/checkpoint"
 	chSim "github.com/zenanetwork/iris/checkpoint/simulation"
 	"github.com/zenanetwork/iris/checkpoint/types"
@@ -110,6 +114,37 @@ func (suite *QuerierTestSuite) TestQueryAckCount() {
 	require.Equal(t, actualAckcount, ackCount)
 }
 
+func (suite *QuerierTestSuite) TestQueryAckCountChildChain() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []chainmanagerTypes.ChildChainParams{{ZenaChainID: "15002"}}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	childKeeper, err := app.CheckpointKeeper.WithChainID(ctx, "15002")
+	require.NoError(t, err)
+
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
+	childKeeper.UpdateACKCountWithValue(ctx, 5)
+
+	childContractCaller := mocks.IContractCaller{}
+	suite.contractCaller.On("ForChildChain", "15002").Return(&childContractCaller, nil)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount),
+		Data: []byte{},
+	}
+
+	res, sdkErr := querier(ctx, []string{types.QueryAckCount, "15002"}, req)
+	require.NoError(t, sdkErr)
+	require.Equal(t, "5", string(res))
+
+	// the child chain query leaves the later queries on the primary chain
+	res, sdkErr = querier(ctx, []string{types.QueryAckCount}, req)
+	require.NoError(t, sdkErr)
+	require.Equal(t, "1", string(res))
+}
+
 func (suite *QuerierTestSuite) TestQueryCheckpoint() {
 	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
 
@@ -317,3 +352,120 @@ func (suite *QuerierTestSuite) TestQueryNextCheckpoint() {
 	require.Equal(t, checkpointBlock.RootHash, actualRes.RootHash)
 	require.Equal(t, checkpointBlock.ZenaChainID, actualRes.ZenaChainID)
 }
+
+func (suite *QuerierTestSuite) TestQueryCheckpointBlock() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	// 5 zena headers, padded to 8 leaves in the root hash tree
+	startBlock := uint64(100)
+	endBlock := uint64(104)
+	headers := make([]*ethTypes.Header, 0, endBlock-startBlock+1)
+	leaves := make([][]byte, 8)
+
+	for i := startBlock; i <= endBlock; i++ {
+		header := &ethTypes.Header{
+			Number:      new(big.Int).SetUint64(i),
+			Time:        1700000000 + i,
+			TxHash:      ethcmn.BigToHash(new(big.Int).SetUint64(i * 7)),
+			ReceiptHash: ethcmn.BigToHash(new(big.Int).SetUint64(i * 13)),
+		}
+		headers = append(headers, header)
+		leaves[i-startBlock] = types.GetHeaderLeaf(header)
+
+		suite.contractCaller.On("GetMaticChainBlock", new(big.Int).SetUint64(i)).Return(header, nil)
+	}
+
+	for i := len(headers); i < len(leaves); i++ {
+		leaves[i] = make([]byte, 32)
+	}
+
+	for len(leaves) > 1 {
+		next := make([][]byte, 0, len(leaves)/2)
+		for i := 0; i < len(leaves); i += 2 {
+			next = append(next, crypto.Keccak256(leaves[i], leaves[i+1]))
+		}
+
+		leaves = next
+	}
+
+	checkpointBlock := hmTypes.CreateBlock(
+		startBlock,
+		endBlock,
+		hmTypes.BytesToIrisHash(leaves[0]),
+		hmTypes.HexToIrisAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	require.NoError(t, app.CheckpointKeeper.AddCheckpoint(ctx, 3, checkpointBlock))
+
+	path := []string{types.QueryCheckpointBlock}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBlock)
+
+	for blockNumber := startBlock; blockNumber <= endBlock; blockNumber++ {
+		req := abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointBlockParams(blockNumber)),
+		}
+		res, sdkErr := querier(ctx, path, req)
+		require.NoError(t, sdkErr)
+
+		var result types.CheckpointBlock
+		require.NoError(t, jsoniter.ConfigFastest.Unmarshal(res, &result))
+		require.Equal(t, checkpointBlock, result.Checkpoint)
+
+		// the proof is built by the clients
+		blockProof, err := types.GetBlockProof(result, &suite.contractCaller)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), blockProof.Number)
+		require.Equal(t, checkpointBlock, blockProof.Checkpoint)
+		require.Equal(t, blockNumber-startBlock, blockProof.Index)
+		require.Len(t, blockProof.Proof, 3*32)
+		require.NoError(t, blockProof.Verify())
+
+		blockProof.Index ^= 1
+		blockProof.BlockNumber ^= 1
+		require.Error(t, blockProof.Verify())
+	}
+
+	// block not checkpointed yet
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointBlockParams(endBlock + 1)),
+	}
+	_, sdkErr := querier(ctx, path, req)
+	require.Error(t, sdkErr)
+}
+
+func (suite *QuerierTestSuite) TestQueryAdaptiveCheckpoint() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.AdaptiveCheckpoint.Enabled = true
+	keeper.SetParams(ctx, params)
+
+	confirmations := app.ChainKeeper.GetParams(ctx).MaticchainTxConfirmations
+
+	// a zena block every 2 seconds, L1 base fee twice the threshold
+	suite.contractCaller.On("GetMaticChainBlock", (*big.Int)(nil)).Return(&ethTypes.Header{Number: new(big.Int).SetUint64(1000 + confirmations)}, nil)
+	suite.contractCaller.On("GetMaticChainBlock", new(big.Int).SetUint64(1000)).Return(&ethTypes.Header{Number: big.NewInt(1000), Time: 2000}, nil)
+	suite.contractCaller.On("GetMaticChainBlock", new(big.Int).SetUint64(1000-params.AvgCheckpointLength)).Return(&ethTypes.Header{Time: 2000 - 2*params.AvgCheckpointLength}, nil)
+	suite.contractCaller.On("GetMainChainBlock", (*big.Int)(nil)).Return(&ethTypes.Header{BaseFee: new(big.Int).SetUint64(2 * params.AdaptiveCheckpoint.BaseFeeThreshold)}, nil)
+
+	path := []string{types.QueryAdaptiveCheckpoint}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAdaptiveCheckpoint)
+
+	res, err := querier(ctx, path, abci.RequestQuery{Path: route})
+	require.NoError(t, err)
+
+	var next types.AdaptiveCheckpoint
+	require.NoError(t, jsoniter.ConfigFastest.Unmarshal(res, &next))
+
+	// 300 blocks over the 10 minutes target interval, doubled by the base fee
+	require.True(t, next.Enabled)
+	require.True(t, next.Ready)
+	require.Equal(t, uint64(0), next.StartBlock)
+	require.Equal(t, uint64(599), next.EndBlock)
+	require.Equal(t, uint64(1000), next.LatestBlock)
+	require.Equal(t, params.AvgCheckpointLength, next.SampleBlocks)
+}
@@ -3,6 +3,7 @@ package checkpoint
 import (
 	"bytes"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -12,6 +13,7 @@ import (
 	"github.com/zenanetwork/iris/common"
 	"github.com/zenanetwork/iris/helper"
 	hmTypes "github.com/zenanetwork/iris/types"
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
 )
 
 // NewSideTxHandler returns a side handler for "bank" type messages.
@@ -19,6 +21,13 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 	return func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
+		// read the zena chain of the msg
+		k, err := k.WithChainID(ctx, msgZenaChainID(msg))
+		if err != nil {
+			k.Logger(ctx).Error("Invalid zena chain id", "chainID", msgZenaChainID(msg), "error", err)
+			return common.ErrorSideTx(k.Codespace(), common.CodeInvalidZenaChainID)
+		}
+
 		switch msg := msg.(type) {
 		case types.MsgCheckpointAdjust:
 			return SideHandleMsgCheckpointAdjust(ctx, k, msg, contractCaller)
@@ -100,6 +109,29 @@ func SideHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	// logger
 	logger := k.Logger(ctx)
 
+	// read the blocks of the zena chain of the checkpoint
+	contractCaller, err := k.ChainContractCaller(contractCaller)
+	if err != nil {
+		logger.Error("Error getting the contract caller of the zena chain", "chainID", msg.ZenaChainID, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidBlockInput)
+	}
+
+	// bounds of the adaptive checkpoint sizing, from the state only
+	var lastCheckpointTime time.Time
+	if lastCheckpoint, err := k.GetLastCheckpoint(ctx); err == nil {
+		lastCheckpointTime = time.Unix(int64(lastCheckpoint.TimeStamp), 0) //nolint:gosec
+	}
+
+	if err := params.ValidateAdaptiveCheckpointLength(msg.StartBlock, msg.EndBlock, lastCheckpointTime, ctx.BlockTime()); err != nil {
+		logger.Error("Checkpoint out of the adaptive checkpoint bounds",
+			"startBlock", msg.StartBlock,
+			"endBlock", msg.EndBlock,
+			"error", err,
+		)
+
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidBlockInput)
+	}
+
 	// validate checkpoint
 	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash, params.MaxCheckpointLength, contractCaller, maticTxConfirmations)
 	if err != nil {
@@ -130,7 +162,7 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	logger := k.Logger(ctx)
 
 	params := k.GetParams(ctx)
-	chainParams := k.ck.GetParams(ctx).ChainParams
+	chainParams := k.ChainParams(ctx)
 
 	//
 	// Validate data from root chain
@@ -173,6 +205,20 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
 	}
 
+	// the L1 block of the ack anchors the pruning of the log registry
+	if msg.BlockNumber != 0 && k.uk.IsUpgradeActive(ctx, upgradeTypes.LogRegistryUpgrade) {
+		receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.ck.GetParams(ctx).MainchainTxConfirmations)
+		if err != nil || receipt == nil {
+			logger.Error("Unable to fetch the receipt of the ack", "txHash", msg.TxHash, "error", err)
+			return common.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		}
+
+		if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+			logger.Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+			return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
+		}
+	}
+
 	// say `yes`
 	result.Result = abci.SideTxResultType_Yes
 
@@ -188,6 +234,13 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 	return func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
+		// the checkpoints and milestones of a child chain are kept apart
+		k, err := k.WithChainID(ctx, msgZenaChainID(msg))
+		if err != nil {
+			k.Logger(ctx).Error("Invalid Zena chain id", "msgChainID", msgZenaChainID(msg))
+			return common.ErrInvalidZenaChainID(k.Codespace()).Result()
+		}
+
 		switch msg := msg.(type) {
 		case types.MsgCheckpointAdjust:
 			return PostHandleMsgCheckpointAdjust(ctx, k, msg, sideTxResult, contractCaller)
@@ -412,7 +465,7 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	}
 
 	// adjust checkpoint data if latest checkpoint is already submitted
-	if ctx.BlockHeight() < helper.GetAalborgHardForkHeight() {
+	if !k.uk.IsUpgradeActive(ctx, helper.AalborgUpgrade) {
 		if checkpointObj.EndBlock > msg.EndBlock {
 			logger.Info("Adjusting endBlock to one already submitted on chain", "endBlock", checkpointObj.EndBlock, "adjustedEndBlock", msg.EndBlock)
 			checkpointObj.EndBlock = msg.EndBlock
@@ -449,9 +502,17 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	k.UpdateACKCount(ctx)
 
 	logger.Info("Valid ack received", "CurrentACKCount", k.GetACKCount(ctx)-1, "UpdatedACKCount", k.GetACKCount(ctx))
+	checkpointAckCount.WithLabelValues(k.ChainParams(ctx).ZenaChainID).Set(float64(k.GetACKCount(ctx)))
 
-	// Increment accum (selects new proposer)
-	k.sk.IncrementAccum(ctx, 1)
+	// Increment accum (selects new proposer), the acks of the chain of the chain params
+	// only drive the proposer rotation and the epochs of the validators
+	if k.ChainID() == "" {
+		k.sk.IncrementAccum(ctx, 1)
+
+		if msg.BlockNumber > k.GetLastAckBlock(ctx) && k.uk.IsUpgradeActive(ctx, upgradeTypes.LogRegistryUpgrade) {
+			k.SetLastAckBlock(ctx, msg.BlockNumber)
+		}
+	}
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	zenaCommon "github.com/zenanetwork/go-zenanet/common"
+	ethTypes "github.com/zenanetwork/go-zenanet/core/types"
 
 	"github.com/zenanetwork/iris/app"
 	cmTypes "github.com/zenanetwork/iris/chainmanager/types"
@@ -21,6 +22,7 @@ import (
 	"github.com/zenanetwork/iris/common"
 	errs "github.com/zenanetwork/iris/common"
 	"github.com/zenanetwork/iris/contracts/rootchain"
+	"github.com/zenanetwork/iris/helper"
 	"github.com/zenanetwork/iris/helper/mocks"
 	hmTypes "github.com/zenanetwork/iris/types"
 )
@@ -66,6 +68,48 @@ func (suite *SideHandlerTestSuite) TestSideHandler() {
 	require.Equal(t, abci.SideTxResultType_Skip, result.Result)
 }
 
+func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAdaptiveBounds() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.AdaptiveCheckpoint.Enabled = true
+	keeper.SetParams(ctx, params)
+
+	lastCheckpointTime := time.Unix(1000000, 0)
+	lastCheckpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToIrisHash("123"), hmTypes.HexToIrisAddress("123"), helper.DefaultZenaChainID, uint64(lastCheckpointTime.Unix()))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, lastCheckpoint))
+	keeper.UpdateACKCount(ctx)
+
+	// shorter than the min checkpoint length
+	msgCheckpoint := types.NewMsgCheckpointBlock(
+		hmTypes.HexToIrisAddress("123"),
+		256,
+		256+params.AdaptiveCheckpoint.MinCheckpointLength-2,
+		hmTypes.HexToIrisHash("456"),
+		hmTypes.HexToIrisHash("456"),
+		helper.DefaultZenaChainID,
+	)
+
+	suite.Run("Too short", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		result := suite.sideHandler(ctx.WithBlockTime(lastCheckpointTime.Add(params.AdaptiveCheckpoint.TargetInterval)), msgCheckpoint)
+		require.Equal(t, uint32(common.CodeInvalidBlockInput), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result)
+	})
+
+	suite.Run("Short after two target intervals", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("CheckIfBlocksExist", msgCheckpoint.EndBlock+cmTypes.DefaultMaticchainTxConfirmations).Return(true)
+		suite.contractCaller.On("GetRootHash", msgCheckpoint.StartBlock, msgCheckpoint.EndBlock, params.MaxCheckpointLength).Return(msgCheckpoint.RootHash.Bytes(), nil)
+
+		result := suite.sideHandler(ctx.WithBlockTime(lastCheckpointTime.Add(2*params.AdaptiveCheckpoint.TargetInterval)), msgCheckpoint)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code)
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result)
+	})
+}
+
 // test handler for message
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustSuccess() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
@@ -76,7 +120,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustSuccess() {
 		StartBlock:  0,
 		EndBlock:    256,
 		RootHash:    hmTypes.HexToIrisHash("123"),
-		ZenaChainID: "testchainid",
+		ZenaChainID: helper.DefaultZenaChainID,
 		TimeStamp:   1,
 	}
 	err := keeper.AddCheckpoint(ctx, 1, checkpoint)
@@ -112,7 +156,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustSameCheckpointAsRoot
 		StartBlock:  0,
 		EndBlock:    256,
 		RootHash:    hmTypes.HexToIrisHash("123"),
-		ZenaChainID: "testchainid",
+		ZenaChainID: helper.DefaultZenaChainID,
 		TimeStamp:   1,
 	}
 	err := keeper.AddCheckpoint(ctx, 1, checkpoint)
@@ -143,7 +187,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustNotSameCheckpointAsR
 		StartBlock:  0,
 		EndBlock:    256,
 		RootHash:    hmTypes.HexToIrisHash("123"),
-		ZenaChainID: "testchainid",
+		ZenaChainID: helper.DefaultZenaChainID,
 		TimeStamp:   1,
 	}
 	err := keeper.AddCheckpoint(ctx, 1, checkpoint)
@@ -176,7 +220,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpoint() {
 	header, err := chSim.GenRandCheckpoint(start, maxSize, params.MaxCheckpointLength)
 	require.NoError(t, err)
 
-	zenaChainId := "1234"
+	zenaChainId := helper.DefaultZenaChainID
 
 	suite.Run("Success", func() {
 		suite.contractCaller = mocks.IContractCaller{}
@@ -283,6 +327,33 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
 	})
 
+	suite.Run("Ack Block Mismatch", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		// prepare ack msg
+		msgCheckpointAck := types.NewMsgCheckpointAck(
+			hmTypes.HexToIrisAddress("123"),
+			uint64(1),
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			hmTypes.HexToIrisHash("123123"),
+			uint64(1),
+		)
+		msgCheckpointAck.BlockNumber = 50
+		rootchainInstance := &rootchain.Rootchain{}
+		txReceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(51)}
+
+		suite.contractCaller.On("GetRootChainInstance", mock.Anything).Return(rootchainInstance, nil)
+		suite.contractCaller.On("GetHeaderInfo", headerId, rootchainInstance, params.ChildBlockInterval).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", msgCheckpointAck.TxHash.EthHash(), app.ChainKeeper.GetParams(ctx).MainchainTxConfirmations).Return(txReceipt, nil)
+
+		result := suite.sideHandler(ctx, msgCheckpointAck)
+		require.Equal(t, uint32(common.CodeInvalidACK), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+
 	suite.Run("No HeaderInfo", func() {
 		suite.contractCaller = mocks.IContractCaller{}
 
@@ -348,7 +419,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpoint() {
 	// add current proposer to header
 	header.Proposer = stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
 
-	zenaChainId := "1234"
+	zenaChainId := helper.DefaultZenaChainID
 
 	suite.Run("Failure", func() {
 		// create checkpoint msg
@@ -450,7 +521,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header.EndBlock,
 			header.RootHash,
 			header.RootHash,
-			"1234",
+			helper.DefaultZenaChainID,
 		)
 
 		result := suite.postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
@@ -466,9 +537,11 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			hmTypes.HexToIrisHash("123123"),
 			uint64(1),
 		)
+		msgCheckpointAck.BlockNumber = 50
 
 		result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
 		require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+		require.Equal(t, uint64(50), keeper.GetLastAckBlock(ctx))
 
 		afterAckBufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
 		require.Nil(t, afterAckBufferedCheckpoint)
@@ -494,6 +567,49 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 		require.Nil(t, afterAckBufferedCheckpoint)
 	})
 
+	suite.Run("ChildChain", func() {
+		chainParams := app.ChainKeeper.GetParams(ctx)
+		chainParams.ChildChains = []cmTypes.ChildChainParams{{ZenaChainID: "15002"}}
+		app.ChainKeeper.SetParams(ctx, chainParams)
+
+		msgCheckpoint := types.NewMsgCheckpointBlock(
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			header.RootHash,
+			"15002",
+		)
+
+		result := suite.postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+		msgCheckpointAck := types.NewMsgCheckpointAck(
+			hmTypes.HexToIrisAddress("123"),
+			1,
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			hmTypes.HexToIrisHash("123123"),
+			uint64(1),
+		)
+		msgCheckpointAck.ZenaChainID = "15002"
+
+		// the acks of a child chain don't rotate the proposer
+		validatorSet := app.StakingKeeper.GetValidatorSet(ctx)
+
+		result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+		require.Equal(t, validatorSet, app.StakingKeeper.GetValidatorSet(ctx))
+
+		// unknown chains are rejected
+		msgCheckpointAck.ZenaChainID = "15003"
+
+		result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
+		require.Equal(t, common.CodeInvalidZenaChainID, result.Code)
+	})
+
 	suite.Run("InvalidEndBlock", func() {
 		suite.contractCaller = mocks.IContractCaller{}
 		header2, _ := chSim.GenRandCheckpoint(header.EndBlock+1, maxSize, params.MaxCheckpointLength)
@@ -504,7 +620,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header2.EndBlock,
 			header2.RootHash,
 			header2.RootHash,
-			"1234",
+			helper.DefaultZenaChainID,
 		)
 
 		result := suite.postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
@@ -541,7 +657,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header3.EndBlock,
 			header3.RootHash,
 			header3.RootHash,
-			"1234",
+			helper.DefaultZenaChainID,
 		)
 
 		ctx = ctx.WithBlockHeight(int64(-1))
@@ -585,7 +701,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header4.EndBlock,
 			header4.RootHash,
 			header4.RootHash,
-			"1234",
+			helper.DefaultZenaChainID,
 		)
 
 		ctx = ctx.WithBlockHeight(int64(-1))
@@ -629,7 +745,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header5.EndBlock,
 			header5.RootHash,
 			header5.RootHash,
-			"1234",
+			helper.DefaultZenaChainID,
 		)
 
 		ctx = ctx.WithBlockHeight(int64(1))
@@ -673,7 +789,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header6.EndBlock,
 			header6.RootHash,
 			header6.RootHash,
-			"1234",
+			helper.DefaultZenaChainID,
 		)
 
 		ctx = ctx.WithBlockHeight(int64(1))
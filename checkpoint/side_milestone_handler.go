@@ -7,21 +7,28 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmTypes "github.com/tendermint/tendermint/types"
 
-	cmTypes "github.com/zenanetwork/iris/chainmanager/types"
 	"github.com/zenanetwork/iris/checkpoint/types"
 	"github.com/zenanetwork/iris/common"
 	"github.com/zenanetwork/iris/helper"
 	hmTypes "github.com/zenanetwork/iris/types"
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
 )
 
 // SideHandleMsgMilestone handles MsgMilestone message for external call
 func SideHandleMsgMilestone(ctx sdk.Context, k Keeper, msg types.MsgMilestone, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
 	// get params
-	milestoneLength := helper.MilestoneLength
+	params := k.GetParams(ctx)
 
 	// logger
 	logger := k.MilestoneLogger(ctx)
 
+	// read the blocks of the zena chain of the milestone
+	contractCaller, err := k.ChainContractCaller(contractCaller)
+	if err != nil {
+		logger.Error("Error getting the contract caller of the zena chain", "chainID", msg.ZenaChainID, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidBlockInput)
+	}
+
 	//Get the milestone count
 	count := k.GetMilestoneCount(ctx)
 	lastMilestone, err := k.GetLastMilestone(ctx)
@@ -44,7 +51,7 @@ func SideHandleMsgMilestone(ctx sdk.Context, k Keeper, msg types.MsgMilestone, c
 	}
 
 	// Validating the milestone
-	validMilestone, err := types.ValidateMilestone(msg.StartBlock, msg.EndBlock, msg.Hash, msg.MilestoneID, contractCaller, milestoneLength, cmTypes.DefaultMaticchainMilestoneTxConfirmations)
+	validMilestone, err := types.ValidateMilestone(msg.StartBlock, msg.EndBlock, msg.Hash, msg.MilestoneID, contractCaller, params.MilestoneLength, params.MilestoneTxConfirmations)
 	if err != nil {
 		logger.Error("Error validating milestone",
 			"startBlock", msg.StartBlock,
@@ -158,6 +165,11 @@ func PostHandleMsgMilestone(ctx sdk.Context, k Keeper, msg types.MsgMilestone, s
 	}); err != nil {
 		k.SetNoAckMilestone(ctx, msg.MilestoneID)
 		logger.Error("Failed to set milestone ", "Error", err)
+	} else {
+		if k.uk.IsUpgradeActive(ctx, upgradeTypes.MilestoneParticipationUpgrade) {
+			k.sk.RecordMilestoneParticipation(ctx, msg.Proposer, false)
+		}
+		milestoneCount.Set(float64(k.GetMilestoneCount(ctx)))
 	}
 
 	return sdk.Result{
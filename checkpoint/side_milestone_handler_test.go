@@ -8,7 +8,6 @@ import (
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	cmTypes "github.com/zenanetwork/iris/chainmanager/types"
 	chSim "github.com/zenanetwork/iris/checkpoint/simulation"
 	"github.com/zenanetwork/iris/checkpoint/types"
 	"github.com/zenanetwork/iris/common"
@@ -26,12 +25,12 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgMilestone() {
 	keeper := app.CheckpointKeeper
 
 	start := uint64(0)
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := types.DefaultMilestoneLength
 
 	milestone, err := chSim.GenRandMilestone(start, milestoneLength)
 	require.NoError(t, err)
 
-	zenaChainId := "1234"
+	zenaChainId := helper.DefaultZenaChainID
 
 	suite.Run("Success", func() {
 		suite.contractCaller = mocks.IContractCaller{}
@@ -46,7 +45,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgMilestone() {
 			milestone.MilestoneID,
 		)
 
-		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+cmTypes.DefaultMaticchainMilestoneTxConfirmations).Return(true)
+		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+types.DefaultMilestoneTxConfirmations).Return(true)
 		suite.contractCaller.On("GetVoteOnHash", milestone.StartBlock, milestone.EndBlock, milestoneLength, milestone.Hash.String(), milestone.MilestoneID).Return(true, nil)
 
 		result := suite.sideHandler(ctx, msgMilestone)
@@ -70,7 +69,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgMilestone() {
 			milestone.MilestoneID,
 		)
 
-		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+cmTypes.DefaultMaticchainMilestoneTxConfirmations).Return(true)
+		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+types.DefaultMilestoneTxConfirmations).Return(true)
 		suite.contractCaller.On("GetVoteOnHash", milestone.StartBlock, milestone.EndBlock, milestoneLength, milestone.Hash.String(), milestone.MilestoneID).Return(false, nil)
 
 		result := suite.sideHandler(ctx, msgMilestone)
@@ -96,7 +95,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgMilestone() {
 			milestone.MilestoneID,
 		)
 
-		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+cmTypes.DefaultMaticchainMilestoneTxConfirmations).Return(true)
+		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+types.DefaultMilestoneTxConfirmations).Return(true)
 		suite.contractCaller.On("GetVoteOnHash", milestone.StartBlock, milestone.EndBlock, milestoneLength, milestone.Hash.String(), milestone.MilestoneID).Return(true, nil)
 
 		result := suite.sideHandler(ctx, msgMilestone)
@@ -119,9 +118,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgMilestone() {
 			milestone.MilestoneID,
 		)
 
-		msgMilestone.ZenaChainID = msgMilestone.MilestoneID
-
-		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+cmTypes.DefaultMaticchainMilestoneTxConfirmations).Return(true)
+		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+types.DefaultMilestoneTxConfirmations).Return(true)
 		suite.contractCaller.On("GetVoteOnHash", milestone.StartBlock, milestone.EndBlock, milestoneLength, milestone.Hash.String(), milestone.MilestoneID).Return(true, nil)
 
 		result := suite.sideHandler(ctx, msgMilestone)
@@ -144,9 +141,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgMilestone() {
 			milestone.MilestoneID,
 		)
 
-		msgMilestone.ZenaChainID = msgMilestone.MilestoneID
-
-		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+cmTypes.DefaultMaticchainMilestoneTxConfirmations).Return(true)
+		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+types.DefaultMilestoneTxConfirmations).Return(true)
 		suite.contractCaller.On("GetVoteOnHash", milestone.StartBlock, milestone.EndBlock, milestoneLength, milestone.Hash.String(), milestone.MilestoneID).Return(true, nil)
 
 		result := suite.sideHandler(ctx, msgMilestone)
@@ -172,7 +167,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgMilestone() {
 			milestone.MilestoneID,
 		)
 
-		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+cmTypes.DefaultMaticchainMilestoneTxConfirmations).Return(true)
+		suite.contractCaller.On("CheckIfBlocksExist", milestone.EndBlock+types.DefaultMilestoneTxConfirmations).Return(true)
 		suite.contractCaller.On("GetVoteOnHash", milestone.StartBlock, milestone.EndBlock, milestoneLength, milestone.Hash.String(), milestone.MilestoneID).Return(true, nil)
 
 		result := suite.sideHandler(ctx, msgMilestone)
@@ -186,7 +181,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgMilestone() {
 	keeper := app.CheckpointKeeper
 	stakingKeeper := app.StakingKeeper
 	start := uint64(0)
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := types.DefaultMilestoneLength
 
 	// check valid milestone
 	// generate proposer for validator set
@@ -204,7 +199,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgMilestone() {
 	// add current proposer to header
 	milestone.Proposer = stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
 
-	zenaChainId := "1234"
+	zenaChainId := helper.DefaultZenaChainID
 
 	suite.Run("Failure", func() {
 		// create milestone msg
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zenanetwork/iris/helper"
 	"github.com/zenanetwork/iris/staking"
 	stakingSim "github.com/zenanetwork/iris/staking/simulation"
 
@@ -17,7 +18,7 @@ import (
 // GenRandCheckpoint return headers
 func GenRandCheckpoint(start uint64, headerSize uint64, maxCheckpointLength uint64) (headerBlock types.Checkpoint, err error) {
 	end := start + headerSize
-	zenaChainID := "1234"
+	zenaChainID := helper.DefaultZenaChainID
 	rootHash := types.HexToIrisHash("123")
 	proposer := types.IrisAddress{}
 
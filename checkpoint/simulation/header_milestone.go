@@ -4,6 +4,7 @@ package simulation
 import (
 	"time"
 
+	"github.com/zenanetwork/iris/helper"
 	"github.com/zenanetwork/iris/types"
 )
 
@@ -12,7 +13,7 @@ const TestMilestoneID = "17ce48fe-0a18-41a8-ab7e-59d8002f027b - 0x901a64406d97a3
 // GenRandMilestone return headers
 func GenRandMilestone(start uint64, sprintLength uint64) (milestone types.Milestone, err error) {
 	end := start + sprintLength - 1
-	zenaChainID := "1234"
+	zenaChainID := helper.DefaultZenaChainID
 	rootHash := types.HexToIrisHash("123")
 	proposer := types.IrisAddress{}
 	milestoneID := TestMilestoneID
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/zenanetwork/iris/helper"
+)
+
+// AdaptiveCheckpoint is the range of the next checkpoint chosen by the adaptive checkpoint sizing,
+// along with the zena block rate and the L1 base fee it was chosen from
+type AdaptiveCheckpoint struct {
+	Enabled    bool   `json:"enabled"`
+	StartBlock uint64 `json:"start_block"`
+	EndBlock   uint64 `json:"end_block"`
+	// all the blocks of the range are confirmed, the checkpoint can be proposed
+	Ready bool `json:"ready"`
+
+	LatestBlock    uint64        `json:"latest_block"`
+	SampleBlocks   uint64        `json:"sample_blocks"`
+	SampleDuration time.Duration `json:"sample_duration"`
+	BaseFee        *big.Int      `json:"base_fee"`
+}
+
+// AdaptiveCheckpointLength returns the length of the next checkpoint: the zena blocks produced over the
+// target interval at the rate of sampleBlocks over sampleDuration, scaled up by the L1 base fee over the
+// base fee threshold, within MinCheckpointLength and MaxCheckpointLength
+func (p Params) AdaptiveCheckpointLength(sampleBlocks uint64, sampleDuration time.Duration, baseFee *big.Int) uint64 {
+	adaptive := p.AdaptiveCheckpoint
+
+	length := new(big.Int).SetUint64(p.MaxCheckpointLength)
+	if sampleDuration > 0 {
+		length.SetUint64(sampleBlocks)
+		length.Mul(length, big.NewInt(int64(adaptive.TargetInterval)))
+		length.Quo(length, big.NewInt(int64(sampleDuration)))
+	}
+
+	// fewer, larger checkpoints while L1 is expensive
+	if adaptive.BaseFeeThreshold > 0 && baseFee != nil && baseFee.Cmp(new(big.Int).SetUint64(adaptive.BaseFeeThreshold)) > 0 {
+		length.Mul(length, baseFee)
+		length.Quo(length, new(big.Int).SetUint64(adaptive.BaseFeeThreshold))
+	}
+
+	if !length.IsUint64() || length.Uint64() > p.MaxCheckpointLength {
+		return p.MaxCheckpointLength
+	}
+
+	if length.Uint64() < adaptive.MinCheckpointLength {
+		return adaptive.MinCheckpointLength
+	}
+
+	return length.Uint64()
+}
+
+// ValidateAdaptiveCheckpointLength checks the length of a checkpoint against the bounds of the adaptive
+// checkpoint sizing. Checkpoints shorter than MinCheckpointLength are only valid two target intervals
+// after the last checkpoint, when zena blocks are produced slower than expected.
+func (p Params) ValidateAdaptiveCheckpointLength(start uint64, end uint64, lastCheckpointTime time.Time, now time.Time) error {
+	adaptive := p.AdaptiveCheckpoint
+	if !adaptive.Enabled {
+		return nil
+	}
+
+	if end < start {
+		return fmt.Errorf("invalid checkpoint range %d-%d", start, end)
+	}
+
+	length := end - start + 1
+	if length > p.MaxCheckpointLength {
+		return fmt.Errorf("checkpoint length %d is more than the max checkpoint length %d", length, p.MaxCheckpointLength)
+	}
+
+	if length < adaptive.MinCheckpointLength && now.Sub(lastCheckpointTime) < 2*adaptive.TargetInterval {
+		return fmt.Errorf("checkpoint length %d is less than the min checkpoint length %d, %s after the last checkpoint",
+			length, adaptive.MinCheckpointLength, now.Sub(lastCheckpointTime))
+	}
+
+	return nil
+}
+
+// NextAdaptiveCheckpoint returns the range of the checkpoint starting at start chosen by the adaptive
+// checkpoint sizing, from the rate of the zena blocks up to latest, the latest confirmed block, and the
+// base fee of the latest L1 block
+func NextAdaptiveCheckpoint(params Params, start uint64, latest uint64, contractCaller helper.IContractCaller) (*AdaptiveCheckpoint, error) {
+	next := &AdaptiveCheckpoint{
+		Enabled:     params.AdaptiveCheckpoint.Enabled,
+		StartBlock:  start,
+		LatestBlock: latest,
+	}
+
+	// block rate over the last avg checkpoint length blocks
+	var sampleStart uint64
+	if latest > params.AvgCheckpointLength {
+		sampleStart = latest - params.AvgCheckpointLength
+	}
+
+	if next.SampleBlocks = latest - sampleStart; next.SampleBlocks > 0 {
+		latestHeader, err := contractCaller.GetMaticChainBlock(new(big.Int).SetUint64(latest))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching zena block %d: %w", latest, err)
+		}
+
+		sampleHeader, err := contractCaller.GetMaticChainBlock(new(big.Int).SetUint64(sampleStart))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching zena block %d: %w", sampleStart, err)
+		}
+
+		if latestHeader.Time > sampleHeader.Time {
+			next.SampleDuration = time.Duration(latestHeader.Time-sampleHeader.Time) * time.Second
+		}
+	}
+
+	mainHeader, err := contractCaller.GetMainChainBlock(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching latest main chain block: %w", err)
+	}
+
+	next.BaseFee = mainHeader.BaseFee
+
+	length := params.AdaptiveCheckpointLength(next.SampleBlocks, next.SampleDuration, next.BaseFee)
+	next.EndBlock = start + length - 1
+	next.Ready = latest >= next.EndBlock
+
+	return next, nil
+}
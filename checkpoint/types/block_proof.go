@@ -0,0 +1,89 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	ethTypes "github.com/zenanetwork/go-zenanet/core/types"
+
+	"github.com/zenanetwork/iris/helper"
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+// CheckpointBlock is the checkpoint covering a zena block
+type CheckpointBlock struct {
+	BlockNumber uint64             `json:"block_number"`
+	Number      uint64             `json:"number"`
+	Checkpoint  hmTypes.Checkpoint `json:"checkpoint"`
+}
+
+// BlockProof is the checkpoint covering a zena block with the merkle path of the block header
+// (Leaf, see GetHeaderLeaf) at Index in the checkpoint root hash, as needed to build exit proofs.
+type BlockProof struct {
+	BlockNumber uint64             `json:"block_number"`
+	Number      uint64             `json:"number"`
+	Checkpoint  hmTypes.Checkpoint `json:"checkpoint"`
+	Leaf        hmTypes.HexBytes   `json:"leaf"`
+	Index       uint64             `json:"index"`
+	Proof       hmTypes.HexBytes   `json:"proof"`
+}
+
+// Verify checks the merkle path of Leaf against the checkpoint root hash
+func (p BlockProof) Verify() error {
+	if p.BlockNumber < p.Checkpoint.StartBlock || p.BlockNumber > p.Checkpoint.EndBlock {
+		return errors.New("block is not in checkpoint range")
+	}
+
+	if p.Index != p.BlockNumber-p.Checkpoint.StartBlock {
+		return errors.New("invalid header index")
+	}
+
+	if !VerifyHeaderProof(p.Leaf, p.Index, p.Checkpoint.RootHash.Bytes(), p.Proof) {
+		return errors.New("invalid header proof")
+	}
+
+	return nil
+}
+
+// GetBlockProof fetches the zena headers of the checkpoint covering a block and builds the merkle path
+// of the block header. It runs in the clients and not in the querier, as a checkpoint covers up to
+// max checkpoint length headers.
+func GetBlockProof(checkpointBlock CheckpointBlock, contractCaller helper.IContractCaller) (*BlockProof, error) {
+	checkpoint := checkpointBlock.Checkpoint
+	if checkpointBlock.BlockNumber < checkpoint.StartBlock || checkpointBlock.BlockNumber > checkpoint.EndBlock {
+		return nil, errors.New("block is not in checkpoint range")
+	}
+
+	headers := make([]*ethTypes.Header, 0, checkpoint.EndBlock-checkpoint.StartBlock+1)
+
+	for i := checkpoint.StartBlock; i <= checkpoint.EndBlock; i++ {
+		header, err := contractCaller.GetMaticChainBlock(new(big.Int).SetUint64(i))
+		if err != nil || header == nil {
+			return nil, fmt.Errorf("could not fetch zena block header %v", i)
+		}
+
+		headers = append(headers, header)
+	}
+
+	index := checkpointBlock.BlockNumber - checkpoint.StartBlock
+
+	rootHash, proof, err := GetHeaderProof(headers, index)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate header proof for block %v: %w", checkpointBlock.BlockNumber, err)
+	}
+
+	if !bytes.Equal(rootHash, checkpoint.RootHash.Bytes()) {
+		return nil, fmt.Errorf("root hash of zena blocks %v-%v does not match checkpoint %v", checkpoint.StartBlock, checkpoint.EndBlock, checkpointBlock.Number)
+	}
+
+	return &BlockProof{
+		BlockNumber: checkpointBlock.BlockNumber,
+		Number:      checkpointBlock.Number,
+		Checkpoint:  checkpoint,
+		Leaf:        GetHeaderLeaf(headers[index]),
+		Index:       index,
+		Proof:       proof,
+	}, nil
+}
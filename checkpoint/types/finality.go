@@ -0,0 +1,31 @@
+package types
+
+// FinalityLevel is how final a zena block is, from none to acked on L1
+type FinalityLevel string
+
+const (
+	FinalityNone         FinalityLevel = "none"         // not covered by a milestone nor a checkpoint
+	FinalityMilestone    FinalityLevel = "milestone"    // covered by a milestone
+	FinalityCheckpointed FinalityLevel = "checkpointed" // covered by the checkpoint in buffer, waiting for its ack
+	FinalityL1Acked      FinalityLevel = "l1-acked"     // covered by a checkpoint acked on L1
+)
+
+// MaxFinalityRange is the max number of blocks of a finality range query
+const MaxFinalityRange = 1000
+
+// BlockFinality is the finality of a zena block, with the milestone and the checkpoint covering it
+type BlockFinality struct {
+	BlockNumber uint64        `json:"block_number"`
+	BlockHash   string        `json:"block_hash,omitempty"`
+	Level       FinalityLevel `json:"level"`
+
+	// milestone covering the block, unknown for the blocks of pruned milestones
+	MilestoneNumber uint64 `json:"milestone_number,omitempty"`
+	MilestoneID     string `json:"milestone_id,omitempty"`
+
+	// checkpoint covering the block, the number the checkpoint in buffer is acked with
+	CheckpointNumber uint64 `json:"checkpoint_number,omitempty"`
+
+	// iris height the block reached its level at, zero if not known
+	FinalizedAt int64 `json:"finalized_at"`
+}
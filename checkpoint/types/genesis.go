@@ -17,6 +17,19 @@ type GenesisState struct {
 	LastNoACK          uint64               `json:"last_no_ack" yaml:"last_no_ack"`
 	AckCount           uint64               `json:"ack_count" yaml:"ack_count"`
 	Checkpoints        []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
+
+	// checkpoint state of the zena child chains
+	ChildChains []ChildChainGenesisState `json:"child_chains,omitempty" yaml:"child_chains,omitempty"`
+}
+
+// ChildChainGenesisState is the checkpoint state of a zena child chain
+type ChildChainGenesisState struct {
+	ZenaChainID string `json:"zena_chain_id" yaml:"zena_chain_id"`
+
+	BufferedCheckpoint *hmTypes.Checkpoint  `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
+	LastNoACK          uint64               `json:"last_no_ack" yaml:"last_no_ack"`
+	AckCount           uint64               `json:"ack_count" yaml:"ack_count"`
+	Checkpoints        []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -46,7 +59,7 @@ func DefaultGenesisState() GenesisState {
 // ValidateGenesis performs basic validation of zena genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
-	if err := data.Params.Validate(); err != nil {
+	if err := data.Params.WithMilestoneDefaults().Validate(); err != nil {
 		return err
 	}
 
@@ -57,6 +70,20 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("ack count does not match the number of checkpoints")
 	}
 
+	chainIDs := make(map[string]bool, len(data.ChildChains))
+
+	for _, childChain := range data.ChildChains {
+		if childChain.ZenaChainID == "" || chainIDs[childChain.ZenaChainID] {
+			return fmt.Errorf("empty or duplicate zena chain id %q of child chain checkpoints", childChain.ZenaChainID)
+		}
+
+		chainIDs[childChain.ZenaChainID] = true
+
+		if childChain.AckCount > math.MaxInt || int(childChain.AckCount) != len(childChain.Checkpoints) {
+			return fmt.Errorf("ack count does not match the number of checkpoints of zena chain %s", childChain.ZenaChainID)
+		}
+	}
+
 	return nil
 }
 
@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/cbergoon/merkletree"
 	"github.com/zenanetwork/go-zenanet/common"
+	ethTypes "github.com/zenanetwork/go-zenanet/core/types"
+	"github.com/zenanetwork/go-zenanet/crypto"
 
 	"github.com/tendermint/crypto/sha3"
 
@@ -115,6 +118,95 @@ func VerifyAccountProof(dividendAccounts []hmTypes.DividendAccount, userAddr hmT
 	return false, nil
 }
 
+// headerLeaf is the hash of a zena block header in the checkpoint root hash tree
+type headerLeaf [32]byte
+
+// CalculateHash returns the leaf as is, it is already a hash
+func (l headerLeaf) CalculateHash() ([]byte, error) {
+	return l[:], nil
+}
+
+// Equals tests for equality of two leaves
+func (l headerLeaf) Equals(other merkletree.Content) (bool, error) {
+	o, ok := other.(headerLeaf)
+	return ok && l == o, nil
+}
+
+// GetHeaderLeaf returns the leaf of a zena block header in the checkpoint root hash tree
+func GetHeaderLeaf(header *ethTypes.Header) []byte {
+	return crypto.Keccak256(appendBytes32(
+		header.Number.Bytes(),
+		new(big.Int).SetUint64(header.Time).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	))
+}
+
+// GetHeaderProof returns the root hash of the checkpoint made of headers (as computed by zena)
+// and the merkle path of the header at index, as concatenated 32 byte siblings from the leaf up
+func GetHeaderProof(headers []*ethTypes.Header, index uint64) ([]byte, []byte, error) {
+	if index >= uint64(len(headers)) {
+		return nil, nil, fmt.Errorf("header index %d out of range: %d headers", index, len(headers))
+	}
+
+	// the tree is padded with empty leaves up to the next power of two
+	size := 1
+	for size < len(headers) {
+		size *= 2
+	}
+
+	list := make([]merkletree.Content, size)
+	for i := range list {
+		list[i] = headerLeaf{}
+	}
+
+	for i, header := range headers {
+		var leaf headerLeaf
+
+		copy(leaf[:], GetHeaderLeaf(header))
+		list[i] = leaf
+	}
+
+	// a single header is its own root
+	if size == 1 {
+		leaf := list[0].(headerLeaf)
+		return leaf[:], []byte{}, nil
+	}
+
+	tree, err := merkletree.NewTreeWithHashStrategy(list, sha3.NewLegacyKeccak256)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	branchArray, _, err := tree.GetMerklePath(list[index])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tree.MerkleRoot(), appendBytes32(branchArray...), nil
+}
+
+// VerifyHeaderProof checks the merkle path of a header leaf at index against a checkpoint root hash
+func VerifyHeaderProof(leaf []byte, index uint64, rootHash []byte, proof []byte) bool {
+	if len(proof)%32 != 0 {
+		return false
+	}
+
+	hash := leaf
+
+	for i := 0; i < len(proof); i += 32 {
+		if index%2 == 0 {
+			hash = crypto.Keccak256(hash, proof[i:i+32])
+		} else {
+			hash = crypto.Keccak256(proof[i:i+32], hash)
+		}
+
+		index /= 2
+	}
+
+	return index == 0 && bytes.Equal(hash, rootHash)
+}
+
 //nolint:unparam
 func convertTo32(input []byte) (output [32]byte, err error) {
 	l := len(input)
@@ -190,6 +190,10 @@ type MsgCheckpointAck struct {
 	RootHash   types.IrisHash    `json:"root_hash"`
 	TxHash     types.IrisHash    `json:"tx_hash"`
 	LogIndex   uint64            `json:"log_index"`
+	// zena child chain of the checkpoint, empty for the chain of the chain params
+	ZenaChainID string `json:"zena_chain_id,omitempty"`
+	// L1 block of the ack tx, empty for the acks from before it was carried
+	BlockNumber uint64 `json:"block_number,omitempty"`
 }
 
 func NewMsgCheckpointAck(
@@ -277,6 +281,8 @@ var _ sdk.Msg = &MsgCheckpointNoAck{}
 
 type MsgCheckpointNoAck struct {
 	From types.IrisAddress `json:"from"`
+	// zena child chain of the checkpoint, empty for the chain of the chain params
+	ZenaChainID string `json:"zena_chain_id,omitempty"`
 }
 
 func NewMsgCheckpointNoAck(from types.IrisAddress) MsgCheckpointNoAck {
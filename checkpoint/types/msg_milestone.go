@@ -89,6 +89,8 @@ var _ sdk.Msg = &MsgMilestoneTimeout{}
 
 type MsgMilestoneTimeout struct {
 	From types.IrisAddress `json:"from"`
+	// zena child chain of the milestone, empty for the chain of the chain params
+	ZenaChainID string `json:"zena_chain_id,omitempty"`
 }
 
 func NewMsgMilestoneTimeout(from types.IrisAddress) MsgMilestoneTimeout {
@@ -15,6 +15,15 @@ const (
 	DefaultAvgCheckpointLength  uint64        = 256
 	DefaultMaxCheckpointLength  uint64        = 1024
 	DefaultChildBlockInterval   uint64        = 10000
+
+	DefaultMilestoneLength          uint64        = 12
+	DefaultMilestoneBufferLength    uint64        = DefaultMilestoneLength * 5
+	DefaultMilestoneTxConfirmations uint64        = 16
+	DefaultMilestoneBufferTime      time.Duration = 256 * time.Second
+
+	DefaultAdaptiveCheckpointTargetInterval time.Duration = 10 * time.Minute
+	DefaultAdaptiveMinCheckpointLength      uint64        = 64
+	DefaultAdaptiveCheckpointBaseFee        uint64        = 50000000000 // 50 gwei
 )
 
 // Parameter keys
@@ -23,6 +32,13 @@ var (
 	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
 	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
 	KeyChildBlockInterval   = []byte("ChildBlockInterval")
+
+	KeyMilestoneLength          = []byte("MilestoneLength")
+	KeyMilestoneBufferLength    = []byte("MilestoneBufferLength")
+	KeyMilestoneTxConfirmations = []byte("MilestoneTxConfirmations")
+	KeyMilestoneBufferTime      = []byte("MilestoneBufferTime")
+
+	KeyAdaptiveCheckpoint = []byte("AdaptiveCheckpoint")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -33,6 +49,41 @@ type Params struct {
 	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
 	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
 	ChildBlockInterval   uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
+
+	MilestoneLength          uint64        `json:"milestone_length" yaml:"milestone_length"`                     // Minimum number of zena blocks of a milestone
+	MilestoneBufferLength    uint64        `json:"milestone_buffer_length" yaml:"milestone_buffer_length"`       // Zena blocks after the last milestone a milestone timeout is proposed at
+	MilestoneTxConfirmations uint64        `json:"milestone_tx_confirmations" yaml:"milestone_tx_confirmations"` // Zena blocks confirming the end block of a milestone
+	MilestoneBufferTime      time.Duration `json:"milestone_buffer_time" yaml:"milestone_buffer_time"`           // Time after the last milestone a milestone timeout is accepted
+
+	AdaptiveCheckpoint AdaptiveCheckpointParams `json:"adaptive_checkpoint" yaml:"adaptive_checkpoint"`
+}
+
+// AdaptiveCheckpointParams are the params of the adaptive checkpoint sizing. When enabled, checkpoints
+// are cut on the zena blocks produced over TargetInterval instead of AvgCheckpointLength, made larger
+// while the L1 base fee is above BaseFeeThreshold, within MinCheckpointLength and MaxCheckpointLength.
+type AdaptiveCheckpointParams struct {
+	Enabled             bool          `json:"enabled" yaml:"enabled"`
+	TargetInterval      time.Duration `json:"target_interval" yaml:"target_interval"`             // Time between two checkpoint submissions
+	MinCheckpointLength uint64        `json:"min_checkpoint_length" yaml:"min_checkpoint_length"` // Shorter checkpoints are only accepted 2 target intervals after the last one
+	BaseFeeThreshold    uint64        `json:"base_fee_threshold" yaml:"base_fee_threshold"`       // L1 base fee in wei, zero not to scale with the base fee
+}
+
+// DefaultAdaptiveCheckpointParams returns the default adaptive checkpoint sizing params, disabled
+func DefaultAdaptiveCheckpointParams() AdaptiveCheckpointParams {
+	return AdaptiveCheckpointParams{
+		TargetInterval:      DefaultAdaptiveCheckpointTargetInterval,
+		MinCheckpointLength: DefaultAdaptiveMinCheckpointLength,
+		BaseFeeThreshold:    DefaultAdaptiveCheckpointBaseFee,
+	}
+}
+
+// Validate checks that the adaptive checkpoint params have valid values when enabled
+func (p AdaptiveCheckpointParams) Validate() error {
+	if p.Enabled && (p.TargetInterval <= 0 || p.MinCheckpointLength == 0) {
+		return fmt.Errorf("AdaptiveCheckpoint TargetInterval and MinCheckpointLength should be greater than zero")
+	}
+
+	return nil
 }
 
 // NewParams creates a new Params object
@@ -41,18 +92,35 @@ func NewParams(
 	checkpointLength uint64,
 	maxCheckpointLength uint64,
 	childBlockInterval uint64,
+	milestoneLength uint64,
+	milestoneBufferLength uint64,
+	milestoneTxConfirmations uint64,
+	milestoneBufferTime time.Duration,
+	adaptiveCheckpoint AdaptiveCheckpointParams,
 ) Params {
 	return Params{
-		CheckpointBufferTime: checkpointBufferTime,
-		AvgCheckpointLength:  checkpointLength,
-		MaxCheckpointLength:  maxCheckpointLength,
-		ChildBlockInterval:   childBlockInterval,
+		CheckpointBufferTime:     checkpointBufferTime,
+		AvgCheckpointLength:      checkpointLength,
+		MaxCheckpointLength:      maxCheckpointLength,
+		ChildBlockInterval:       childBlockInterval,
+		MilestoneLength:          milestoneLength,
+		MilestoneBufferLength:    milestoneBufferLength,
+		MilestoneTxConfirmations: milestoneTxConfirmations,
+		MilestoneBufferTime:      milestoneBufferTime,
+		AdaptiveCheckpoint:       adaptiveCheckpoint,
 	}
 }
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().
+		RegisterParamSet(&Params{}).
+		RegisterValidator(KeyMilestoneLength, validateMilestoneLength).
+		RegisterValidator(KeyMilestoneBufferLength, validateMilestoneBufferLength).
+		RegisterValidator(KeyMilestoneTxConfirmations, validateMilestoneTxConfirmations).
+		RegisterValidator(KeyMilestoneBufferTime, validateMilestoneBufferTime).
+		RegisterValidator(KeyAdaptiveCheckpoint, validateAdaptiveCheckpoint).
+		RegisterParamSetValidator(&Params{}, validateParams)
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -64,6 +132,11 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyAvgCheckpointLength, &p.AvgCheckpointLength},
 		{KeyMaxCheckpointLength, &p.MaxCheckpointLength},
 		{KeyChildBlockInterval, &p.ChildBlockInterval},
+		{KeyMilestoneLength, &p.MilestoneLength},
+		{KeyMilestoneBufferLength, &p.MilestoneBufferLength},
+		{KeyMilestoneTxConfirmations, &p.MilestoneTxConfirmations},
+		{KeyMilestoneBufferTime, &p.MilestoneBufferTime},
+		{KeyAdaptiveCheckpoint, &p.AdaptiveCheckpoint},
 	}
 }
 
@@ -78,11 +151,38 @@ func (p Params) Equal(p2 Params) bool {
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		CheckpointBufferTime: DefaultCheckpointBufferTime,
-		AvgCheckpointLength:  DefaultAvgCheckpointLength,
-		MaxCheckpointLength:  DefaultMaxCheckpointLength,
-		ChildBlockInterval:   DefaultChildBlockInterval,
+		CheckpointBufferTime:     DefaultCheckpointBufferTime,
+		AvgCheckpointLength:      DefaultAvgCheckpointLength,
+		MaxCheckpointLength:      DefaultMaxCheckpointLength,
+		ChildBlockInterval:       DefaultChildBlockInterval,
+		MilestoneLength:          DefaultMilestoneLength,
+		MilestoneBufferLength:    DefaultMilestoneBufferLength,
+		MilestoneTxConfirmations: DefaultMilestoneTxConfirmations,
+		MilestoneBufferTime:      DefaultMilestoneBufferTime,
+		AdaptiveCheckpoint:       DefaultAdaptiveCheckpointParams(),
+	}
+}
+
+// WithMilestoneDefaults returns the params with the milestone params left zero, by genesis files
+// and chains from before they were added, set to their defaults
+func (p Params) WithMilestoneDefaults() Params {
+	if p.MilestoneLength == 0 {
+		p.MilestoneLength = DefaultMilestoneLength
+	}
+
+	if p.MilestoneBufferLength == 0 {
+		p.MilestoneBufferLength = DefaultMilestoneBufferLength
+	}
+
+	if p.MilestoneTxConfirmations == 0 {
+		p.MilestoneTxConfirmations = DefaultMilestoneTxConfirmations
 	}
+
+	if p.MilestoneBufferTime == 0 {
+		p.MilestoneBufferTime = DefaultMilestoneBufferTime
+	}
+
+	return p
 }
 
 // String implements the stringer interface.
@@ -94,6 +194,11 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
 	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
 	sb.WriteString(fmt.Sprintf("ChildBlockInterval: %d\n", p.ChildBlockInterval))
+	sb.WriteString(fmt.Sprintf("MilestoneLength: %d\n", p.MilestoneLength))
+	sb.WriteString(fmt.Sprintf("MilestoneBufferLength: %d\n", p.MilestoneBufferLength))
+	sb.WriteString(fmt.Sprintf("MilestoneTxConfirmations: %d\n", p.MilestoneTxConfirmations))
+	sb.WriteString(fmt.Sprintf("MilestoneBufferTime: %s\n", p.MilestoneBufferTime))
+	sb.WriteString(fmt.Sprintf("AdaptiveCheckpoint: %+v\n", p.AdaptiveCheckpoint))
 
 	return sb.String()
 }
@@ -112,9 +217,101 @@ func (p Params) Validate() error {
 		return fmt.Errorf("ChildBlockInterval should be greater than zero")
 	}
 
+	if p.MilestoneLength == 0 {
+		return fmt.Errorf("MilestoneLength should be greater than zero")
+	}
+
+	if p.MilestoneBufferLength < p.MilestoneLength {
+		return fmt.Errorf("MilestoneBufferLength should not be less than MilestoneLength")
+	}
+
+	if p.MilestoneBufferTime <= 0 {
+		return fmt.Errorf("MilestoneBufferTime should be greater than zero")
+	}
+
+	if err := p.AdaptiveCheckpoint.Validate(); err != nil {
+		return err
+	}
+
+	if adaptive := p.AdaptiveCheckpoint; adaptive.Enabled && adaptive.MinCheckpointLength > p.MaxCheckpointLength {
+		return fmt.Errorf("AdaptiveCheckpoint MinCheckpointLength should not be greater than MaxCheckpointLength")
+	}
+
 	return nil
 }
 
+func validateMilestoneLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid milestone length: %d", v)
+	}
+
+	return nil
+}
+
+func validateMilestoneBufferLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid milestone buffer length: %d", v)
+	}
+
+	return nil
+}
+
+func validateMilestoneTxConfirmations(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid milestone tx confirmations: %d", v)
+	}
+
+	return nil
+}
+
+func validateMilestoneBufferTime(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("invalid milestone buffer time: %s", v)
+	}
+
+	return nil
+}
+
+func validateAdaptiveCheckpoint(i interface{}) error {
+	v, ok := i.(AdaptiveCheckpointParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+// validateParams checks the params across their keys, e.g. MilestoneBufferLength against
+// MilestoneLength or the adaptive MinCheckpointLength against MaxCheckpointLength, once a param change proposal set them
+func validateParams(ps subspace.ParamSet) error {
+	p, ok := ps.(*Params)
+	if !ok {
+		return fmt.Errorf("invalid parameter set type: %T", ps)
+	}
+
+	return p.WithMilestoneDefaults().Validate()
+}
+
 type Count struct {
 	Count uint64 `json:"count" yaml:"count"`
 }
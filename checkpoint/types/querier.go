@@ -2,16 +2,20 @@ package types
 
 // query endpoints supported by the auth Querier
 const (
-	QueryParams           = "params"
-	QueryAckCount         = "ack-count"
-	QueryCheckpoint       = "checkpoint"
-	QueryCheckpointBuffer = "checkpoint-buffer"
-	QueryLastNoAck        = "last-no-ack"
-	QueryCheckpointList   = "checkpoint-list"
-	QueryNextCheckpoint   = "next-checkpoint"
-	QueryProposer         = "is-proposer"
-	QueryCurrentProposer  = "current-proposer"
-	StakingQuerierRoute   = "staking"
+	QueryParams             = "params"
+	QueryAckCount           = "ack-count"
+	QueryCheckpoint         = "checkpoint"
+	QueryCheckpointBuffer   = "checkpoint-buffer"
+	QueryLastNoAck          = "last-no-ack"
+	QueryCheckpointList     = "checkpoint-list"
+	QueryNextCheckpoint     = "next-checkpoint"
+	QueryCheckpointBlock    = "checkpoint-block"
+	QueryFinality           = "finality"
+	QueryFinalityRange      = "finality-range"
+	QueryAdaptiveCheckpoint = "adaptive-checkpoint"
+	QueryProposer           = "is-proposer"
+	QueryCurrentProposer    = "current-proposer"
+	StakingQuerierRoute     = "staking"
 )
 
 // QueryCheckpointParams defines the params for querying accounts.
@@ -24,6 +28,38 @@ func NewQueryCheckpointParams(number uint64) QueryCheckpointParams {
 	return QueryCheckpointParams{Number: number}
 }
 
+// QueryCheckpointBlockParams defines the params for querying the checkpoint of a zena block.
+type QueryCheckpointBlockParams struct {
+	BlockNumber uint64
+}
+
+// NewQueryCheckpointBlockParams creates a new instance of QueryCheckpointBlockParams.
+func NewQueryCheckpointBlockParams(blockNumber uint64) QueryCheckpointBlockParams {
+	return QueryCheckpointBlockParams{BlockNumber: blockNumber}
+}
+
+// QueryFinalityParams defines the params for querying the finality of a zena block, by number or hash.
+type QueryFinalityParams struct {
+	BlockNumber uint64
+	BlockHash   string
+}
+
+// NewQueryFinalityParams creates a new instance of QueryFinalityParams.
+func NewQueryFinalityParams(blockNumber uint64, blockHash string) QueryFinalityParams {
+	return QueryFinalityParams{BlockNumber: blockNumber, BlockHash: blockHash}
+}
+
+// QueryFinalityRangeParams defines the params for querying the finality of a range of zena blocks.
+type QueryFinalityRangeParams struct {
+	FromBlock uint64
+	ToBlock   uint64
+}
+
+// NewQueryFinalityRangeParams creates a new instance of QueryFinalityRangeParams.
+func NewQueryFinalityRangeParams(fromBlock uint64, toBlock uint64) QueryFinalityRangeParams {
+	return QueryFinalityRangeParams{FromBlock: fromBlock, ToBlock: toBlock}
+}
+
 // QueryZenaChainID defines the params for querying with zena chain id
 type QueryZenaChainID struct {
 	ZenaChainID string
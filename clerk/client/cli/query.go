@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/zenanetwork/iris/helper"
@@ -15,6 +18,7 @@ import (
 	"github.com/zenanetwork/iris/clerk/types"
 	clerkTypes "github.com/zenanetwork/iris/clerk/types"
 	hmClient "github.com/zenanetwork/iris/client"
+	hmRest "github.com/zenanetwork/iris/types/rest"
 )
 
 var logger = helper.Logger.With("module", "clerk/client/cli")
@@ -34,12 +38,48 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	queryCmds.AddCommand(
 		client.GetCommands(
 			GetStateRecord(cdc),
+			GetStateRecordProof(cdc),
+			GetQueryParams(cdc),
 		)...,
 	)
 
 	return queryCmds
 }
 
+// GetQueryParams implements the params query command.
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current clerk parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as clerk parameters.
+
+Example:
+$ %s query clerk params
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := jsoniter.ConfigFastest.Unmarshal(bz, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
 // GetStateRecord get state record
 func GetStateRecord(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -92,6 +132,51 @@ func GetStateRecord(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetStateRecordProof get state record with the proof of its state id
+func GetStateRecordProof(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-proof",
+		Short: "show state record with the IAVL proof of its state id, or the proof of its absence",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			recordID := viper.GetUint64(FlagRecordID)
+
+			// fetch state record with proof
+			proof, err := hmRest.QueryStoreProof(cliCtx, clerkTypes.StoreKey, clerkTypes.GetEventRecordKey(recordID))
+			if err != nil {
+				return err
+			}
+
+			recordProof, err := clerkTypes.NewRecordProof(recordID, proof)
+			if err != nil {
+				return err
+			}
+
+			// app hash is certified by the verifier when the node is not trusted
+			if err := recordProof.Verify(proof.AppHash); err != nil {
+				return err
+			}
+
+			out, err := cliCtx.Codec.MarshalJSONIndent(recordProof, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagRecordID, 0, "--id=<record ID here>")
+
+	if err := cmd.MarkFlagRequired(FlagRecordID); err != nil {
+		logger.Error("GetStateRecordProof | MarkFlagRequired | FlagRecordID", "Error", err)
+	}
+
+	return cmd
+}
+
 // GetStateRecord get state record
 func IsOldTx(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -17,6 +17,7 @@ import (
 	"github.com/zenanetwork/iris/helper"
 	"github.com/zenanetwork/iris/types"
 	hmTypes "github.com/zenanetwork/iris/types"
+	upgradeUtils "github.com/zenanetwork/iris/upgrade/client/utils"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -103,7 +104,12 @@ func CreateNewStateRecord(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("data should be hex string")
 			}
 
-			if util.GetBlockHeight(cliCtx) > helper.GetSpanOverrideHeight() && len(data) > helper.MaxStateSyncSize {
+			spanOverride, err := upgradeUtils.QueryPlan(cliCtx, helper.SpanOverrideUpgrade)
+			if err != nil {
+				return err
+			}
+
+			if util.GetBlockHeight(cliCtx) > spanOverride.Height && len(data) > helper.MaxStateSyncSize {
 				logger.Info(`Data is too large to process, Resetting to ""`, "id", recordIDStr)
 				data = hmTypes.HexToHexBytes("")
 			} else if len(data) > helper.LegacyMaxStateSyncSize {
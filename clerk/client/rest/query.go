@@ -69,10 +69,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/clerk/event-record/{recordId}",
 		recordHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/clerk/event-record/{recordId}/proof",
+		recordProofHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/clerk/isoldtx",
 		DepositTxStatusHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/clerk/params",
+		paramsHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 //swagger:parameters clerkEventById
@@ -122,6 +130,71 @@ func recordHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+//swagger:response clerkEventProofResponse
+type clerkEventProofResponse struct {
+	//in:body
+	Output clerkEventProof `json:"output"`
+}
+
+type clerkEventProof struct {
+	Height string            `json:"height"`
+	Result types.RecordProof `json:"result"`
+}
+
+//swagger:parameters clerkEventProof
+type clerkEventProofParams struct {
+
+	//ID of the state-sync record
+	//required:true
+	//in:path
+	Id int64 `json:"recordID"`
+
+	//Height of the clerk store, defaults to the latest height with a committed app hash
+	//in:query
+	Height int64 `json:"height"`
+}
+
+// swagger:route GET /clerk/event-record/{recordID}/proof clerk clerkEventProof
+// It returns the clerk event with the IAVL proof of its state ID, or the proof of its absence
+// responses:
+//
+//	200: clerkEventProofResponse
+//
+// recordProofHandlerFn returns record by record id with its proof
+func recordProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// record id
+		recordID, ok := rest.ParseUint64OrReturnBadRequest(w, vars["recordId"])
+		if !ok {
+			return
+		}
+
+		// get record and its proof from store
+		proof, err := hmRest.QueryStoreProof(cliCtx, types.StoreKey, types.GetEventRecordKey(recordID))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := types.NewRecordProof(recordID, proof)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(proof.Height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 //swagger:parameters clerkEventList
 type clerkEventListParams struct {
 
@@ -227,7 +300,7 @@ func recordListHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		// send internal server error if error occurred during the query
 		if err != nil {
 			logger.Error("Error while querying event record list", "error", err.Error())
-			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
@@ -286,13 +359,13 @@ func DepositTxStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		// get query params
 		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryRecordSequenceParams(txHash, logindex))
 		if err != nil {
-			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
 		seqNo, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRecordSequence), queryParams)
 		if err != nil {
-			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
@@ -313,6 +386,42 @@ func DepositTxStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 // Internal helpers
 //
 
+//swagger:response clerkParamsResponse
+type clerkParamsResponse struct {
+	//in:body
+	Output clerkParams `json:"output"`
+}
+
+type clerkParams struct {
+	Height string       `json:"height"`
+	Result types.Params `json:"result"`
+}
+
+// swagger:route GET /clerk/params clerk clerkParams
+// It returns the clerk parameters, with the limits of the records synced in chunks
+// responses:
+//
+//	200: clerkParamsResponse
+func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		hmRest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func recordQuery(cliCtx context.CLIContext, recordID uint64) ([]byte, error) {
 	// get query params
 	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryRecordParams(recordID))
@@ -14,6 +14,7 @@ import (
 	"github.com/zenanetwork/iris/helper"
 	"github.com/zenanetwork/iris/types"
 	"github.com/zenanetwork/iris/types/rest"
+	upgradeUtils "github.com/zenanetwork/iris/upgrade/client/utils"
 )
 
 // It represents New checkpoint msg.
@@ -122,7 +123,13 @@ func newEventRecordHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		// get ContractAddress
 		contractAddress := types.HexToIrisAddress(req.ContractAddress)
 
-		if util.GetBlockHeight(cliCtx) > helper.GetSpanOverrideHeight() && len(types.HexToHexBytes(req.Data)) > helper.MaxStateSyncSize {
+		spanOverride, err := upgradeUtils.QueryPlan(cliCtx, helper.SpanOverrideUpgrade)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if util.GetBlockHeight(cliCtx) > spanOverride.Height && len(types.HexToHexBytes(req.Data)) > helper.MaxStateSyncSize {
 			RestLogger.Info(`Data is too large to process, Resetting to ""`, "id", req.ID)
 			req.Data = ""
 		} else if len(types.HexToHexBytes(req.Data)) > helper.LegacyMaxStateSyncSize {
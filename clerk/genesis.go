@@ -8,6 +8,11 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	// genesis files from before the params existed leave them empty, for the defaults
+	if data.Params != (types.Params{}) {
+		keeper.SetParams(ctx, data.Params)
+	}
+
 	// add checkpoint headers
 	if len(data.EventRecords) != 0 {
 		for _, record := range data.EventRecords {
@@ -20,9 +25,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	for _, sequence := range data.RecordSequences {
 		keeper.SetRecordSequence(ctx, sequence)
 	}
+
+	// move legacy sequences to the log registry once it is active
+	if keeper.logRegistry.IsActive(ctx) {
+		keeper.MigrateRecordSequences(ctx)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
-	return types.NewGenesisState(keeper.GetAllEventRecords(ctx), keeper.GetRecordSequences(ctx))
+	return types.NewGenesisState(keeper.GetParams(ctx), keeper.GetAllEventRecords(ctx), keeper.GetRecordSequences(ctx))
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/zenanetwork/iris/app"
 	"github.com/zenanetwork/iris/clerk"
 	"github.com/zenanetwork/iris/clerk/types"
+	logregistryTypes "github.com/zenanetwork/iris/logregistry/types"
 	hmTypes "github.com/zenanetwork/iris/types"
 	"github.com/zenanetwork/iris/types/simulation"
 )
@@ -65,6 +66,13 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 
 	actualParams := clerk.ExportGenesis(ctx, app.ClerkKeeper)
 
-	require.Equal(t, len(recordSequences), len(actualParams.RecordSequences))
+	// the log registry is active from genesis, so the sequences are moved to it
+	require.Empty(t, actualParams.RecordSequences)
+
+	for _, sequence := range recordSequences {
+		blockNumber, logIndex, err := logregistryTypes.ParseSequence(sequence)
+		require.NoError(t, err)
+		require.True(t, app.LogRegistryKeeper.HasProcessedLog(ctx, blockNumber, logIndex))
+	}
 	require.Equal(t, len(eventRecords), len(actualParams.EventRecords))
 }
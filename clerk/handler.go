@@ -2,7 +2,6 @@ package clerk
 
 import (
 	"encoding/hex"
-	"math/big"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +20,8 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgEventRecord:
 			return handleMsgEventRecord(ctx, msg, k, contractCaller)
+		case types.MsgEventRecordChunk:
+			return handleMsgEventRecordChunk(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in clerk module").Result()
 		}
@@ -52,14 +53,9 @@ func handleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, _
 		return common.ErrInvalidZenaChainID(k.Codespace()).Result()
 	}
 
-	// sequence id
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx is older
-	if k.HasRecordSequence(ctx, sequence.String()) {
-		k.Logger(ctx).Error("Older invalid tx found", "Sequence", sequence.String())
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
+		k.Logger(ctx).Error("Older invalid tx found", "blockNumber", msg.BlockNumber, "logIndex", msg.LogIndex)
 		return common.ErrOldTx(k.Codespace()).Result()
 	}
 
@@ -79,3 +75,68 @@ func handleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, _
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+func handleMsgEventRecordChunk(ctx sdk.Context, msg types.MsgEventRecordChunk, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating clerk chunk msg",
+		"id", msg.ID,
+		"chunkIndex", msg.ChunkIndex,
+		"chunkCount", msg.ChunkCount,
+		"txHash", hmTypes.BytesToIrisHash(msg.TxHash.Bytes()),
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// check if event record exists
+	if exists := k.HasEventRecord(ctx, msg.ID); exists {
+		return types.ErrEventRecordAlreadySynced(k.Codespace()).Result()
+	}
+
+	// check if chunk exists
+	if k.HasRecordChunk(ctx, msg.ID, msg.ChunkIndex) {
+		return types.ErrChunkAlreadySynced(k.Codespace()).Result()
+	}
+
+	params := k.GetParams(ctx)
+	if params.MaxChunkedRecordSize == 0 {
+		k.Logger(ctx).Error("Chunked records are disabled")
+		return types.ErrEventRecordChunkInvalid(k.Codespace()).Result()
+	}
+
+	// chainManager params
+	chainParams := k.chainKeeper.GetParams(ctx).ChainParams
+
+	// check chain id
+	if chainParams.ZenaChainID != msg.ChainID {
+		k.Logger(ctx).Error("Invalid Zena chain id", "msgChainID", msg.ChainID, "zenaChainId", chainParams.ZenaChainID)
+		return common.ErrInvalidZenaChainID(k.Codespace()).Result()
+	}
+
+	// check if incoming tx is older
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
+		k.Logger(ctx).Error("Older invalid tx found", "blockNumber", msg.BlockNumber, "logIndex", msg.LogIndex)
+		return common.ErrOldTx(k.Codespace()).Result()
+	}
+
+	// limit the chunks of the block
+	if count := k.IncrementBlockChunkCount(ctx); count > params.MaxChunksPerBlock {
+		k.Logger(ctx).Debug("Max chunks per block exceeded", "count", count, "maxChunksPerBlock", params.MaxChunksPerBlock)
+		return types.ErrChunksPerBlockExceed(k.Codespace()).Result()
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecordChunk,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecordChunkIndex, strconv.FormatUint(msg.ChunkIndex, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecordContract, msg.ContractAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordTxHash, msg.TxHash.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordTxLogIndex, strconv.FormatUint(msg.LogIndex, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
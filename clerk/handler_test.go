@@ -1,7 +1,6 @@
 package clerk_test
 
 import (
-	"math/big"
 	"math/rand"
 	"testing"
 	"time"
@@ -141,11 +140,8 @@ func (suite *HandlerTestSuite) TestHandleMsgEventRecordSequence() {
 		chainID,
 	)
 
-	// sequence id
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-	app.ClerkKeeper.SetRecordSequence(ctx, sequence.String())
+	// processed log
+	app.ClerkKeeper.SetProcessedLog(ctx, msg.TxHash, msg.LogIndex, msg.BlockNumber)
 
 	result := suite.handler(ctx, msg)
 	require.False(t, result.IsOK(), "should fail due to existent sequence but succeeded")
@@ -1,6 +1,7 @@
 package clerk
 
 import (
+	"encoding/binary"
 	"errors"
 	"strconv"
 	"time"
@@ -11,12 +12,15 @@ import (
 
 	"github.com/zenanetwork/iris/chainmanager"
 	"github.com/zenanetwork/iris/clerk/types"
+	"github.com/zenanetwork/iris/logregistry"
+	logregistryTypes "github.com/zenanetwork/iris/logregistry/types"
 	"github.com/zenanetwork/iris/params/subspace"
 	hmTypes "github.com/zenanetwork/iris/types"
+	"github.com/zenanetwork/iris/upgrade"
 )
 
 var (
-	StateRecordPrefixKey = []byte{0x11} // prefix key for when storing state
+	StateRecordPrefixKey = types.StateRecordPrefixKey // prefix key for when storing state
 
 	// DefaultValue default value
 	DefaultValue = []byte{0x01}
@@ -25,6 +29,11 @@ var (
 	RecordSequencePrefixKey = []byte{0x12}
 
 	StateRecordPrefixKeyWithTime = []byte{0x13} // prefix key for when storing state with time
+
+	RecordChunkPrefixKey     = types.RecordChunkPrefixKey     // prefix key for when storing the chunks of a state
+	BlockChunkCountPrefixKey = types.BlockChunkCountPrefixKey // prefix key for the number of chunks accepted in the current block
+	RecordChunkSetPrefixKey  = types.RecordChunkSetPrefixKey  // prefix key for the chunk set of a state
+	ChunkSetHeightPrefixKey  = types.ChunkSetHeightPrefixKey  // prefix key for the chunk sets by height
 )
 
 // Keeper stores all related data
@@ -38,6 +47,10 @@ type Keeper struct {
 	paramSpace subspace.Subspace
 	// chain param keeper
 	chainKeeper chainmanager.Keeper
+	// upgrade keeper
+	upgradeKeeper upgrade.Keeper
+	// log registry keeper
+	logRegistry logregistry.Keeper
 }
 
 // NewKeeper create new keeper
@@ -47,13 +60,17 @@ func NewKeeper(
 	paramSpace subspace.Subspace,
 	codespace sdk.CodespaceType,
 	chainKeeper chainmanager.Keeper,
+	upgradeKeeper upgrade.Keeper,
+	logRegistry logregistry.Keeper,
 ) Keeper {
 	keeper := Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		paramSpace:  paramSpace,
-		codespace:   codespace,
-		chainKeeper: chainKeeper,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSpace:    paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:     codespace,
+		chainKeeper:   chainKeeper,
+		upgradeKeeper: upgradeKeeper,
+		logRegistry:   logRegistry,
 	}
 
 	return keeper
@@ -223,14 +240,149 @@ func (k *Keeper) GetEventRecordListWithTime(ctx sdk.Context, fromTime, toTime ti
 	return records, nil
 }
 
+// -----------------------------------------------------------------------------
+// Chunks
+
+// SetRecordChunk stores the data of a chunk of state, until the state is complete
+func (k *Keeper) SetRecordChunk(ctx sdk.Context, stateID uint64, chunkIndex uint64, data []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRecordChunkKey(stateID, chunkIndex), data)
+}
+
+// HasRecordChunk checks if the chunk of state is stored
+func (k *Keeper) HasRecordChunk(ctx sdk.Context, stateID uint64, chunkIndex uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetRecordChunkKey(stateID, chunkIndex))
+}
+
+// GetRecordChunks returns the data of the stored chunks of state, by chunk index
+func (k *Keeper) GetRecordChunks(ctx sdk.Context, stateID uint64) (chunks [][]byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetRecordChunksKey(stateID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		chunks = append(chunks, iterator.Value())
+	}
+
+	return
+}
+
+// DeleteRecordChunks removes the stored chunks and the chunk set of state, once the state is complete or pruned
+func (k *Keeper) DeleteRecordChunks(ctx sdk.Context, stateID uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetRecordChunksKey(stateID))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	if chunkSet, ok := k.GetRecordChunkSet(ctx, stateID); ok {
+		store.Delete(types.GetChunkSetHeightKey(chunkSet.Height, stateID))
+		store.Delete(types.GetRecordChunkSetKey(stateID))
+	}
+}
+
+// SetRecordChunkSet stores the chunk set of state, along with its first chunk
+func (k *Keeper) SetRecordChunkSet(ctx sdk.Context, stateID uint64, chunkSet types.RecordChunkSet) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRecordChunkSetKey(stateID), k.cdc.MustMarshalBinaryBare(chunkSet))
+	store.Set(types.GetChunkSetHeightKey(chunkSet.Height, stateID), DefaultValue)
+}
+
+// GetRecordChunkSet returns the chunk set of state, if a chunk of it is stored
+func (k *Keeper) GetRecordChunkSet(ctx sdk.Context, stateID uint64) (chunkSet types.RecordChunkSet, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetRecordChunkSetKey(stateID))
+	if bz == nil {
+		return chunkSet, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &chunkSet)
+
+	return chunkSet, true
+}
+
+// PruneRecordChunkSets removes the chunks of the incomplete states whose first chunk
+// was stored more than maxAge blocks ago, and returns their state ids
+func (k *Keeper) PruneRecordChunkSets(ctx sdk.Context, maxAge uint64) (stateIDs []uint64) {
+	height := ctx.BlockHeight() - int64(maxAge)
+	if height <= 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// chunk sets started before height
+	iterator := store.Iterator(ChunkSetHeightPrefixKey, types.GetChunkSetHeightPrefix(height))
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		stateIDs = append(stateIDs, binary.BigEndian.Uint64(key[len(key)-8:]))
+	}
+	iterator.Close()
+
+	for _, stateID := range stateIDs {
+		k.DeleteRecordChunks(ctx, stateID)
+	}
+
+	return stateIDs
+}
+
+// IncrementBlockChunkCount counts a chunk accepted in the current block, and returns the count of the block
+func (k *Keeper) IncrementBlockChunkCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	height := ctx.BlockHeight()
+	count := uint64(0)
+
+	// the count of a previous block is reset
+	if bz := store.Get(BlockChunkCountPrefixKey); bz != nil && int64(binary.BigEndian.Uint64(bz[:8])) == height {
+		count = binary.BigEndian.Uint64(bz[8:])
+	}
+
+	count++
+
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], uint64(height))
+	binary.BigEndian.PutUint64(bz[8:], count)
+	store.Set(BlockChunkCountPrefixKey, bz)
+
+	return count
+}
+
+// -----------------------------------------------------------------------------
+// Params
+
+// SetParams sets the clerk module's parameters.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the clerk module's parameters.
+func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	// params added after genesis are not stored until set by governance
+	params = types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+
+	return
+}
+
 //
 // GetEventRecordKey returns key for state record
 //
 
 // GetEventRecordKey appends prefix to state id
 func GetEventRecordKey(stateID uint64) []byte {
-	stateIDBytes := []byte(strconv.FormatUint(stateID, 10))
-	return append(StateRecordPrefixKey, stateIDBytes...)
+	return types.GetEventRecordKey(stateID)
 }
 
 // GetEventRecordKeyWithTime appends prefix to state id and record time
@@ -318,6 +470,37 @@ func (k *Keeper) HasRecordSequence(ctx sdk.Context, sequence string) bool {
 	return store.Has(GetRecordSequenceKey(sequence))
 }
 
+// HasProcessedLog checks if the clerk log at logIndex of the L1 block was already processed,
+// by any module once the log registry is active
+func (k *Keeper) HasProcessedLog(ctx sdk.Context, blockNumber uint64, logIndex uint64) bool {
+	if k.logRegistry.IsActive(ctx) {
+		return k.logRegistry.HasProcessedLog(ctx, blockNumber, logIndex)
+	}
+
+	return k.HasRecordSequence(ctx, logregistryTypes.GetSequence(blockNumber, logIndex))
+}
+
+// SetProcessedLog marks the clerk log at logIndex of the L1 block as processed
+func (k *Keeper) SetProcessedLog(ctx sdk.Context, txHash hmTypes.IrisHash, logIndex uint64, blockNumber uint64) {
+	if k.logRegistry.IsActive(ctx) {
+		k.logRegistry.SetProcessedLog(ctx, logregistryTypes.NewProcessedLog(types.ModuleName, txHash, logIndex, blockNumber))
+		return
+	}
+
+	k.SetRecordSequence(ctx, logregistryTypes.GetSequence(blockNumber, logIndex))
+}
+
+// MigrateRecordSequences moves the clerk sequences to the log registry
+func (k *Keeper) MigrateRecordSequences(ctx sdk.Context) {
+	sequences := k.GetRecordSequences(ctx)
+	k.logRegistry.MigrateSequences(ctx, types.ModuleName, sequences)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, sequence := range sequences {
+		store.Delete(GetRecordSequenceKey(sequence))
+	}
+}
+
 // IterateRecordsAndCollect iterates over EventRecords, collects up to 'max' entries,
 // and returns a slice containing the collected records.
 // It continues from the last key processed in the previous batch.
@@ -161,3 +161,29 @@ func (suite *KeeperTestSuite) TestSetHasGetRecordSequence() {
 	recordSequences := ck.GetRecordSequences(ctx)
 	require.Len(t, recordSequences, 1)
 }
+
+func (suite *KeeperTestSuite) TestPruneRecordChunkSets() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	ck := app.ClerkKeeper
+	maxAge := uint64(10)
+
+	// chunk sets started at heights 5 and 20
+	for stateID, height := range map[uint64]int64{1: 5, 2: 20} {
+		ck.SetRecordChunkSet(ctx, stateID, types.RecordChunkSet{ChunkSize: 1, ChunkCount: 2, Height: height})
+		ck.SetRecordChunk(ctx, stateID, 0, []byte{0x01})
+	}
+
+	require.Empty(t, ck.PruneRecordChunkSets(ctx.WithBlockHeight(15), maxAge))
+
+	require.Equal(t, []uint64{1}, ck.PruneRecordChunkSets(ctx.WithBlockHeight(16), maxAge))
+	require.False(t, ck.HasRecordChunk(ctx, 1, 0))
+
+	_, ok := ck.GetRecordChunkSet(ctx, 1)
+	require.False(t, ok)
+
+	require.True(t, ck.HasRecordChunk(ctx, 2, 0))
+
+	_, ok = ck.GetRecordChunkSet(ctx, 2)
+	require.True(t, ok)
+}
@@ -146,9 +146,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 // that will be returned via NextGenesisData.
 func (am AppModule) ExportPartialGenesis(ctx sdk.Context) (json.RawMessage, error) {
 	type partialGenesisState struct {
-		RecordSequences []string `json:"record_sequences" yaml:"record_sequences"`
+		Params          types.Params `json:"params" yaml:"params"`
+		RecordSequences []string     `json:"record_sequences" yaml:"record_sequences"`
 	}
 	return types.ModuleCdc.MustMarshalJSON(partialGenesisState{
+		Params:          am.keeper.GetParams(ctx),
 		RecordSequences: am.keeper.GetRecordSequences(ctx),
 	}), nil
 }
@@ -169,9 +171,13 @@ func (am AppModule) NextGenesisData(ctx sdk.Context, nextKey []byte, maxV int) (
 // BeginBlock returns the begin blocker for the auth module.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the auth module. It returns no validator
-// updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the auth module. It prunes the chunks of
+// the records left incomplete, and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if stateIDs := am.keeper.PruneRecordChunkSets(ctx, am.keeper.GetParams(ctx).MaxChunkSetAge); len(stateIDs) > 0 {
+		am.keeper.Logger(ctx).Info("Pruned incomplete chunked records", "ids", stateIDs)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
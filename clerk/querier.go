@@ -26,6 +26,8 @@ func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querie
 			return handleQueryRecordListWithTime(ctx, req, keeper)
 		case types.QueryRecordSequence:
 			return handleQueryRecordSequence(ctx, req, keeper, contractCaller)
+		case types.QueryParams:
+			return handleQueryParams(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -106,17 +108,25 @@ func handleQueryRecordSequence(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 		return nil, sdk.ErrInternal("Transaction is not confirmed yet. Please wait for sometime and try again")
 	}
 
-	// sequence id
+	// check if incoming tx already exists
+	if !keeper.HasProcessedLog(ctx, receipt.BlockNumber.Uint64(), params.LogIndex) {
+		return nil, nil
+	}
 
+	// sequence id
 	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
 	sequence.Add(sequence, new(big.Int).SetUint64(params.LogIndex))
 
-	// check if incoming tx already exists
-	if !keeper.HasRecordSequence(ctx, sequence.String()) {
-		return nil, nil
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, sequence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
 
-	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, sequence)
+	return bz, nil
+}
+
+func handleQueryParams(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := jsoniter.ConfigFastest.Marshal(keeper.GetParams(ctx))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -179,14 +179,11 @@ func (suite *QuerierTestSuite) TestHandleQueryRecordSequence() {
 	require.Nil(t, err)
 	require.Nil(t, resp)
 
-	testSeq := "1000010"
-	ck := app.ClerkKeeper
-
-	ck.SetRecordSequence(ctx, testSeq)
-
 	logIndex = uint64(10)
 	txHash = hmTypes.HexToIrisHash("12345")
 
+	app.ClerkKeeper.SetProcessedLog(ctx, txHash, logIndex, txreceipt.BlockNumber.Uint64())
+
 	suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
 
 	req = abci.RequestQuery{
@@ -2,7 +2,6 @@ package clerk
 
 import (
 	"bytes"
-	"math/big"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +22,8 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 		switch msg := msg.(type) {
 		case types.MsgEventRecord:
 			return SideHandleMsgEventRecord(ctx, k, msg, contractCaller)
+		case types.MsgEventRecordChunk:
+			return SideHandleMsgEventRecordChunk(ctx, k, msg, contractCaller)
 		default:
 			return abci.ResponseDeliverSideTx{
 				Code: uint32(sdk.CodeUnknownRequest),
@@ -38,6 +39,8 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 		switch msg := msg.(type) {
 		case types.MsgEventRecord:
 			return PostHandleMsgEventRecord(ctx, k, msg, sideTxResult)
+		case types.MsgEventRecordChunk:
+			return PostHandleMsgEventRecordChunk(ctx, k, msg, sideTxResult)
 		default:
 			return sdk.ErrUnknownRequest("Unknown msg type").Result()
 		}
@@ -89,8 +92,14 @@ func SideHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
 
+	// records synced in chunks can't be synced without data
+	if clerkParams := k.GetParams(ctx); clerkParams.IsChunked(uint64(len(eventLog.Data))) {
+		k.Logger(ctx).Error("Event data is synced in chunks", "dataSize", len(eventLog.Data))
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
 	if !bytes.Equal(eventLog.Data, msg.Data) {
-		if ctx.BlockHeight() > helper.GetSpanOverrideHeight() {
+		if k.upgradeKeeper.IsUpgradeActive(ctx, helper.SpanOverrideUpgrade) && !k.upgradeKeeper.IsUpgradeHeight(ctx, helper.SpanOverrideUpgrade) {
 			if !(len(eventLog.Data) > helper.MaxStateSyncSize && bytes.Equal(msg.Data, hmTypes.HexToHexBytes(""))) {
 				k.Logger(ctx).Error(
 					"Data from event does not match with Msg Data",
@@ -133,11 +142,6 @@ func PostHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 
 	k.Logger(ctx).Debug("Persisting clerk state", "sideTxResult", sideTxResult)
 
-	// sequence id
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// create event record
 	record := types.NewEventRecord(
 		msg.TxHash,
@@ -156,7 +160,7 @@ func PostHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 	}
 
 	// save record sequence
-	k.SetRecordSequence(ctx, sequence.String())
+	k.SetProcessedLog(ctx, msg.TxHash, msg.LogIndex, msg.BlockNumber)
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -180,3 +184,182 @@ func PostHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+func SideHandleMsgEventRecordChunk(ctx sdk.Context, k Keeper, msg types.MsgEventRecordChunk, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for clerk chunk msg",
+		"txHash", hmTypes.BytesToIrisHash(msg.TxHash.Bytes()),
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+		"chunkIndex", msg.ChunkIndex,
+	)
+
+	// chainManager params
+	params := k.chainKeeper.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	if receipt == nil || err != nil {
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+	}
+
+	// get event log for state sync
+	eventLog, err := contractCaller.DecodeStateSyncedEvent(chainParams.StateSenderAddress.EthAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	// check if message and event log matches
+	if eventLog.Id.Uint64() != msg.ID {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "stateIdFromTx", eventLog.Id)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if !bytes.Equal(eventLog.ContractAddress.Bytes(), msg.ContractAddress.Bytes()) {
+		k.Logger(ctx).Error(
+			"ContractAddress from event does not match with Msg ContractAddress",
+			"EventContractAddress", eventLog.ContractAddress.String(),
+			"MsgContractAddress", msg.ContractAddress.String(),
+		)
+
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	// the data of the event is synced in chunks, and the chunk is the part of it at the chunk index
+	dataSize := uint64(len(eventLog.Data))
+
+	// the chunks stored already fix the chunk size of the record
+	chunkSet, ok := k.GetRecordChunkSet(ctx, msg.ID)
+	if !ok {
+		clerkParams := k.GetParams(ctx)
+		if !clerkParams.IsChunked(dataSize) {
+			k.Logger(ctx).Error("Event data is not synced in chunks", "dataSize", dataSize)
+			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+		}
+
+		chunkSet = types.RecordChunkSet{ChunkSize: clerkParams.ChunkSize, ChunkCount: clerkParams.ChunkCount(dataSize)}
+	}
+
+	if chunkSet.ChunkCount != msg.ChunkCount || chunkSet.ChunkCount != (dataSize+chunkSet.ChunkSize-1)/chunkSet.ChunkSize {
+		k.Logger(ctx).Error("Event data is not synced in chunks of the msg", "dataSize", dataSize, "chunkCount", msg.ChunkCount)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	start, end := chunkSet.ChunkRange(msg.ChunkIndex, dataSize)
+	if !bytes.Equal(eventLog.Data[start:end], msg.Data) {
+		k.Logger(ctx).Error(
+			"Chunk of data from event does not match with Msg Data",
+			"chunkIndex", msg.ChunkIndex,
+			"MsgData", hmTypes.BytesToHexBytes(msg.Data),
+		)
+
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	result.Result = abci.SideTxResultType_Yes
+
+	return
+}
+
+func PostHandleMsgEventRecordChunk(ctx sdk.Context, k Keeper, msg types.MsgEventRecordChunk, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if clerk is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping new clerk chunk since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// check for replay
+	if k.HasEventRecord(ctx, msg.ID) || k.HasRecordChunk(ctx, msg.ID, msg.ChunkIndex) {
+		k.Logger(ctx).Debug("Skipping new clerk chunk as it's already processed")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	// the first chunk fixes the chunk size the record is assembled with
+	chunkSet, ok := k.GetRecordChunkSet(ctx, msg.ID)
+	if !ok {
+		chunkSet = types.RecordChunkSet{
+			ChunkSize:  k.GetParams(ctx).ChunkSize,
+			ChunkCount: msg.ChunkCount,
+			Height:     ctx.BlockHeight(),
+		}
+	}
+
+	// a chunk size changed since the side-tx was voted on doesn't fit the chunk
+	if msg.ChunkCount != chunkSet.ChunkCount ||
+		uint64(len(msg.Data)) > chunkSet.ChunkSize ||
+		(msg.ChunkIndex < chunkSet.ChunkCount-1 && uint64(len(msg.Data)) != chunkSet.ChunkSize) {
+		k.Logger(ctx).Error("Clerk chunk doesn't fit the chunk set", "id", msg.ID, "chunkIndex", msg.ChunkIndex, "chunkSize", chunkSet.ChunkSize, "chunkCount", chunkSet.ChunkCount)
+		return types.ErrEventRecordChunkInvalid(k.Codespace()).Result()
+	}
+
+	k.Logger(ctx).Debug("Persisting clerk chunk", "id", msg.ID, "chunkIndex", msg.ChunkIndex, "sideTxResult", sideTxResult)
+
+	if !ok {
+		k.SetRecordChunkSet(ctx, msg.ID, chunkSet)
+	}
+
+	k.SetRecordChunk(ctx, msg.ID, msg.ChunkIndex, msg.Data)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecordChunk,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                              // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),            // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToIrisHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(types.AttributeKeyRecordTxLogIndex, strconv.FormatUint(msg.LogIndex, 10)),
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()), // result
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecordChunkIndex, strconv.FormatUint(msg.ChunkIndex, 10)),
+		),
+	}
+
+	// assemble the record once every chunk of it is stored
+	chunks := k.GetRecordChunks(ctx, msg.ID)
+	if uint64(len(chunks)) == chunkSet.ChunkCount {
+		record := types.NewEventRecord(
+			msg.TxHash,
+			msg.LogIndex,
+			msg.ID,
+			msg.ContractAddress,
+			bytes.Join(chunks, nil),
+			msg.ChainID,
+			ctx.BlockTime(),
+		)
+
+		// save event into state
+		if err := k.SetEventRecord(ctx, record); err != nil {
+			k.Logger(ctx).Error("Unable to update event record", "id", msg.ID, "error", err)
+			return types.ErrEventUpdate(k.Codespace()).Result()
+		}
+
+		k.DeleteRecordChunks(ctx, msg.ID)
+
+		// save record sequence
+		k.SetProcessedLog(ctx, msg.TxHash, msg.LogIndex, msg.BlockNumber)
+
+		k.Logger(ctx).Debug("Assembled clerk record from chunks", "id", msg.ID, "chunkCount", chunkSet.ChunkCount, "chunkSize", chunkSet.ChunkSize, "dataSize", len(record.Data))
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeRecord,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecordContract, msg.ContractAddress.String()),
+		))
+	}
+
+	ctx.EventManager().EmitEvents(events)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -224,6 +224,16 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgEventRecord() {
 		storedEventRecord, err := app.ClerkKeeper.GetEventRecord(ctx, id)
 		require.Nil(t, storedEventRecord)
 		require.Error(t, err)
+
+		// a record synced in chunks isn't synced without data
+		params := types.DefaultParams()
+		params.MaxChunkedRecordSize = uint64(len(b))
+		app.ClerkKeeper.SetParams(ctx, params)
+		defer app.ClerkKeeper.SetParams(ctx, types.DefaultParams())
+
+		result = suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
 	})
 }
 
@@ -274,14 +284,9 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgEventRecord() {
 		require.True(t, result.IsOK(), "Post handler should succeed")
 		require.Greater(t, len(result.Events), 0, "Events should be emitted for successful post-tx")
 
-		// sequence id
-		blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-		sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-		sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
-		// check sequence
-		hasSequence := app.ClerkKeeper.HasRecordSequence(ctx, sequence.String())
-		require.True(t, hasSequence, "Sequence should be stored correctly")
+		// check processed log
+		hasProcessedLog := app.ClerkKeeper.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex)
+		require.True(t, hasProcessedLog, "Processed log should be stored correctly")
 
 		// there should be no stored event record
 		storedEventRecord, err := app.ClerkKeeper.GetEventRecord(ctx, id)
@@ -315,3 +320,200 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgEventRecord() {
 		require.Equal(t, common.CodeOldTx, result.Code)
 	})
 }
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgEventRecordChunk() {
+	t, app, ctx, r := suite.T(), suite.app, suite.ctx, suite.r
+	chainParams := app.ChainKeeper.GetParams(suite.ctx)
+
+	params := types.DefaultParams()
+	params.MaxChunkedRecordSize = 3 * params.ChunkSize
+	app.ClerkKeeper.SetParams(ctx, params)
+
+	_, _, addr1 := sdkAuth.KeyTestPubAddr()
+
+	id := r.Uint64()
+	logIndex := uint64(10)
+	blockNumber := uint64(599)
+	txReceipt := &ethTypes.Receipt{
+		BlockNumber: new(big.Int).SetUint64(blockNumber),
+	}
+	txHash := hmTypes.HexToIrisHash("chunk hash")
+
+	data := make([]byte, 2*params.ChunkSize+1)
+	r.Read(data)
+
+	event := &statesender.StatesenderStateSynced{
+		Id:              new(big.Int).SetUint64(id),
+		ContractAddress: hmTypes.BytesToIrisAddress(addr1.Bytes()).EthAddress(),
+		Data:            data,
+	}
+
+	newChunkMsg := func(chunkData []byte, chunkIndex uint64, chunkCount uint64) types.MsgEventRecordChunk {
+		return types.NewMsgEventRecordChunk(
+			hmTypes.BytesToIrisAddress(addr1.Bytes()),
+			txHash,
+			logIndex,
+			blockNumber,
+			id,
+			hmTypes.BytesToIrisAddress(addr1.Bytes()),
+			chunkData,
+			suite.chainID,
+			chunkIndex,
+			chunkCount,
+		)
+	}
+
+	mockCalls := func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeStateSyncedEvent", chainParams.ChainParams.StateSenderAddress.EthAddress(), txReceipt, logIndex).Return(event, nil)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockCalls()
+
+		result := suite.sideHandler(ctx, newChunkMsg(data[params.ChunkSize:2*params.ChunkSize], 1, 3))
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+
+		// the last chunk is shorter
+		result = suite.sideHandler(ctx, newChunkMsg(data[2*params.ChunkSize:], 2, 3))
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	t.Run("DataMismatch", func(t *testing.T) {
+		mockCalls()
+
+		result := suite.sideHandler(ctx, newChunkMsg(data[:params.ChunkSize], 1, 3))
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+	})
+
+	t.Run("ChunkCountMismatch", func(t *testing.T) {
+		mockCalls()
+
+		result := suite.sideHandler(ctx, newChunkMsg(data[:params.ChunkSize], 0, 4))
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		mockCalls()
+		app.ClerkKeeper.SetParams(ctx, types.DefaultParams())
+
+		result := suite.sideHandler(ctx, newChunkMsg(data[:params.ChunkSize], 0, 3))
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgEventRecordChunk() {
+	t, app, ctx, r := suite.T(), suite.app, suite.ctx, suite.r
+
+	_, _, addr1 := sdkAuth.KeyTestPubAddr()
+
+	id := r.Uint64()
+	logIndex := r.Uint64()
+	blockNumber := r.Uint64()
+	txHash := hmTypes.HexToIrisHash("chunk hash")
+
+	data := make([]byte, 2*helper.MaxStateSyncSize+1)
+	r.Read(data)
+
+	chunks := [][]byte{
+		data[:helper.MaxStateSyncSize],
+		data[helper.MaxStateSyncSize : 2*helper.MaxStateSyncSize],
+		data[2*helper.MaxStateSyncSize:],
+	}
+
+	newChunkMsg := func(chunkIndex uint64) types.MsgEventRecordChunk {
+		return types.NewMsgEventRecordChunk(
+			hmTypes.BytesToIrisAddress(addr1.Bytes()),
+			txHash,
+			logIndex,
+			blockNumber,
+			id,
+			hmTypes.BytesToIrisAddress(addr1.Bytes()),
+			chunks[chunkIndex],
+			suite.chainID,
+			chunkIndex,
+			uint64(len(chunks)),
+		)
+	}
+
+	t.Run("NoResult", func(t *testing.T) {
+		result := suite.postHandler(ctx, newChunkMsg(0), abci.SideTxResultType_No)
+		require.False(t, result.IsOK(), "Post handler should fail")
+		require.Equal(t, common.CodeSideTxValidationFailed, result.Code)
+		require.False(t, app.ClerkKeeper.HasRecordChunk(ctx, id, 0))
+	})
+
+	t.Run("Assemble", func(t *testing.T) {
+		// chunks are stored in any order
+		for _, chunkIndex := range []uint64{2, 0} {
+			result := suite.postHandler(ctx, newChunkMsg(chunkIndex), abci.SideTxResultType_Yes)
+			require.True(t, result.IsOK(), "Post handler should succeed")
+			require.True(t, app.ClerkKeeper.HasRecordChunk(ctx, id, chunkIndex))
+			require.False(t, app.ClerkKeeper.HasEventRecord(ctx, id))
+		}
+
+		// replayed chunk
+		result := suite.postHandler(ctx, newChunkMsg(0), abci.SideTxResultType_Yes)
+		require.Equal(t, common.CodeOldTx, result.Code)
+
+		result = suite.postHandler(ctx, newChunkMsg(1), abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "Post handler should succeed")
+
+		storedEventRecord, err := app.ClerkKeeper.GetEventRecord(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, data, storedEventRecord.Data.Bytes())
+		require.Empty(t, app.ClerkKeeper.GetRecordChunks(ctx, id))
+
+		_, ok := app.ClerkKeeper.GetRecordChunkSet(ctx, id)
+		require.False(t, ok)
+
+		require.True(t, app.ClerkKeeper.HasProcessedLog(ctx, blockNumber, logIndex))
+	})
+
+	t.Run("ChunkSizeChanged", func(t *testing.T) {
+		id := id + 1
+
+		msg := newChunkMsg(0)
+		msg.ID = id
+
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "Post handler should succeed")
+
+		// the chunk size of the first chunk is kept for the record
+		params := types.DefaultParams()
+		params.ChunkSize = helper.MaxStateSyncSize / 2
+		app.ClerkKeeper.SetParams(ctx, params)
+		defer app.ClerkKeeper.SetParams(ctx, types.DefaultParams())
+
+		chunkSet, ok := app.ClerkKeeper.GetRecordChunkSet(ctx, id)
+		require.True(t, ok)
+		require.Equal(t, types.DefaultChunkSize, chunkSet.ChunkSize)
+		require.Equal(t, uint64(len(chunks)), chunkSet.ChunkCount)
+
+		// a chunk of the new size doesn't fit the record
+		msg = newChunkMsg(1)
+		msg.ID = id
+		msg.Data = chunks[1][:params.ChunkSize]
+
+		result = suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.Equal(t, types.CodeEventRecordChunkInvalid, result.Code)
+
+		for _, chunkIndex := range []uint64{1, 2} {
+			msg = newChunkMsg(chunkIndex)
+			msg.ID = id
+
+			result = suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+			require.True(t, result.IsOK(), "Post handler should succeed")
+		}
+
+		storedEventRecord, err := app.ClerkKeeper.GetEventRecord(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, data, storedEventRecord.Data.Bytes())
+	})
+}
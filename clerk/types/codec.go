@@ -7,6 +7,7 @@ import (
 // RegisterCodec registers concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgEventRecord{}, "cosmos-sdk/MsgEventRecord", nil)
+	cdc.RegisterConcrete(MsgEventRecordChunk{}, "cosmos-sdk/MsgEventRecordChunk", nil)
 }
 
 // ModuleCdc module cdc
@@ -10,6 +10,9 @@ const (
 	CodeEventRecordInvalid       sdk.CodeType = 5401
 	CodeEventRecordUpdate        sdk.CodeType = 5402
 	CodeSizeExceed               sdk.CodeType = 5403
+	CodeEventRecordChunkInvalid  sdk.CodeType = 5404
+	CodeChunkAlreadySynced       sdk.CodeType = 5405
+	CodeChunksPerBlockExceed     sdk.CodeType = 5406
 )
 
 // ErrEventRecordAlreadySynced represents event sync error
@@ -31,3 +34,18 @@ func ErrEventRecordInvalid(codespace sdk.CodespaceType) sdk.Error {
 func ErrEventUpdate(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEventRecordUpdate, "Event record update error")
 }
+
+// ErrEventRecordChunkInvalid represents event chunk error
+func ErrEventRecordChunkInvalid(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEventRecordChunkInvalid, "Event record chunk is invalid")
+}
+
+// ErrChunkAlreadySynced represents event chunk sync error
+func ErrChunkAlreadySynced(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeChunkAlreadySynced, "Event record chunk already synced")
+}
+
+// ErrChunksPerBlockExceed represents the error of a chunk over the max chunks of the block
+func ErrChunksPerBlockExceed(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeChunksPerBlockExceed, "Max event record chunks per block exceeded")
+}
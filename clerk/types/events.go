@@ -1,13 +1,15 @@
 package types
 
 var (
-	EventTypeRecord = "record"
+	EventTypeRecord      = "record"
+	EventTypeRecordChunk = "record-chunk"
 
 	AttributeKeyRecordTxHash     = "record-tx-hash"
 	AttributeKeyRecordTxLogIndex = "record-tx-log-index"
 	AttributeKeyRecordID         = "record-id"
 	AttributeKeyRecordContract   = "record-contract"
 	AttributeKeyCreatedAt        = "created-at"
+	AttributeKeyRecordChunkIndex = "record-chunk-index"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -23,3 +26,58 @@ const (
 	// DefaultCodespace default code space
 	DefaultCodespace sdk.CodespaceType = ModuleName
 )
+
+var (
+	StateRecordPrefixKey = []byte{0x11} // prefix key for when storing state
+
+	RecordChunkPrefixKey     = []byte{0x14} // prefix key for when storing the chunks of a state until it is complete
+	BlockChunkCountPrefixKey = []byte{0x15} // prefix key for the number of chunks accepted in the current block
+	RecordChunkSetPrefixKey  = []byte{0x16} // prefix key for the chunk size and count of a state being synced in chunks
+	ChunkSetHeightPrefixKey  = []byte{0x17} // prefix key for the chunk sets by the height of their first chunk
+)
+
+// GetEventRecordKey appends prefix to state id
+func GetEventRecordKey(stateID uint64) []byte {
+	stateIDBytes := []byte(strconv.FormatUint(stateID, 10))
+	return append(StateRecordPrefixKey, stateIDBytes...)
+}
+
+// GetRecordChunksKey appends prefix to state id, for the chunks of the state
+func GetRecordChunksKey(stateID uint64) []byte {
+	stateIDBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(stateIDBytes, stateID)
+
+	return append(append([]byte{}, RecordChunkPrefixKey...), stateIDBytes...)
+}
+
+// GetRecordChunkKey appends prefix to state id and chunk index
+func GetRecordChunkKey(stateID uint64, chunkIndex uint64) []byte {
+	chunkIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(chunkIndexBytes, chunkIndex)
+
+	return append(GetRecordChunksKey(stateID), chunkIndexBytes...)
+}
+
+// GetRecordChunkSetKey appends prefix to state id, for the chunk set of the state
+func GetRecordChunkSetKey(stateID uint64) []byte {
+	stateIDBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(stateIDBytes, stateID)
+
+	return append(append([]byte{}, RecordChunkSetPrefixKey...), stateIDBytes...)
+}
+
+// GetChunkSetHeightPrefix appends prefix to height, for the chunk sets started at or before it
+func GetChunkSetHeightPrefix(height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+
+	return append(append([]byte{}, ChunkSetHeightPrefixKey...), heightBytes...)
+}
+
+// GetChunkSetHeightKey appends prefix to height and state id
+func GetChunkSetHeightKey(height int64, stateID uint64) []byte {
+	stateIDBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(stateIDBytes, stateID)
+
+	return append(GetChunkSetHeightPrefix(height), stateIDBytes...)
+}
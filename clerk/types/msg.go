@@ -95,3 +95,99 @@ func (msg MsgEventRecord) GetLogIndex() uint64 {
 func (msg MsgEventRecord) GetSideSignBytes() []byte {
 	return nil
 }
+
+// MsgEventRecordChunk - chunk of a state msg whose data does not fit in a msg.
+// The record is stored once every chunk of it is voted on.
+type MsgEventRecordChunk struct {
+	From            types.IrisAddress `json:"from"`
+	TxHash          types.IrisHash    `json:"tx_hash"`
+	LogIndex        uint64            `json:"log_index"`
+	BlockNumber     uint64            `json:"block_number"`
+	ContractAddress types.IrisAddress `json:"contract_address"`
+	Data            types.HexBytes    `json:"data"`
+	ID              uint64            `json:"id"`
+	ChainID         string            `json:"zena_chain_id"`
+	ChunkIndex      uint64            `json:"chunk_index"`
+	ChunkCount      uint64            `json:"chunk_count"`
+}
+
+var _ sdk.Msg = MsgEventRecordChunk{}
+
+// NewMsgEventRecordChunk - construct state chunk msg
+func NewMsgEventRecordChunk(
+	from types.IrisAddress,
+	txHash types.IrisHash,
+	logIndex uint64,
+	blockNumber uint64,
+	id uint64,
+	contractAddress types.IrisAddress,
+	data types.HexBytes,
+	chainID string,
+	chunkIndex uint64,
+	chunkCount uint64,
+) MsgEventRecordChunk {
+	return MsgEventRecordChunk{
+		From:            from,
+		TxHash:          txHash,
+		LogIndex:        logIndex,
+		BlockNumber:     blockNumber,
+		ID:              id,
+		ContractAddress: contractAddress,
+		Data:            data,
+		ChainID:         chainID,
+		ChunkIndex:      chunkIndex,
+		ChunkCount:      chunkCount,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgEventRecordChunk) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgEventRecordChunk) Type() string { return "event-record-chunk" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgEventRecordChunk) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+
+	if msg.TxHash.Empty() {
+		return sdk.ErrInvalidAddress("missing tx hash")
+	}
+
+	if msg.ChunkCount < 2 || msg.ChunkIndex >= msg.ChunkCount {
+		return ErrEventRecordChunkInvalid(DefaultCodespace)
+	}
+
+	if len(msg.Data) == 0 || len(msg.Data) > helper.MaxStateSyncSize {
+		return ErrSizeExceed(sdk.CodespaceType(fmt.Sprintf("length should be between 1 and %d bytes", helper.MaxStateSyncSize)))
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgEventRecordChunk) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgEventRecordChunk) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.IrisAddressToAccAddress(msg.From)}
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgEventRecordChunk) GetTxHash() types.IrisHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgEventRecordChunk) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgEventRecordChunk) GetSideSignBytes() []byte {
+	return nil
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/zenanetwork/iris/helper"
+	"github.com/zenanetwork/iris/params/subspace"
+)
+
+// Default parameter values
+const (
+	// DefaultMaxChunkedRecordSize disables chunked records, records larger than a msg keep being synced without data
+	DefaultMaxChunkedRecordSize uint64 = 0
+	DefaultChunkSize            uint64 = helper.MaxStateSyncSize
+	DefaultMaxChunksPerBlock    uint64 = 10
+	DefaultMaxChunkSetAge       uint64 = 1000
+)
+
+// Parameter keys
+var (
+	KeyMaxChunkedRecordSize = []byte("MaxChunkedRecordSize")
+	KeyChunkSize            = []byte("ChunkSize")
+	KeyMaxChunksPerBlock    = []byte("MaxChunksPerBlock")
+	KeyMaxChunkSetAge       = []byte("MaxChunkSetAge")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the clerk module.
+type Params struct {
+	MaxChunkedRecordSize uint64 `json:"max_chunked_record_size" yaml:"max_chunked_record_size"` // max data size of a record synced in chunks, 0 to disable chunked records
+	ChunkSize            uint64 `json:"chunk_size" yaml:"chunk_size"`                           // data size of a chunk, the last one of a record being shorter
+	MaxChunksPerBlock    uint64 `json:"max_chunks_per_block" yaml:"max_chunks_per_block"`       // max number of chunks accepted per block
+	MaxChunkSetAge       uint64 `json:"max_chunk_set_age" yaml:"max_chunk_set_age"`             // number of blocks the chunks of an incomplete record are kept before being pruned
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of clerk module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeyMaxChunkedRecordSize, &p.MaxChunkedRecordSize},
+		{KeyChunkSize, &p.ChunkSize},
+		{KeyMaxChunksPerBlock, &p.MaxChunksPerBlock},
+		{KeyMaxChunkSetAge, &p.MaxChunkSetAge},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+
+	return bytes.Equal(bz1, bz2)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("MaxChunkedRecordSize: %d\n", p.MaxChunkedRecordSize))
+	sb.WriteString(fmt.Sprintf("ChunkSize: %d\n", p.ChunkSize))
+	sb.WriteString(fmt.Sprintf("MaxChunksPerBlock: %d\n", p.MaxChunksPerBlock))
+	sb.WriteString(fmt.Sprintf("MaxChunkSetAge: %d\n", p.MaxChunkSetAge))
+
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.ChunkSize == 0 || p.ChunkSize > helper.MaxStateSyncSize {
+		return fmt.Errorf("invalid chunk size: %d, it should be between 1 and %d", p.ChunkSize, helper.MaxStateSyncSize)
+	}
+
+	if p.MaxChunkedRecordSize != 0 && p.MaxChunksPerBlock == 0 {
+		return fmt.Errorf("invalid max chunks per block: %d", p.MaxChunksPerBlock)
+	}
+
+	if p.MaxChunkedRecordSize != 0 && p.MaxChunkSetAge == 0 {
+		return fmt.Errorf("invalid max chunk set age: %d", p.MaxChunkSetAge)
+	}
+
+	return nil
+}
+
+// ChunkCount returns the number of chunks of a record with dataSize bytes of data
+func (p Params) ChunkCount(dataSize uint64) uint64 {
+	return (dataSize + p.ChunkSize - 1) / p.ChunkSize
+}
+
+// IsChunked checks if a record with dataSize bytes of data is synced in chunks
+func (p Params) IsChunked(dataSize uint64) bool {
+	return dataSize > helper.MaxStateSyncSize && dataSize <= p.MaxChunkedRecordSize
+}
+
+//
+// Extra functions
+//
+
+// ParamKeyTable for clerk module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxChunkedRecordSize: DefaultMaxChunkedRecordSize,
+		ChunkSize:            DefaultChunkSize,
+		MaxChunksPerBlock:    DefaultMaxChunksPerBlock,
+		MaxChunkSetAge:       DefaultMaxChunkSetAge,
+	}
+}
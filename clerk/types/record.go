@@ -52,3 +52,22 @@ func (s *EventRecord) String() string {
 		s.RecordTime,
 	)
 }
+
+// RecordChunkSet represents the chunks of a state record being synced
+type RecordChunkSet struct {
+	ChunkSize  uint64 `json:"chunk_size" yaml:"chunk_size"`   // chunk size in force when the first chunk was stored
+	ChunkCount uint64 `json:"chunk_count" yaml:"chunk_count"` // number of chunks of the record
+	Height     int64  `json:"height" yaml:"height"`           // iris height of the first chunk
+}
+
+// ChunkRange returns the range of the record data of the chunk at chunkIndex
+func (s RecordChunkSet) ChunkRange(chunkIndex uint64, dataSize uint64) (start uint64, end uint64) {
+	start = chunkIndex * s.ChunkSize
+	end = start + s.ChunkSize
+
+	if end > dataSize {
+		end = dataSize
+	}
+
+	return
+}
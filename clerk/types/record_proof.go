@@ -0,0 +1,58 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	hmRest "github.com/zenanetwork/iris/types/rest"
+)
+
+// RecordProof is a state-sync record with the IAVL proof of its state ID in the clerk store.
+// Record is nil when the proof is an absence proof.
+type RecordProof struct {
+	StateID uint64            `json:"state_id"`
+	Record  *EventRecord      `json:"record"`
+	Proof   hmRest.StoreProof `json:"proof"`
+}
+
+// NewRecordProof decodes the record proven by proof
+func NewRecordProof(stateID uint64, proof hmRest.StoreProof) (RecordProof, error) {
+	recordProof := RecordProof{
+		StateID: stateID,
+		Proof:   proof,
+	}
+
+	if len(proof.Value) != 0 {
+		recordProof.Record = new(EventRecord)
+		if err := ModuleCdc.UnmarshalBinaryBare(proof.Value, recordProof.Record); err != nil {
+			return RecordProof{}, err
+		}
+	}
+
+	return recordProof, nil
+}
+
+// Verify checks that Record is committed under StateID in the clerk store, or that StateID
+// is absent if Record is nil, against appHash of a trusted header at Proof.Height+1.
+func (p RecordProof) Verify(appHash []byte) error {
+	if p.Proof.Store != StoreKey || !bytes.Equal(p.Proof.Key, GetEventRecordKey(p.StateID)) {
+		return fmt.Errorf("proof is not for state id %v", p.StateID)
+	}
+
+	if p.Record == nil {
+		if len(p.Proof.Value) != 0 {
+			return fmt.Errorf("missing record of state id %v", p.StateID)
+		}
+	} else {
+		value, err := ModuleCdc.MarshalBinaryBare(p.Record)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(value, p.Proof.Value) {
+			return fmt.Errorf("record does not match the proven value of state id %v", p.StateID)
+		}
+	}
+
+	return p.Proof.Verify(appHash)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	hmTypes "github.com/zenanetwork/iris/types"
+	hmRest "github.com/zenanetwork/iris/types/rest"
+)
+
+// queryRecordProof queries stateID from the clerk store like QueryStoreProof does from a node
+func queryRecordProof(t *testing.T, store *rootmulti.Store, stateID uint64) RecordProof {
+	t.Helper()
+
+	key := GetEventRecordKey(stateID)
+	res := store.Query(abci.RequestQuery{Path: "/" + StoreKey + "/key", Data: key, Prove: true})
+	require.True(t, res.IsOK(), res.Log)
+
+	recordProof, err := NewRecordProof(stateID, hmRest.StoreProof{
+		Height: res.Height,
+		Store:  StoreKey,
+		Key:    key,
+		Value:  res.Value,
+		Proof:  res.Proof,
+	})
+	require.NoError(t, err)
+
+	return recordProof
+}
+
+func TestRecordProof(t *testing.T) {
+	t.Parallel()
+
+	storeKey := storeTypes.NewKVStoreKey(StoreKey)
+	store := rootmulti.NewStore(dbm.NewMemDB())
+	store.MountStoreWithDB(storeKey, storeTypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	record := NewEventRecord(hmTypes.HexToIrisHash("0x01"), 1, 7, hmTypes.HexToIrisAddress("0x02"), hmTypes.HexBytes{0x03}, "15001", time.Unix(1700000000, 0).UTC())
+	value, err := ModuleCdc.MarshalBinaryBare(record)
+	require.NoError(t, err)
+
+	store.GetCommitKVStore(storeKey).Set(GetEventRecordKey(record.ID), value)
+	appHash := store.Commit().Hash
+
+	// existence
+	recordProof := queryRecordProof(t, store, record.ID)
+	require.Equal(t, &record, recordProof.Record)
+	require.NoError(t, recordProof.Verify(appHash))
+	require.Error(t, recordProof.Verify([]byte("wrong app hash")))
+
+	tampered := recordProof
+	tampered.Record = &EventRecord{ID: record.ID, ChainID: "1"}
+	require.Error(t, tampered.Verify(appHash))
+
+	tampered = recordProof
+	tampered.StateID = 8
+	require.Error(t, tampered.Verify(appHash))
+
+	// absence
+	absentProof := queryRecordProof(t, store, 8)
+	require.Nil(t, absentProof.Record)
+	require.NoError(t, absentProof.Verify(appHash))
+
+	// a record can't be proven with an absence proof
+	absentProof.Record = &record
+	require.Error(t, absentProof.Verify(appHash))
+}
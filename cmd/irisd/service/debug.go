@@ -0,0 +1,149 @@
+package service
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/store"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/zenanetwork/iris/app"
+	"github.com/zenanetwork/iris/helper"
+)
+
+const (
+	flagHeight  = "height"
+	flagTxHash  = "tx"
+	flagFixture = "fixture"
+)
+
+func debugCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Debugging subcommands",
+	}
+
+	cmd.AddCommand(replaySideTxCmd(ctx))
+
+	return cmd
+}
+
+func replaySideTxCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-side-tx",
+		Short: "Replay the vote of this node on a side-tx from its recorded chain reads",
+		Long: `
+Runs the side handlers of the side-tx included at the given height against the application
+state at that height, answering their ethereum and zena reads from the fixture recorded
+while the node voted (see side_tx_record_dir in iris-config.toml), and prints the replayed
+vote along with the recorded one. No state is written, but the node must be stopped.
+`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			height := viper.GetInt64(flagHeight)
+			if height <= 0 {
+				return errors.New("height must be positive")
+			}
+
+			txHash, err := hex.DecodeString(viper.GetString(flagTxHash))
+			if err != nil || len(txHash) == 0 {
+				return fmt.Errorf("invalid tx hash %q", viper.GetString(flagTxHash))
+			}
+
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			helper.InitIrisConfig("")
+
+			fixtureFile := viper.GetString(flagFixture)
+			if fixtureFile == "" {
+				if helper.GetConfig().SideTxRecordDir == "" {
+					return errors.New("no fixture given and side_tx_record_dir is not configured")
+				}
+
+				fixtureFile = helper.GetCallFixtureFile(helper.GetConfig().SideTxRecordDir, txHash)
+			}
+
+			fixture, err := helper.LoadCallFixture(fixtureFile)
+			if err != nil {
+				return err
+			}
+
+			db, err := sdk.NewLevelDB("application", config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// the side handlers read the time and chain id of the block including the side-tx
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+
+			blockMeta := store.NewBlockStore(blockStoreDB).LoadBlockMeta(height)
+			if blockMeta == nil {
+				return fmt.Errorf("no block at height %d", height)
+			}
+
+			hApp := app.NewIrisApp(logger, db)
+
+			res, err := hApp.ReplaySideTx(tmTypes.TM2PB.Header(&blockMeta.Header), txHash, fixture)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(struct {
+				Height         int64  `json:"height"`
+				TxHash         string `json:"tx_hash"`
+				RecordedHeight int64  `json:"recorded_height"`
+				RecordedResult string `json:"recorded_result"`
+				Result         string `json:"result"`
+				Code           uint32 `json:"code"`
+				Codespace      string `json:"codespace,omitempty"`
+				Data           string `json:"data"`
+				Match          bool   `json:"match"`
+			}{
+				Height:         height,
+				TxHash:         hex.EncodeToString(txHash),
+				RecordedHeight: fixture.Height,
+				RecordedResult: fixture.Result,
+				Result:         res.Result.String(),
+				Code:           res.Code,
+				Codespace:      res.Codespace,
+				Data:           hex.EncodeToString(res.Data),
+				Match:          res.Result.String() == fixture.Result,
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "--height=<height the side-tx is included at>")
+	cmd.Flags().String(flagTxHash, "", "--tx=<side-tx hash, hex encoded>")
+	cmd.Flags().String(flagFixture, "", "--fixture=<recorded fixture file, defaults to the one in side_tx_record_dir>")
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "Node's home directory")
+
+	if err := cmd.MarkFlagRequired(flagHeight); err != nil {
+		logger.Error("replaySideTxCmd | MarkFlagRequired | flagHeight", "Error", err)
+	}
+
+	if err := cmd.MarkFlagRequired(flagTxHash); err != nil {
+		logger.Error("replaySideTxCmd | MarkFlagRequired | flagTxHash", "Error", err)
+	}
+
+	return cmd
+}
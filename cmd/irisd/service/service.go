@@ -56,6 +56,8 @@ import (
 	bridgeCmd "github.com/zenanetwork/iris/bridge/cmd"
 	"github.com/zenanetwork/iris/helper"
 	restServer "github.com/zenanetwork/iris/server"
+	"github.com/zenanetwork/iris/signer"
+	"github.com/zenanetwork/iris/snapshot"
 	hmTypes "github.com/zenanetwork/iris/types"
 	hmModule "github.com/zenanetwork/iris/types/module"
 	"github.com/zenanetwork/iris/version"
@@ -185,6 +187,7 @@ func NewIrisService(pCtx context.Context, args []string) {
 
 	rootCmd.AddCommand(showAccountCmd())
 	rootCmd.AddCommand(showPrivateKeyCmd())
+	rootCmd.AddCommand(signerCmd(ctx))
 	rootCmd.AddCommand(restServer.ServeCommands(shutdownCtx, cdc, restServer.RegisterRoutes))
 	rootCmd.AddCommand(bridgeCmd.BridgeCommands(viper.GetViper(), logger, "main"))
 	rootCmd.AddCommand(VerifyGenesis(ctx, cdc))
@@ -193,6 +196,10 @@ func NewIrisService(pCtx context.Context, args []string) {
 
 	// rollback cmd
 	rootCmd.AddCommand(rollbackCmd(ctx))
+	rootCmd.AddCommand(snapshotCmd(ctx))
+
+	// debug cmd
+	rootCmd.AddCommand(debugCmd(ctx))
 
 	if args != nil && len(args) > 0 { //nolint
 		rootCmd.SetArgs(args)
@@ -427,6 +434,9 @@ func startInProcess(cmd *cobra.Command, shutdownCtx context.Context, ctx *server
 
 	app := appCreator(ctx.Logger, db, traceWriter)
 
+	// serve the metrics of the app, and of the bridge if started along
+	helper.StartMetricsServer()
+
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		return fmt.Errorf("failed to load or gen node key: %s", err)
@@ -434,14 +444,40 @@ func startInProcess(cmd *cobra.Command, shutdownCtx context.Context, ctx *server
 
 	server.UpgradeOldPrivValFile(cfg)
 
+	var privValidator tmTypes.PrivValidator = privval.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+
+	// votes are signed by a remote signer listening on priv_validator_laddr, wrapped
+	// so that side-tx results are signed by the signer of the validator key
+	if cfg.PrivValidatorListenAddr != "" {
+		privValidator, err = signer.NewSocketPrivValidator(cfg.PrivValidatorListenAddr, helper.GetSigner(), ctx.Logger.With("module", "privval"))
+		if err != nil {
+			return fmt.Errorf("failed to create remote private validator: %s", err)
+		}
+
+		// keep tendermint from replacing the wrapped private validator
+		cfg.PrivValidatorListenAddr = ""
+	}
+
+	// the tendermint dbs are kept to snapshot the node along with the application db
+	snapshotDBs := snapshot.DBs{Application: db}
+
+	// snapshot the node every snapshot_interval blocks, as the app commits
+	var snapshotApp *snapshot.App
+
+	if interval := helper.GetConfig().SnapshotInterval; interval != 0 {
+		snapshotApp = snapshot.NewApp(app, snapshotDir(cfg), &snapshotDBs, interval,
+			helper.GetConfig().SnapshotKeepRecent, ctx.Logger.With("module", "snapshot"))
+		app = snapshotApp
+	}
+
 	// create & start tendermint node
 	tmNode, err := node.NewNode(
 		cfg,
-		privval.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+		privValidator,
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
 		node.DefaultGenesisDocProviderFunc(cfg),
-		node.DefaultDBProvider,
+		snapshotDBProvider(&snapshotDBs),
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		ctx.Logger.With("module", "node"),
 	)
@@ -517,6 +553,17 @@ func startInProcess(cmd *cobra.Command, shutdownCtx context.Context, ctx *server
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
+
+		// nolint: contextcheck
+		if err := helper.StopMetricsServer(context.Background()); err != nil {
+			ctx.Logger.Error("Error shutting down metrics server", "Error", err)
+		}
+
+		// the snapshot being written reads the dbs closed by the node
+		if snapshotApp != nil {
+			snapshotApp.Close()
+		}
+
 		if tmNode.IsRunning() {
 			return tmNode.Stop()
 		}
@@ -587,10 +634,15 @@ func showPrivateKeyCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "show-privatekey",
 		Short: "Print the account's private key",
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			// init iris config
 			helper.InitIrisConfig("")
 
+			// the key of a remote signer never leaves it
+			if signerName := helper.GetConfig().Signer; signerName != helper.SignerLocal {
+				return fmt.Errorf("private key is held by the %s signer and can't be shown", signerName)
+			}
+
 			// get private and public keys
 			privObject := helper.GetPrivKey()
 
@@ -600,11 +652,13 @@ func showPrivateKeyCmd() *cobra.Command {
 
 			b, err := jsoniter.ConfigFastest.MarshalIndent(account, "", "    ")
 			if err != nil {
-				panic(err)
+				return err
 			}
 
 			// prints json info
 			fmt.Printf("%s", string(b))
+
+			return nil
 		},
 	}
 }
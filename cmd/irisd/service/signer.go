@@ -0,0 +1,106 @@
+package service
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/privval"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+
+	"github.com/zenanetwork/iris/helper"
+	"github.com/zenanetwork/iris/signer"
+)
+
+const (
+	flagSignerAddr = "signer-addr"
+	flagNodeAddr   = "node-addr"
+)
+
+func signerCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer",
+		Short: "Serve the validator key of this home to a node using a remote signer",
+		Long: `
+Serves the validator key of priv_validator_key.json over gRPC, for a node configured with
+signer = "grpc" and signer_addr in iris-config.toml. With --node-addr, it also signs the
+votes and proposals of the node listening on that address (priv_validator_laddr in
+config.toml), keeping the double-sign protection state in priv_validator_state.json.
+
+This is a stand-in for a signing service holding the key in an HSM or KMS, which only
+has to implement the same gRPC service.
+`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			filePV := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+
+			privKey, ok := filePV.Key.PrivKey.(secp256k1.PrivKeySecp256k1)
+			if !ok {
+				return fmt.Errorf("validator key is not of type secp256k1")
+			}
+
+			listener, err := net.Listen("tcp", viper.GetString(flagSignerAddr))
+			if err != nil {
+				return err
+			}
+
+			grpcServer := grpc.NewServer()
+			signer.RegisterSignerServer(grpcServer, signer.NewLocalSigner(privKey), logger)
+
+			go func() {
+				if err := grpcServer.Serve(listener); err != nil {
+					logger.Error("Signer server stopped", "error", err)
+				}
+			}()
+
+			defer grpcServer.GracefulStop()
+
+			logger.Info("Serving validator key", "address", listener.Addr().String(), "validator", filePV.GetAddress().String())
+
+			if nodeAddr := viper.GetString(flagNodeAddr); nodeAddr != "" {
+				genDoc, err := tmTypes.GenesisDocFromFile(config.GenesisFile())
+				if err != nil {
+					return err
+				}
+
+				signerServer, err := signer.NewSocketSignerServer(nodeAddr, genDoc.ChainID, filePV, logger)
+				if err != nil {
+					return err
+				}
+
+				if err = signerServer.Start(); err != nil {
+					return err
+				}
+
+				defer func() {
+					_ = signerServer.Stop()
+				}()
+
+				logger.Info("Signing votes", "node", nodeAddr, "chainID", genDoc.ChainID)
+			}
+
+			// wait for a termination signal
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+			<-sigCh
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSignerAddr, "127.0.0.1:8547", "Address to serve the gRPC signer on")
+	cmd.Flags().String(flagNodeAddr, "", "Address the node listens on for a privval signer (tcp:// or unix://)")
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "Node's home directory")
+
+	return cmd
+}
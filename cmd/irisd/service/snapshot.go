@@ -0,0 +1,313 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/node"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+	sm "github.com/tendermint/tendermint/state"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/zenanetwork/iris/app"
+	"github.com/zenanetwork/iris/helper"
+	"github.com/zenanetwork/iris/snapshot"
+)
+
+const flagTrustedNode = "trusted-node"
+
+// snapshotDBNames are the names of the dbs restored from a snapshot, in the data directory
+var snapshotDBNames = []string{"application.db", "state.db", "blockstore.db"}
+
+func snapshotCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Create, list and restore snapshots of the node state",
+		Long: `
+A snapshot holds the stores of every module of the application at a height, the side-tx
+pending txs and validators included, along with the Tendermint state and the last block
+at that height. It is written to data/snapshots/<height> in sha256 hashed chunks listed in
+a manifest. Snapshots are also taken by the running node every snapshot_interval blocks
+(see iris-config.toml).
+`,
+	}
+
+	cmd.AddCommand(
+		snapshotCreateCmd(ctx),
+		snapshotListCmd(ctx),
+		snapshotRestoreCmd(ctx),
+	)
+
+	return cmd
+}
+
+func snapshotCreateCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Snapshot the state of the node at its latest height",
+		Long: `
+Writes a snapshot of the state of the node at its latest height. The node must be stopped.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			dbs, closeDBs, err := openSnapshotDBs(config)
+			if err != nil {
+				return err
+			}
+			defer closeDBs()
+
+			manifest, err := snapshot.Create(snapshotDir(config), dbs, 0)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Created snapshot at height %d with app hash %X, hash %X\n", manifest.Height, manifest.AppHash, manifest.Hash())
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "Node's home directory")
+
+	return cmd
+}
+
+func snapshotListCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the snapshots of the node",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			manifests, err := snapshot.List(snapshotDir(config))
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "HEIGHT\tAPP HASH\tHASH\tCHUNKS\tSIZE\tCREATED")
+
+			for _, manifest := range manifests {
+				fmt.Fprintf(w, "%d\t%X\t%X\t%d\t%d\t%s\n", manifest.Height, manifest.AppHash, manifest.Hash(),
+					len(manifest.Chunks), manifest.Size(), manifest.CreatedAt.Format("2006-01-02 15:04:05"))
+			}
+
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "Node's home directory")
+
+	return cmd
+}
+
+func snapshotRestoreCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [height]",
+		Short: "Restore the state of the node from a snapshot",
+		Long: `
+Restores the state of the node from the snapshot at the given height. The app hash of the
+snapshot is first checked against the one committed by the next block, fetched from the
+trusted node. The snapshot is then restored into temporary dbs, after checking its chunks
+against their hashes, and the application loaded from them is checked against the app hash.
+Only then are the dbs moved into the data directory. The node must be stopped and its data
+empty (see unsafe-reset-all). Once started, the node syncs the blocks after the snapshot
+from its peers.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || height <= 0 {
+				return fmt.Errorf("invalid snapshot height %q", args[0])
+			}
+
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			helper.InitIrisConfig("")
+
+			manifest, err := snapshot.Load(snapshotDir(config), height)
+			if err != nil {
+				return err
+			}
+
+			if err = checkTrustedAppHash(config, viper.GetString(flagTrustedNode), manifest); err != nil {
+				return err
+			}
+
+			dbs, closeDBs, err := openSnapshotDBs(config)
+			if err != nil {
+				return err
+			}
+
+			err = snapshot.CheckEmpty(dbs)
+			closeDBs()
+
+			if err != nil {
+				return err
+			}
+
+			// the dbs are restored next to the ones of the node, to be moved in once checked
+			restoreConfig := *config
+			restoreConfig.DBPath = filepath.Join(config.DBDir(), "snapshot-restore")
+
+			if err = os.RemoveAll(restoreConfig.DBDir()); err != nil {
+				return err
+			}
+			defer os.RemoveAll(restoreConfig.DBDir())
+
+			if err = restoreSnapshot(&restoreConfig, snapshotDir(config), manifest); err != nil {
+				return err
+			}
+
+			for _, name := range snapshotDBNames {
+				// the dbs of the node are empty
+				if err = os.RemoveAll(filepath.Join(config.DBDir(), name)); err != nil {
+					return err
+				}
+
+				if err = os.Rename(filepath.Join(restoreConfig.DBDir(), name), filepath.Join(config.DBDir(), name)); err != nil {
+					return fmt.Errorf("failed to move the restored %s into the data directory: %w", name, err)
+				}
+			}
+
+			fmt.Printf("Restored snapshot at height %d with app hash %X\n", manifest.Height, manifest.AppHash)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "Node's home directory")
+	cmd.Flags().String(flagTrustedNode, "", "RPC address of a trusted node of the chain, past the snapshot height (e.g. tcp://localhost:26657)")
+
+	_ = cmd.MarkFlagRequired(flagTrustedNode)
+
+	return cmd
+}
+
+// checkTrustedAppHash checks the app hash of the snapshot against the header of the next block,
+// which commits it, fetched from the trusted node
+func checkTrustedAppHash(config *cfg.Config, trustedNode string, manifest *snapshot.Manifest) error {
+	genesis, err := tmTypes.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	height := manifest.Height + 1
+
+	commit, err := httpClient.NewHTTP(trustedNode, "/websocket").Commit(&height)
+	if err != nil {
+		return fmt.Errorf("unable to fetch the header at height %d from the trusted node %s: %w", height, trustedNode, err)
+	}
+
+	if commit.Header.ChainID != genesis.ChainID {
+		return fmt.Errorf("trusted node is on chain %s, not on chain %s", commit.Header.ChainID, genesis.ChainID)
+	}
+
+	if !bytes.Equal(commit.Header.AppHash, manifest.AppHash) {
+		return fmt.Errorf("snapshot app hash %X does not match the app hash %X committed at height %d by the trusted node",
+			manifest.AppHash, commit.Header.AppHash, height)
+	}
+
+	return nil
+}
+
+// restoreSnapshot restores the snapshot into the dbs of config, and checks the tendermint state
+// and the application loaded from them against the app hash of the snapshot
+func restoreSnapshot(config *cfg.Config, dir string, manifest *snapshot.Manifest) error {
+	dbs, closeDBs, err := openSnapshotDBs(config)
+	if err != nil {
+		return err
+	}
+	defer closeDBs()
+
+	if _, err = snapshot.Restore(dir, dbs, manifest.Height); err != nil {
+		return err
+	}
+
+	if state := sm.LoadState(dbs.State); !bytes.Equal(state.AppHash, manifest.AppHash) {
+		return fmt.Errorf("restored tendermint state app hash %X does not match the snapshot app hash %X", state.AppHash, manifest.AppHash)
+	}
+
+	commitID := app.NewIrisApp(logger, dbs.Application).LastCommitID()
+	if commitID.Version != manifest.Height || !bytes.Equal(commitID.Hash, manifest.AppHash) {
+		return fmt.Errorf("restored app hash %X at height %d does not match the snapshot app hash %X at height %d",
+			commitID.Hash, commitID.Version, manifest.AppHash, manifest.Height)
+	}
+
+	return nil
+}
+
+// snapshotDir returns the directory the snapshots of the node are kept in
+func snapshotDir(config *cfg.Config) string {
+	return filepath.Join(config.DBDir(), "snapshots")
+}
+
+// openSnapshotDBs opens the application db and the tendermint state and block store dbs
+func openSnapshotDBs(config *cfg.Config) (snapshot.DBs, func(), error) {
+	var dbs snapshot.DBs
+
+	appDB, err := sdk.NewLevelDB("application", config.DBDir())
+	if err != nil {
+		return dbs, nil, err
+	}
+
+	// tendermint dbs are opened with the backend of the config
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		appDB.Close()
+		return dbs, nil, err
+	}
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		appDB.Close()
+		stateDB.Close()
+
+		return dbs, nil, err
+	}
+
+	dbs = snapshot.DBs{Application: appDB, State: stateDB, BlockStore: blockStoreDB}
+
+	return dbs, func() {
+		appDB.Close()
+		stateDB.Close()
+		blockStoreDB.Close()
+	}, nil
+}
+
+// snapshotDBProvider returns the db provider of the tendermint node, keeping the state and block
+// store dbs to take the snapshots of the running node from
+func snapshotDBProvider(dbs *snapshot.DBs) node.DBProvider {
+	return func(dbCtx *node.DBContext) (dbm.DB, error) {
+		db, err := node.DefaultDBProvider(dbCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		switch dbCtx.ID {
+		case "state":
+			dbs.State = db
+		case "blockstore":
+			dbs.BlockStore = db
+		}
+
+		return db, nil
+	}
+}
@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// traceParentKey is the W3C trace context header holding the trace and span IDs
+const traceParentKey = "traceparent"
+
+// propagator carries trace contexts between the bridge and the node, whether tracing
+// is enabled or not, as W3C trace context headers
+var propagator = propagation.TraceContext{}
+
+// Inject writes the trace context of the span in ctx to carrier
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	propagator.Inject(ctx, carrier)
+}
+
+// Extract returns ctx continuing the trace context read from carrier
+func Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return propagator.Extract(ctx, carrier)
+}
+
+// TraceParent returns the W3C traceparent header of the span in ctx, empty if there is no span
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	Inject(ctx, carrier)
+
+	return carrier.Get(traceParentKey)
+}
+
+// WithTraceParent returns ctx continuing the trace of a W3C traceparent header.
+// ctx is returned as is if traceParent is not a valid header.
+func WithTraceParent(ctx context.Context, traceParent string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+
+	return Extract(ctx, propagation.MapCarrier{traceParentKey: traceParent})
+}
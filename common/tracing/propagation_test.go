@@ -0,0 +1,20 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceParent(t *testing.T) {
+	t.Parallel()
+
+	const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	require.Empty(t, TraceParent(context.Background()))
+	require.Equal(t, traceParent, TraceParent(WithTraceParent(context.Background(), traceParent)))
+
+	// invalid headers are ignored
+	require.Empty(t, TraceParent(WithTraceParent(context.Background(), "not a traceparent")))
+}
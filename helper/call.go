@@ -13,6 +13,7 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/zenanetwork/go-zenanet"
 	"github.com/zenanetwork/go-zenanet/accounts/abi"
+	"github.com/zenanetwork/go-zenanet/accounts/abi/bind"
 	"github.com/zenanetwork/go-zenanet/common"
 	"github.com/zenanetwork/go-zenanet/common/hexutil"
 	ethTypes "github.com/zenanetwork/go-zenanet/core/types"
@@ -61,6 +62,7 @@ type IContractCaller interface {
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
+	GetMaticChainBlockByHash(common.Hash) (*ethTypes.Header, error)
 	GetZenaChainBlockAuthor(*big.Int) (*common.Address, error)
 	IsTxConfirmed(common.Hash, uint64) bool
 	GetConfirmedTxReceipt(common.Hash, uint64) (*ethTypes.Receipt, error)
@@ -101,6 +103,8 @@ type IContractCaller interface {
 	GetStateSenderInstance(stateSenderAddress common.Address) (*statesender.Statesender, error)
 	GetStateReceiverInstance(stateReceiverAddress common.Address) (*statereceiver.Statereceiver, error)
 	GetMaticTokenInstance(maticTokenAddress common.Address) (*erc20.Erc20, error)
+
+	ForChildChain(chainID string) (IContractCaller, error)
 }
 
 // ContractCaller contract caller
@@ -119,6 +123,10 @@ type ContractCaller struct {
 
 	MaticChainTimeout time.Duration
 
+	// MainChainPool and MaticChainPool are the RPC providers used for failover and quorum reads
+	MainChainPool  *RPCPool
+	MaticChainPool *RPCPool
+
 	RootChainABI     abi.ABI
 	StakingInfoABI   abi.ABI
 	ValidatorSetABI  abi.ABI
@@ -130,9 +138,21 @@ type ContractCaller struct {
 
 	ReceiptCache *lru.Cache
 
+	// Fixture records the chain reads of side-tx votes, or serves them when a vote is replayed
+	Fixture *CallFixture
+
 	ContractInstanceCache map[common.Address]interface{}
 }
 
+// headerInfo is the checkpoint header info returned by GetHeaderInfo
+type headerInfo struct {
+	Root      common.Hash       `json:"root"`
+	Start     uint64            `json:"start"`
+	End       uint64            `json:"end"`
+	CreatedAt uint64            `json:"created_at"`
+	Proposer  types.IrisAddress `json:"proposer"`
+}
+
 type txExtraInfo struct {
 	BlockNumber *string         `json:"blockNumber,omitempty"`
 	BlockHash   *common.Hash    `json:"blockHash,omitempty"`
@@ -155,6 +175,14 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	contractCallerObj.ReceiptCache, err = lru.New(1000)
 	contractCallerObj.MaticGrpcFlag = config.ZenaGRPCFlag
 	contractCallerObj.MaticGrpcClient = GetMaticGRPCClient()
+	contractCallerObj.MainChainPool = GetMainChainPool()
+	contractCallerObj.MaticChainPool = GetMaticChainPool()
+
+	if config.SideTxRecordDir != "" {
+		if contractCallerObj.Fixture, err = NewRecordingCallFixture(config.SideTxRecordDir); err != nil {
+			return contractCallerObj, err
+		}
+	}
 
 	if err != nil {
 		return contractCallerObj, err
@@ -170,6 +198,35 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	return contractCallerObj, nil
 }
 
+// mainChainPool returns the main chain RPC providers, MainChainClient alone if they are not set
+func (c *ContractCaller) mainChainPool() *RPCPool {
+	if c.MainChainPool != nil {
+		return c.MainChainPool
+	}
+
+	return NewRPCPool("eth", 1, &RPCProvider{Name: "default", RPC: c.MainChainRPC, Client: c.MainChainClient})
+}
+
+// maticChainPool returns the matic chain RPC providers, MaticChainClient alone if they are not set
+func (c *ContractCaller) maticChainPool() *RPCPool {
+	if c.MaticChainPool != nil {
+		return c.MaticChainPool
+	}
+
+	return NewRPCPool("zena", 1, &RPCProvider{Name: "default", RPC: c.MaticChainRPC, Client: c.MaticChainClient})
+}
+
+// getContractAddress returns the address of a cached contract instance
+func (c *ContractCaller) getContractAddress(contractInstance interface{}) (common.Address, bool) {
+	for address, ci := range c.ContractInstanceCache {
+		if ci == contractInstance {
+			return address, true
+		}
+	}
+
+	return common.Address{}, false
+}
+
 // GetRootChainInstance returns RootChain contract instance for selected base chain
 func (c *ContractCaller) GetRootChainInstance(rootChainAddress common.Address) (*rootchain.Rootchain, error) {
 	contractInstance, ok := c.ContractInstanceCache[rootChainAddress]
@@ -282,6 +339,25 @@ func (c *ContractCaller) GetHeaderInfo(number uint64, rootChainInstance *rootcha
 	createdAt uint64,
 	proposer types.IrisAddress,
 	err error,
+) {
+	var info headerInfo
+
+	err = c.Fixture.Call("GetHeaderInfo", []interface{}{number, childBlockInterval}, &info, func() (err error) {
+		info.Root, info.Start, info.End, info.CreatedAt, info.Proposer, err = c.getHeaderInfo(number, rootChainInstance, childBlockInterval)
+		return err
+	})
+
+	return info.Root, info.Start, info.End, info.CreatedAt, info.Proposer, err
+}
+
+// getHeaderInfo get header info from checkpoint number
+func (c *ContractCaller) getHeaderInfo(number uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (
+	root common.Hash,
+	start uint64,
+	end uint64,
+	createdAt uint64,
+	proposer types.IrisAddress,
+	err error,
 ) {
 	// get header from rootChain
 	checkpointBigInt := big.NewInt(0).Mul(big.NewInt(0).SetUint64(number), big.NewInt(0).SetUint64(childBlockInterval))
@@ -301,6 +377,18 @@ func (c *ContractCaller) GetHeaderInfo(number uint64, rootChainInstance *rootcha
 
 // GetRootHash get root hash from zena chain
 func (c *ContractCaller) GetRootHash(start uint64, end uint64, checkpointLength uint64) ([]byte, error) {
+	var rootHash []byte
+
+	err := c.Fixture.Call("GetRootHash", []interface{}{start, end, checkpointLength}, &rootHash, func() (err error) {
+		rootHash, err = c.getRootHash(start, end, checkpointLength)
+		return err
+	})
+
+	return rootHash, err
+}
+
+// getRootHash get root hash from zena chain
+func (c *ContractCaller) getRootHash(start uint64, end uint64, checkpointLength uint64) ([]byte, error) {
 	noOfBlock := end - start + 1
 
 	if start > end {
@@ -321,7 +409,16 @@ func (c *ContractCaller) GetRootHash(start uint64, end uint64, checkpointLength
 	if c.MaticGrpcFlag {
 		rootHash, err = c.MaticGrpcClient.GetRootHash(ctx, start, end)
 	} else {
-		rootHash, err = c.MaticChainClient.GetRootHash(ctx, start, end)
+		var result interface{}
+
+		result, err = c.maticChainPool().QuorumCall("GetRootHash", func(provider *RPCProvider) (interface{}, error) {
+			return provider.Client.GetRootHash(ctx, start, end)
+		}, func(result interface{}) string {
+			return result.(string)
+		})
+		if err == nil {
+			rootHash = result.(string)
+		}
 	}
 
 	if err != nil {
@@ -334,6 +431,18 @@ func (c *ContractCaller) GetRootHash(start uint64, end uint64, checkpointLength
 
 // GetVoteOnHash gets vote on hash from zena chain
 func (c *ContractCaller) GetVoteOnHash(start uint64, end uint64, milestoneLength uint64, hash string, milestoneID string) (bool, error) {
+	var vote bool
+
+	err := c.Fixture.Call("GetVoteOnHash", []interface{}{start, end, milestoneLength, hash, milestoneID}, &vote, func() (err error) {
+		vote, err = c.getVoteOnHash(start, end, milestoneLength, hash, milestoneID)
+		return err
+	})
+
+	return vote, err
+}
+
+// getVoteOnHash gets vote on hash from zena chain
+func (c *ContractCaller) getVoteOnHash(start uint64, end uint64, milestoneLength uint64, hash string, milestoneID string) (bool, error) {
 	if start > end {
 		return false, errors.New("start block number is greater than the end block number")
 	}
@@ -375,7 +484,33 @@ func (c *ContractCaller) GetLastChildBlock(rootChainInstance *rootchain.Rootchai
 
 // CurrentHeaderBlock fetches current header block
 func (c *ContractCaller) CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error) {
-	currentHeaderBlock, err := rootChainInstance.CurrentHeaderBlock(nil)
+	var currentHeaderBlock *big.Int
+	var err error
+
+	// read the contract through each provider when its address is known
+	if rootChainAddress, ok := c.getContractAddress(rootChainInstance); ok {
+		var result interface{}
+
+		result, err = c.mainChainPool().QuorumCall("CurrentHeaderBlock", func(provider *RPCProvider) (interface{}, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+			defer cancel()
+
+			caller, err := rootchain.NewRootchainCaller(rootChainAddress, provider.Client)
+			if err != nil {
+				return nil, err
+			}
+
+			return caller.CurrentHeaderBlock(&bind.CallOpts{Context: ctx})
+		}, func(result interface{}) string {
+			return result.(*big.Int).String()
+		})
+		if err == nil {
+			currentHeaderBlock, _ = result.(*big.Int)
+		}
+	} else {
+		currentHeaderBlock, err = rootChainInstance.CurrentHeaderBlock(nil)
+	}
+
 	if err != nil {
 		Logger.Error("Could not fetch current header block from rootChain contract", "error", err)
 		return 0, err
@@ -434,29 +569,59 @@ func (c *ContractCaller) GetValidatorInfo(valID types.ValidatorID, stakingInfoIn
 
 // GetMainChainBlock returns main chain block header
 func (c *ContractCaller) GetMainChainBlock(blockNum *big.Int) (header *ethTypes.Header, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
-	defer cancel()
+	err = c.Fixture.Call("GetMainChainBlock", []interface{}{blockNum}, &header, func() (err error) {
+		header, err = c.getMainChainBlock(blockNum)
+		return err
+	})
+
+	return header, err
+}
+
+// getMainChainBlock returns main chain block header
+func (c *ContractCaller) getMainChainBlock(blockNum *big.Int) (header *ethTypes.Header, err error) {
+	fetchHeader := func(provider *RPCProvider) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+		defer cancel()
+
+		return provider.Client.HeaderByNumber(ctx, blockNum)
+	}
+
+	var result interface{}
+
+	if blockNum == nil {
+		// providers don't agree on the latest block while syncing, only fail over
+		result, err = c.mainChainPool().Call("GetMainChainBlock", fetchHeader)
+	} else {
+		result, err = c.mainChainPool().QuorumCall("GetMainChainBlock", fetchHeader, func(result interface{}) string {
+			return result.(*ethTypes.Header).Hash().Hex()
+		})
+	}
 
-	latestBlock, err := c.MainChainClient.HeaderByNumber(ctx, blockNum)
 	if err != nil {
 		Logger.Error("Unable to connect to main chain", "error", err)
 		return
 	}
 
+	latestBlock, _ := result.(*ethTypes.Header)
+
 	return latestBlock, nil
 }
 
 // GetMainChainFinalizedBlock returns finalized main chain block header (post-merge)
 func (c *ContractCaller) GetMainChainFinalizedBlock() (header *ethTypes.Header, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
-	defer cancel()
+	result, err := c.mainChainPool().Call("GetMainChainFinalizedBlock", func(provider *RPCProvider) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+		defer cancel()
 
-	latestFinalizedBlock, err := c.MainChainClient.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+		return provider.Client.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	})
 	if err != nil {
 		Logger.Error("Unable to connect to main chain", "error", err)
 		return
 	}
 
+	latestFinalizedBlock, _ := result.(*ethTypes.Header)
+
 	return latestFinalizedBlock, nil
 }
 
@@ -481,6 +646,16 @@ func (c *ContractCaller) GetMainChainBlockTime(ctx context.Context, blockNum uin
 
 // GetMaticChainBlock returns child chain block header
 func (c *ContractCaller) GetMaticChainBlock(blockNum *big.Int) (header *ethTypes.Header, err error) {
+	err = c.Fixture.Call("GetMaticChainBlock", []interface{}{blockNum}, &header, func() (err error) {
+		header, err = c.getMaticChainBlock(blockNum)
+		return err
+	})
+
+	return header, err
+}
+
+// getMaticChainBlock returns child chain block header
+func (c *ContractCaller) getMaticChainBlock(blockNum *big.Int) (header *ethTypes.Header, err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
 	defer cancel()
 
@@ -505,8 +680,44 @@ func (c *ContractCaller) GetMaticChainBlock(blockNum *big.Int) (header *ethTypes
 	return latestBlock, nil
 }
 
+// GetMaticChainBlockByHash returns child chain block header by hash
+func (c *ContractCaller) GetMaticChainBlockByHash(blockHash common.Hash) (header *ethTypes.Header, err error) {
+	err = c.Fixture.Call("GetMaticChainBlockByHash", []interface{}{blockHash}, &header, func() (err error) {
+		header, err = c.getMaticChainBlockByHash(blockHash)
+		return err
+	})
+
+	return header, err
+}
+
+// getMaticChainBlockByHash returns child chain block header by hash, over RPC only
+func (c *ContractCaller) getMaticChainBlockByHash(blockHash common.Hash) (header *ethTypes.Header, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
+	defer cancel()
+
+	header, err = c.MaticChainClient.HeaderByHash(ctx, blockHash)
+	if err != nil {
+		Logger.Error("Unable to connect to matic chain", "error", err)
+		return
+	}
+
+	return header, nil
+}
+
 // GetZenaChainBlockAuthor returns the producer of the zena block
 func (c *ContractCaller) GetZenaChainBlockAuthor(blockNum *big.Int) (*common.Address, error) {
+	var author *common.Address
+
+	err := c.Fixture.Call("GetZenaChainBlockAuthor", []interface{}{blockNum}, &author, func() (err error) {
+		author, err = c.getZenaChainBlockAuthor(blockNum)
+		return err
+	})
+
+	return author, err
+}
+
+// getZenaChainBlockAuthor returns the producer of the zena block
+func (c *ContractCaller) getZenaChainBlockAuthor(blockNum *big.Int) (*common.Address, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
 	defer cancel()
 
@@ -576,12 +787,24 @@ func (c *ContractCaller) IsTxConfirmed(tx common.Hash, requiredConfirmations uin
 func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmations uint64) (*ethTypes.Receipt, error) {
 	var receipt *ethTypes.Receipt
 
+	err := c.Fixture.Call("GetConfirmedTxReceipt", []interface{}{tx, requiredConfirmations}, &receipt, func() (err error) {
+		receipt, err = c.getConfirmedTxReceipt(tx, requiredConfirmations)
+		return err
+	})
+
+	return receipt, err
+}
+
+// getConfirmedTxReceipt returns confirmed tx receipt
+func (c *ContractCaller) getConfirmedTxReceipt(tx common.Hash, requiredConfirmations uint64) (*ethTypes.Receipt, error) {
+	var receipt *ethTypes.Receipt
+
 	receiptCache, ok := c.ReceiptCache.Get(tx.String())
 	if !ok {
 		var err error
 
-		// get main tx receipt
-		receipt, err = c.GetMainTxReceipt(tx)
+		// get main tx receipt agreed on by the main chain providers
+		receipt, err = c.getConfirmedMainTxReceipt(tx)
 		if err != nil {
 			Logger.Error("Error while fetching mainChain receipt", "txHash", tx.Hex(), "error", err)
 			return nil, err
@@ -928,6 +1151,21 @@ func (c *ContractCaller) CurrentStateCounter(stateSenderInstance *statesender.St
 
 // CheckIfBlocksExist - check if the given block exists on local chain
 func (c *ContractCaller) CheckIfBlocksExist(end uint64) bool {
+	var exist bool
+
+	if err := c.Fixture.Call("CheckIfBlocksExist", []interface{}{end}, &exist, func() error {
+		exist = c.checkIfBlocksExist(end)
+		return nil
+	}); err != nil {
+		Logger.Error("Unable to check if blocks exist", "error", err)
+		return false
+	}
+
+	return exist
+}
+
+// checkIfBlocksExist - check if the given block exists on local chain
+func (c *ContractCaller) checkIfBlocksExist(end uint64) bool {
 	ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
 	defer cancel()
 
@@ -978,6 +1216,33 @@ func (c *ContractCaller) GetMainTxReceipt(txHash common.Hash) (*ethTypes.Receipt
 	return c.getTxReceipt(ctx, c.MainChainClient, nil, txHash)
 }
 
+// getConfirmedMainTxReceipt returns main tx receipt with a quorum read
+func (c *ContractCaller) getConfirmedMainTxReceipt(txHash common.Hash) (*ethTypes.Receipt, error) {
+	result, err := c.mainChainPool().QuorumCall("GetConfirmedTxReceipt", func(provider *RPCProvider) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+		defer cancel()
+
+		return c.getTxReceipt(ctx, provider.Client, nil, txHash)
+	}, func(result interface{}) string {
+		receipt := result.(*ethTypes.Receipt)
+
+		// consensus fields (status, gas, bloom and logs) and the block the receipt is in
+		bz, err := receipt.MarshalBinary()
+		if err != nil {
+			return ""
+		}
+
+		return receipt.BlockHash.Hex() + hexutil.Encode(bz)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, _ := result.(*ethTypes.Receipt)
+
+	return receipt, nil
+}
+
 // GetMaticTxReceipt returns matic tx receipt
 func (c *ContractCaller) GetMaticTxReceipt(txHash common.Hash) (*ethTypes.Receipt, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
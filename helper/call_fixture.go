@@ -0,0 +1,197 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// RecordedCall is a contract caller read with its arguments and response
+type RecordedCall struct {
+	Method  string          `json:"method"`
+	Args    json.RawMessage `json:"args"`
+	Results json.RawMessage `json:"results"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// CallFixture holds the chain reads made by the side handlers while voting on a side-tx.
+// In recording mode the reads of each vote are written to a file of the fixture directory,
+// in replay mode the contract caller answers from the recorded reads instead of the chains.
+type CallFixture struct {
+	Height int64          `json:"height"`  // height of the vote
+	TxHash string         `json:"tx_hash"` // hash of the side-tx
+	Result string         `json:"result"`  // vote
+	Calls  []RecordedCall `json:"calls"`
+
+	mu        sync.Mutex
+	dir       string // recording directory, empty in replay mode
+	recording bool   // whether a vote is being recorded
+	used      []bool // calls already replayed
+}
+
+// NewRecordingCallFixture creates a fixture recording the reads of each vote in dir
+func NewRecordingCallFixture(dir string) (*CallFixture, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &CallFixture{dir: dir}, nil
+}
+
+// LoadCallFixture loads a recorded fixture from file to replay it
+func LoadCallFixture(file string) (*CallFixture, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var fixture CallFixture
+	if err := json.Unmarshal(bz, &fixture); err != nil {
+		return nil, fmt.Errorf("invalid fixture %v: %w", file, err)
+	}
+
+	// args are matched as compact json, the file being indented
+	for i := range fixture.Calls {
+		var args bytes.Buffer
+		if err := json.Compact(&args, fixture.Calls[i].Args); err != nil {
+			return nil, fmt.Errorf("invalid fixture %v: %w", file, err)
+		}
+
+		fixture.Calls[i].Args = args.Bytes()
+	}
+
+	fixture.used = make([]bool, len(fixture.Calls))
+
+	return &fixture, nil
+}
+
+// GetCallFixtureFile returns the file a side-tx vote is recorded to in dir
+func GetCallFixtureFile(dir string, txHash []byte) string {
+	return filepath.Join(dir, hex.EncodeToString(txHash)+".json")
+}
+
+// Replaying returns true if the fixture serves recorded reads
+func (f *CallFixture) Replaying() bool {
+	return f != nil && f.dir == ""
+}
+
+// Begin starts recording the reads of the vote on a side-tx
+func (f *CallFixture) Begin(height int64, txHash []byte) {
+	if f == nil || f.Replaying() {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.Height = height
+	f.TxHash = hex.EncodeToString(txHash)
+	f.Calls = nil
+	f.recording = true
+}
+
+// End writes the reads recorded since Begin along with the vote
+func (f *CallFixture) End(result string) {
+	if f == nil || f.Replaying() {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.recording {
+		return
+	}
+
+	f.recording = false
+	f.Result = result
+
+	bz, err := json.MarshalIndent(f, "", "  ")
+	if err == nil {
+		err = os.WriteFile(filepath.Join(f.dir, f.TxHash+".json"), bz, 0o600)
+	}
+
+	if err != nil {
+		Logger.Error("Unable to write side-tx call fixture", "txHash", f.TxHash, "error", err)
+	}
+}
+
+// Call serves a read of method with args: from the recorded reads in replay mode, otherwise
+// by calling fetch, which sets results, and recording the response if a vote is being recorded.
+func (f *CallFixture) Call(method string, args []interface{}, results interface{}, fetch func() error) error {
+	if f == nil {
+		return fetch()
+	}
+
+	argsBz, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+
+	if f.Replaying() {
+		return f.replay(method, argsBz, results)
+	}
+
+	fetchErr := fetch()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.recording {
+		return fetchErr
+	}
+
+	call := RecordedCall{
+		Method: method,
+		Args:   argsBz,
+	}
+
+	if fetchErr != nil {
+		call.Error = fetchErr.Error()
+	} else if call.Results, err = json.Marshal(results); err != nil {
+		Logger.Error("Unable to record contract caller response", "method", method, "error", err)
+	}
+
+	f.Calls = append(f.Calls, call)
+
+	return fetchErr
+}
+
+// replay sets results from the next recorded read of method with args
+func (f *CallFixture) replay(method string, args json.RawMessage, results interface{}) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// reads are matched in recording order, the last one is served again once all are used
+	match := -1
+
+	for i, call := range f.Calls {
+		if call.Method != method || string(call.Args) != string(args) {
+			continue
+		}
+
+		match = i
+
+		if !f.used[i] {
+			break
+		}
+	}
+
+	if match == -1 {
+		return fmt.Errorf("no recorded response for %v%s", method, args)
+	}
+
+	f.used[match] = true
+	call := f.Calls[match]
+
+	if call.Error != "" {
+		return errors.New(call.Error)
+	}
+
+	return json.Unmarshal(call.Results, results)
+}
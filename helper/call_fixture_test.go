@@ -0,0 +1,73 @@
+package helper
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallFixture(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	txHash := []byte{0x01, 0x02}
+
+	fetch := func(results *[]byte, value []byte, err error) func() error {
+		return func() error {
+			*results = value
+			return err
+		}
+	}
+
+	// nil fixture fetches
+	var results []byte
+
+	var fixture *CallFixture
+	require.NoError(t, fixture.Call("GetRootHash", nil, &results, fetch(&results, []byte{0x0a}, nil)))
+	require.Equal(t, []byte{0x0a}, results)
+
+	// reads outside a vote are not recorded
+	fixture, err := NewRecordingCallFixture(dir)
+	require.NoError(t, err)
+	require.False(t, fixture.Replaying())
+	require.NoError(t, fixture.Call("GetRootHash", []interface{}{1}, &results, fetch(&results, []byte{0x0b}, nil)))
+
+	fixture.Begin(10, txHash)
+	require.NoError(t, fixture.Call("GetRootHash", []interface{}{uint64(1), uint64(2)}, &results, fetch(&results, []byte{0x01}, nil)))
+	require.NoError(t, fixture.Call("GetRootHash", []interface{}{uint64(1), uint64(2)}, &results, fetch(&results, []byte{0x02}, nil)))
+	require.Error(t, fixture.Call("GetMainChainBlock", []interface{}{big.NewInt(5)}, &results, fetch(&results, nil, errors.New("down"))))
+	fixture.End("Yes")
+
+	fixture, err = LoadCallFixture(GetCallFixtureFile(dir, txHash))
+	require.NoError(t, err)
+	require.True(t, fixture.Replaying())
+	require.Equal(t, int64(10), fixture.Height)
+	require.Equal(t, "Yes", fixture.Result)
+	require.Len(t, fixture.Calls, 3)
+
+	replay := func(method string, args ...interface{}) ([]byte, error) {
+		var results []byte
+
+		err := fixture.Call(method, args, &results, func() error {
+			t.Fatal("replay fetched from the chain")
+			return nil
+		})
+
+		return results, err
+	}
+
+	// reads are served in recording order, the last one once all are used
+	for _, expected := range [][]byte{{0x01}, {0x02}, {0x02}} {
+		results, err := replay("GetRootHash", uint64(1), uint64(2))
+		require.NoError(t, err)
+		require.Equal(t, expected, results)
+	}
+
+	_, err = replay("GetMainChainBlock", big.NewInt(5))
+	require.EqualError(t, err, "down")
+
+	_, err = replay("GetRootHash", uint64(1), uint64(3))
+	require.Error(t, err)
+}
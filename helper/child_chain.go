@@ -0,0 +1,93 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/zenanetwork/go-zenanet/common"
+	"github.com/zenanetwork/go-zenanet/ethclient"
+	"github.com/zenanetwork/go-zenanet/rpc"
+)
+
+// RPC clients of the other zena child chains, dialed on first use
+var (
+	childChainRPCClientsMu sync.Mutex
+	childChainRPCClients   = make(map[string]*rpc.Client)
+)
+
+// GetChildChainRPCUrl returns the RPC endpoint of a zena child chain set in child_chain_rpc_urls
+func GetChildChainRPCUrl(chainID string) (string, bool) {
+	for _, entry := range GetConfig().ChildChainRPCUrls {
+		id, url, ok := strings.Cut(entry, "=")
+		if ok && strings.TrimSpace(id) == chainID {
+			return strings.TrimSpace(url), true
+		}
+	}
+
+	return "", false
+}
+
+// GetChildChainIDs returns the ids of the zena child chains set in child_chain_rpc_urls
+func GetChildChainIDs() []string {
+	var chainIDs []string
+
+	for _, entry := range GetConfig().ChildChainRPCUrls {
+		if id, _, ok := strings.Cut(entry, "="); ok {
+			chainIDs = append(chainIDs, strings.TrimSpace(id))
+		}
+	}
+
+	return chainIDs
+}
+
+// getChildChainRPCClient returns the RPC client of a zena child chain, dialing it the first time
+func getChildChainRPCClient(chainID string) (*rpc.Client, string, error) {
+	url, ok := GetChildChainRPCUrl(chainID)
+	if !ok {
+		return nil, "", fmt.Errorf("no RPC endpoint for zena chain %s in child_chain_rpc_urls", chainID)
+	}
+
+	childChainRPCClientsMu.Lock()
+	defer childChainRPCClientsMu.Unlock()
+
+	if rpcClient, ok := childChainRPCClients[chainID]; ok {
+		return rpcClient, url, nil
+	}
+
+	rpcClient, err := rpc.Dial(url)
+	if err != nil {
+		return nil, "", err
+	}
+
+	childChainRPCClients[chainID] = rpcClient
+
+	return rpcClient, url, nil
+}
+
+// ForChildChain returns a contract caller reading the zena child chain with the given id, from its
+// endpoint in child_chain_rpc_urls. The main chain reads are unchanged.
+func (c *ContractCaller) ForChildChain(chainID string) (IContractCaller, error) {
+	rpcClient, url, err := getChildChainRPCClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	childCaller := *c
+	childCaller.MaticGrpcFlag = false
+	childCaller.MaticGrpcClient = nil
+	childCaller.MaticChainRPC = rpcClient
+	childCaller.MaticChainClient = ethclient.NewClient(rpcClient)
+	childCaller.MaticChainPool = NewRPCPool("zena-"+chainID, 1, NewRPCProvider(url, rpcClient))
+
+	// the contract instances are bound to the clients of the chain, and the system contracts
+	// of the child chains share their addresses
+	childCaller.ContractInstanceCache = make(map[common.Address]interface{})
+
+	if childCaller.ReceiptCache, err = lru.New(1000); err != nil {
+		return nil, err
+	}
+
+	return &childCaller, nil
+}
@@ -0,0 +1,56 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+)
+
+var (
+	metricsServer     *http.Server
+	metricsServerOnce sync.Once
+)
+
+// StartMetricsServer serves the default prometheus registry, which the node, the app and the
+// bridge register their metrics to, on metrics_addr. The server is started once per process,
+// so that the bridge started along with the node by `irisd start` shares it.
+func StartMetricsServer() {
+	metricsServerOnce.Do(func() {
+		cfg := rpcserver.DefaultConfig()
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+
+		metricsServer = &http.Server{
+			Addr:              GetConfig().MetricsAddr,
+			Handler:           mux,
+			ReadTimeout:       cfg.ReadTimeout,
+			ReadHeaderTimeout: cfg.ReadTimeout,
+			WriteTimeout:      cfg.WriteTimeout,
+			MaxHeaderBytes:    cfg.MaxHeaderBytes,
+		}
+
+		Logger.Info("Starting metrics server", "address", metricsServer.Addr)
+
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				Logger.Error("failed to start metrics server", "error", err)
+				os.Exit(1)
+			}
+		}()
+	})
+}
+
+// StopMetricsServer gracefully shuts down the metrics server, if started
+func StopMetricsServer(ctx context.Context) error {
+	if metricsServer == nil {
+		return nil
+	}
+
+	return metricsServer.Shutdown(ctx)
+}
@@ -8,6 +8,8 @@ import (
 	common "github.com/zenanetwork/go-zenanet/common"
 	erc20 "github.com/zenanetwork/iris/contracts/erc20"
 
+	helper "github.com/zenanetwork/iris/helper"
+
 	iristypes "github.com/zenanetwork/iris/types"
 
 	mock "github.com/stretchr/testify/mock"
@@ -784,6 +786,66 @@ func (_m *IContractCaller) GetMaticChainBlock(_a0 *big.Int) (*types.Header, erro
 	return r0, r1
 }
 
+// GetMaticChainBlockByHash provides a mock function with given fields: _a0
+func (_m *IContractCaller) GetMaticChainBlockByHash(_a0 common.Hash) (*types.Header, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMaticChainBlockByHash")
+	}
+
+	var r0 *types.Header
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.Hash) (*types.Header, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(common.Hash) *types.Header); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Header)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Hash) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ForChildChain provides a mock function with given fields: chainID
+func (_m *IContractCaller) ForChildChain(chainID string) (helper.IContractCaller, error) {
+	ret := _m.Called(chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForChildChain")
+	}
+
+	var r0 helper.IContractCaller
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (helper.IContractCaller, error)); ok {
+		return rf(chainID)
+	}
+	if rf, ok := ret.Get(0).(func(string) helper.IContractCaller); ok {
+		r0 = rf(chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(helper.IContractCaller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMaticTokenInstance provides a mock function with given fields: maticTokenAddress
 func (_m *IContractCaller) GetMaticTokenInstance(maticTokenAddress common.Address) (*erc20.Erc20, error) {
 	ret := _m.Called(maticTokenAddress)
@@ -0,0 +1,243 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/zenanetwork/go-zenanet"
+	"github.com/zenanetwork/go-zenanet/ethclient"
+	"github.com/zenanetwork/go-zenanet/rpc"
+)
+
+const (
+	// time a provider is skipped after a failure, doubled on each consecutive failure
+	rpcProviderBackoff    = 5 * time.Second
+	rpcProviderMaxBackoff = 5 * time.Minute
+)
+
+var (
+	rpcProviderRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "iris",
+		Subsystem: "rpc",
+		Name:      "provider_requests_total",
+		Help:      "The total number of requests sent to each RPC provider, by result",
+	}, []string{"chain", "provider", "method", "result"})
+
+	rpcProviderHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "iris",
+		Subsystem: "rpc",
+		Name:      "provider_healthy",
+		Help:      "Whether the RPC provider answered its last request",
+	}, []string{"chain", "provider"})
+
+	rpcQuorumReads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "iris",
+		Subsystem: "rpc",
+		Name:      "quorum_reads_total",
+		Help:      "The total number of quorum reads, by result (agreed, disagreed or failed)",
+	}, []string{"chain", "method", "result"})
+)
+
+// RPCProvider is an RPC endpoint of a chain
+type RPCProvider struct {
+	Name   string // host of the endpoint, used as metric label
+	RPC    *rpc.Client
+	Client *ethclient.Client
+
+	failures  int
+	downUntil time.Time
+}
+
+// NewRPCProvider creates a provider for the endpoint at rawURL served by rpcClient
+func NewRPCProvider(rawURL string, rpcClient *rpc.Client) *RPCProvider {
+	name := rawURL
+	// keep api keys in paths or queries out of metrics and logs
+	if u, err := url.Parse(rawURL); err == nil && u.Host != "" {
+		name = u.Host
+	}
+
+	return &RPCProvider{
+		Name:   name,
+		RPC:    rpcClient,
+		Client: ethclient.NewClient(rpcClient),
+	}
+}
+
+// RPCPool is the list of RPC providers of a chain.
+// Reads fail over from the first healthy provider to the next ones. Quorum reads
+// query every provider and need Quorum of them to return the same result.
+type RPCPool struct {
+	Chain  string
+	Quorum int
+
+	mu        sync.Mutex
+	providers []*RPCProvider
+}
+
+// NewRPCPool creates a pool of providers in order of preference
+func NewRPCPool(chain string, quorum int, providers ...*RPCProvider) *RPCPool {
+	for _, provider := range providers {
+		rpcProviderHealthy.WithLabelValues(chain, provider.Name).Set(1)
+	}
+
+	return &RPCPool{
+		Chain:     chain,
+		Quorum:    quorum,
+		providers: providers,
+	}
+}
+
+// Providers returns the providers, the healthy ones first, in order of preference
+func (p *RPCPool) Providers() []*RPCProvider {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	healthy := make([]*RPCProvider, 0, len(p.providers))
+	down := make([]*RPCProvider, 0)
+
+	for _, provider := range p.providers {
+		if now.Before(provider.downUntil) {
+			down = append(down, provider)
+		} else {
+			healthy = append(healthy, provider)
+		}
+	}
+
+	// when all are down, still try them rather than fail
+	return append(healthy, down...)
+}
+
+// Call runs fn against the providers until one succeeds
+func (p *RPCPool) Call(method string, fn func(*RPCProvider) (interface{}, error)) (interface{}, error) {
+	providers := p.Providers()
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no %v rpc provider", p.Chain)
+	}
+
+	var err error
+
+	for _, provider := range providers {
+		var result interface{}
+
+		result, err = fn(provider)
+		p.record(provider, method, err)
+
+		if err == nil {
+			return result, nil
+		}
+
+		Logger.Debug("RPC provider failed, failing over", "chain", p.Chain, "provider", provider.Name, "method", method, "error", err)
+	}
+
+	return nil, err
+}
+
+// QuorumCall runs fn against every provider and returns the result that at least Quorum
+// providers agree on, results being compared by key. It is a plain Call if Quorum is 1 or less.
+func (p *RPCPool) QuorumCall(method string, fn func(*RPCProvider) (interface{}, error), key func(interface{}) string) (interface{}, error) {
+	if p.Quorum <= 1 {
+		return p.Call(method, fn)
+	}
+
+	providers := p.Providers()
+	if len(providers) < p.Quorum {
+		rpcQuorumReads.WithLabelValues(p.Chain, method, "failed").Inc()
+		return nil, fmt.Errorf("quorum of %d %v rpc providers is not reachable with %d providers", p.Quorum, p.Chain, len(providers))
+	}
+
+	results := make([]interface{}, len(providers))
+	errs := make([]error, len(providers))
+
+	var wg sync.WaitGroup
+
+	for i, provider := range providers {
+		wg.Add(1)
+
+		go func(i int, provider *RPCProvider) {
+			defer wg.Done()
+
+			results[i], errs[i] = fn(provider)
+		}(i, provider)
+	}
+
+	wg.Wait()
+
+	// count the results in order of preference, so that ties go to preferred providers
+	keys := make([]string, len(providers))
+	votes := make(map[string]int)
+
+	var lastErr error
+
+	for i, provider := range providers {
+		p.record(provider, method, errs[i])
+
+		if errs[i] != nil {
+			lastErr = errs[i]
+			continue
+		}
+
+		keys[i] = key(results[i])
+		votes[keys[i]]++
+	}
+
+	for i := range providers {
+		if errs[i] == nil && votes[keys[i]] >= p.Quorum {
+			rpcQuorumReads.WithLabelValues(p.Chain, method, "agreed").Inc()
+			return results[i], nil
+		}
+	}
+
+	if len(votes) > 1 {
+		rpcQuorumReads.WithLabelValues(p.Chain, method, "disagreed").Inc()
+		Logger.Error("RPC providers disagree", "chain", p.Chain, "method", method, "quorum", p.Quorum, "results", votes)
+
+		return nil, fmt.Errorf("%v rpc providers disagree on %v", p.Chain, method)
+	}
+
+	rpcQuorumReads.WithLabelValues(p.Chain, method, "failed").Inc()
+
+	if lastErr == nil {
+		lastErr = errors.New("not enough answers")
+	}
+
+	return nil, fmt.Errorf("no quorum of %d %v rpc providers for %v: %w", p.Quorum, p.Chain, method, lastErr)
+}
+
+// record updates the health and metrics of provider after a request
+func (p *RPCPool) record(provider *RPCProvider, method string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// a lagging provider may not know the object yet, it is not unhealthy
+	if errors.Is(err, zenanet.NotFound) {
+		rpcProviderRequests.WithLabelValues(p.Chain, provider.Name, method, "not_found").Inc()
+		return
+	}
+
+	if err == nil {
+		provider.failures = 0
+		provider.downUntil = time.Time{}
+
+		rpcProviderRequests.WithLabelValues(p.Chain, provider.Name, method, "success").Inc()
+		rpcProviderHealthy.WithLabelValues(p.Chain, provider.Name).Set(1)
+
+		return
+	}
+
+	backoff := rpcProviderBackoff << provider.failures
+	if backoff > rpcProviderMaxBackoff || backoff <= 0 {
+		backoff = rpcProviderMaxBackoff
+	}
+
+	provider.failures++
+	provider.downUntil = time.Now().Add(backoff)
+
+	rpcProviderRequests.WithLabelValues(p.Chain, provider.Name, method, "error").Inc()
+	rpcProviderHealthy.WithLabelValues(p.Chain, provider.Name).Set(0)
+}
@@ -0,0 +1,120 @@
+package helper
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zenanetwork/go-zenanet"
+)
+
+func TestRPCPool(t *testing.T) {
+	t.Parallel()
+
+	// answers of each provider, by name
+	newPool := func(quorum int, answers map[string]interface{}) (*RPCPool, func(*RPCProvider) (interface{}, error), map[string]int) {
+		var mu sync.Mutex
+
+		calls := make(map[string]int)
+		providers := []*RPCProvider{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+
+		fn := func(provider *RPCProvider) (interface{}, error) {
+			mu.Lock()
+			calls[provider.Name]++
+			mu.Unlock()
+
+			if err, ok := answers[provider.Name].(error); ok {
+				return nil, err
+			}
+
+			return answers[provider.Name], nil
+		}
+
+		return NewRPCPool("test", quorum, providers...), fn, calls
+	}
+
+	key := func(result interface{}) string {
+		return result.(string)
+	}
+
+	t.Run("failover", func(t *testing.T) {
+		t.Parallel()
+
+		pool, fn, calls := newPool(1, map[string]interface{}{"a": errors.New("down"), "b": "0x1", "c": "0x2"})
+
+		result, err := pool.Call("test", fn)
+		require.NoError(t, err)
+		require.Equal(t, "0x1", result)
+
+		// a is skipped while it is down
+		result, err = pool.Call("test", fn)
+		require.NoError(t, err)
+		require.Equal(t, "0x1", result)
+		require.Equal(t, 1, calls["a"])
+		require.Equal(t, 2, calls["b"])
+		require.Equal(t, 0, calls["c"])
+
+		providers := pool.Providers()
+		require.Equal(t, "a", providers[len(providers)-1].Name)
+	})
+
+	t.Run("not found is not a failure", func(t *testing.T) {
+		t.Parallel()
+
+		pool, fn, _ := newPool(1, map[string]interface{}{"a": zenanet.NotFound, "b": "0x1", "c": "0x1"})
+
+		result, err := pool.Call("test", fn)
+		require.NoError(t, err)
+		require.Equal(t, "0x1", result)
+		require.Equal(t, "a", pool.Providers()[0].Name)
+	})
+
+	t.Run("all down", func(t *testing.T) {
+		t.Parallel()
+
+		pool, fn, _ := newPool(1, map[string]interface{}{"a": errors.New("down"), "b": errors.New("down"), "c": errors.New("down")})
+
+		_, err := pool.Call("test", fn)
+		require.Error(t, err)
+		require.Len(t, pool.Providers(), 3)
+	})
+
+	t.Run("quorum agreed", func(t *testing.T) {
+		t.Parallel()
+
+		pool, fn, _ := newPool(2, map[string]interface{}{"a": "0x1", "b": "0x2", "c": "0x2"})
+
+		result, err := pool.QuorumCall("test", fn, key)
+		require.NoError(t, err)
+		require.Equal(t, "0x2", result)
+	})
+
+	t.Run("quorum with a failed provider", func(t *testing.T) {
+		t.Parallel()
+
+		pool, fn, _ := newPool(2, map[string]interface{}{"a": "0x1", "b": errors.New("down"), "c": "0x1"})
+
+		result, err := pool.QuorumCall("test", fn, key)
+		require.NoError(t, err)
+		require.Equal(t, "0x1", result)
+	})
+
+	t.Run("quorum disagreed", func(t *testing.T) {
+		t.Parallel()
+
+		pool, fn, _ := newPool(2, map[string]interface{}{"a": "0x1", "b": "0x2", "c": errors.New("down")})
+
+		_, err := pool.QuorumCall("test", fn, key)
+		require.Error(t, err)
+	})
+
+	t.Run("quorum failed", func(t *testing.T) {
+		t.Parallel()
+
+		pool, fn, _ := newPool(3, map[string]interface{}{"a": "0x1", "b": "0x1", "c": errors.New("down")})
+
+		_, err := pool.QuorumCall("test", fn, key)
+		require.Error(t, err)
+	})
+}
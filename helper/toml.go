@@ -20,6 +20,20 @@ eth_rpc_url = "{{ .EthRPCUrl }}"
 # RPC endpoint for zena chain
 zena_rpc_url = "{{ .ZenaRPCUrl }}"
 
+# RPC endpoints for ethereum chain tried in order when eth_rpc_url fails, e.g. ["https://a", "https://b"]
+eth_rpc_fallback_urls = [{{ range $i, $url := .EthRPCFallbackUrls }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# RPC endpoints for zena chain tried in order when zena_rpc_url fails
+zena_rpc_fallback_urls = [{{ range $i, $url := .ZenaRPCFallbackUrls }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Number of ethereum/zena RPC endpoints that must return the same receipts, blocks, root hashes
+# and header block numbers before they are used in side-tx votes (0 or 1: first endpoint that answers)
+eth_rpc_quorum = "{{ .EthRPCQuorum }}"
+zena_rpc_quorum = "{{ .ZenaRPCQuorum }}"
+
+# RPC endpoints of the other zena child chains secured by iris, e.g. ["15002=http://localhost:8645"]
+child_chain_rpc_urls = [{{ range $i, $url := .ChildChainRPCUrls }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
 # GRPC flag for zena chain
 zena_grpc_flag = "{{ .ZenaGRPCFlag }}"
 
@@ -29,7 +43,8 @@ zena_grpc_url = "{{ .ZenaGRPCUrl }}"
 # RPC endpoint for tendermint
 tendermint_rpc_url = "{{ .TendermintRPCUrl }}"
 
-# Polygon Sub Graph URL for self-heal mechanism (optional)
+# Polygon Sub Graph URL for self-heal mechanism (optional).
+# Without it, self-heal scans the main chain logs with eth_getLogs instead.
 sub_graph_url = "{{ .SubGraphUrl }}"
 
 #### Bridge configs ####
@@ -40,6 +55,17 @@ iris_rest_server = "{{ .IrisServerURL }}"
 # AMQP endpoint
 amqp_url = "{{ .AmqpURL }}"
 
+# Bridge queue backend: "amqp" (RabbitMQ at amqp_url) or "embedded" (in-process, journaled in the bridge db)
+queue_backend = "{{ .QueueBackend }}"
+
+# Address the prometheus metrics of the node, the app and the bridge are served on (/metrics).
+# Keep it apart from prometheus_listen_addr in config.toml, which serves the same registry.
+metrics_addr = "{{ .MetricsAddr }}"
+
+# Local address of the bridge admin API, used by "irisd bridge tasks" to inspect, retry and drop tasks.
+# Keep it on a loopback address.
+bridge_admin_addr = "{{ .BridgeAdminAddr }}"
+
 ## Poll intervals
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}"
 syncer_poll_interval = "{{ .SyncerPollInterval }}"
@@ -50,7 +76,11 @@ milestone_poll_interval = "{{ .MilestonePollInterval }}"
 enable_self_heal = "{{ .EnableSH }}"
 sh_state_synced_interval = "{{ .SHStateSyncedInterval }}"
 sh_stake_update_interval = "{{ .SHStakeUpdateInterval }}"
+sh_events_interval = "{{ .SHEventsInterval }}"
 sh_max_depth_duration = "{{ .SHMaxDepthDuration }}"
+# Main chain block to scan the logs from, and max blocks per eth_getLogs request, to self-heal without sub_graph_url
+sh_logs_start_block = "{{ .SHLogsStartBlock }}"
+sh_logs_block_range = "{{ .SHLogsBlockRange }}"
 
 
 #### gas limits ####
@@ -58,10 +88,28 @@ main_chain_gas_limit = "{{ .MainchainGasLimit }}"
 
 #### gas price ####
 main_chain_max_gas_price = "{{ .MainchainMaxGasPrice }}"
+# Time a mainchain tx of the bridge waits to be mined before it is replaced with fees bumped by main_chain_tx_fee_bump percent (at least 10)
+main_chain_tx_timeout = "{{ .MainchainTxTimeout }}"
+main_chain_tx_fee_bump = "{{ .MainchainTxFeeBump }}"
+
+##### Snapshot Config #####
+# Number of blocks between the snapshots the node writes to data/snapshots, for "irisd snapshot restore" (0 disables them)
+snapshot_interval = "{{ .SnapshotInterval }}"
+# Number of recent snapshots kept, the older ones are deleted
+snapshot_keep_recent = "{{ .SnapshotKeepRecent }}"
+
+##### Signer Config #####
+# Signer of the validator key: "local" (priv_validator_key.json) or "grpc" (signing service at signer_addr)
+signer = "{{ .Signer }}"
+signer_addr = "{{ .SignerAddr }}"
 
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
+##### Debug Config #####
+# Directory the chain reads of side-tx votes are recorded to, for "irisd debug replay-side-tx" (empty: disabled)
+side_tx_record_dir = "{{ .SideTxRecordDir }}"
+
 ##### chain - newSelectionAlgoHeight depends on this #####
 chain = "{{ .Chain }}"
 `
@@ -13,13 +13,13 @@ import (
 	"github.com/zenanetwork/go-zenanet"
 	"github.com/zenanetwork/go-zenanet/accounts/abi/bind"
 	"github.com/zenanetwork/go-zenanet/common"
-	"github.com/zenanetwork/go-zenanet/crypto"
 	"github.com/zenanetwork/go-zenanet/ethclient"
 
 	"github.com/zenanetwork/iris/contracts/erc20"
 	"github.com/zenanetwork/iris/contracts/rootchain"
 	"github.com/zenanetwork/iris/contracts/slashmanager"
 	"github.com/zenanetwork/iris/contracts/stakemanager"
+	"github.com/zenanetwork/iris/signer"
 )
 
 func GenerateAuthObj(client *ethclient.Client, address common.Address, data []byte) (auth *bind.TransactOpts, err error) {
@@ -29,28 +29,18 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 		Data: data,
 	}
 
-	// get priv key
-	pkObject := GetPrivKey()
-
-	// create ecdsa private key
-	ecdsaPrivateKey, err := crypto.ToECDSA(pkObject[:])
-	if err != nil {
-		return
-	}
+	// get validator key signer
+	validatorSigner := GetSigner()
 
 	// from address
-	fromAddress := common.BytesToAddress(pkObject.PubKey().Address().Bytes())
+	fromAddress := signer.Address(validatorSigner)
 	// fetch gas price
 	gasprice, err := client.SuggestGasPrice(context.Background())
 	if err != nil {
 		return
 	}
 
-	mainChainMaxGasPrice := GetConfig().MainchainMaxGasPrice
-	// Check if configured or not, Use default in case of invalid value
-	if mainChainMaxGasPrice <= 0 {
-		mainChainMaxGasPrice = DefaultMainchainMaxGasPrice
-	}
+	mainChainMaxGasPrice := GetMainchainMaxGasPrice().Int64()
 
 	if gasprice.Cmp(big.NewInt(mainChainMaxGasPrice)) == 1 {
 		Logger.Error("Gas price is more than max gas price", "gasprice", gasprice)
@@ -76,7 +66,7 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	}
 
 	// create auth
-	auth, err = bind.NewKeyedTransactorWithChainID(ecdsaPrivateKey, chainId)
+	auth, err = signer.NewTransactor(validatorSigner, chainId)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
 		return
@@ -93,6 +83,79 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	return
 }
 
+// GetMainchainMaxGasPrice returns the configured max gas price of mainchain txs, or the default in case of invalid value
+func GetMainchainMaxGasPrice() *big.Int {
+	mainChainMaxGasPrice := GetConfig().MainchainMaxGasPrice
+	if mainChainMaxGasPrice <= 0 {
+		mainChainMaxGasPrice = DefaultMainchainMaxGasPrice
+	}
+
+	return big.NewInt(mainChainMaxGasPrice)
+}
+
+// SuggestDynamicFees suggests the EIP-1559 tip and fee caps of a mainchain tx, leaving room for
+// the base fee to double. The fee cap is capped by the max gas price.
+func SuggestDynamicFees(client *ethclient.Client) (gasTipCap *big.Int, gasFeeCap *big.Int, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	gasTipCap, err = client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if header.BaseFee == nil {
+		return nil, nil, errors.New("mainchain does not support EIP-1559 txs")
+	}
+
+	maxGasPrice := GetMainchainMaxGasPrice()
+
+	if minFeeCap := new(big.Int).Add(header.BaseFee, gasTipCap); minFeeCap.Cmp(maxGasPrice) == 1 {
+		return nil, nil, fmt.Errorf("gas price is more than max_gas_price, gasprice = %v, maxGasPrice = %v", minFeeCap, maxGasPrice)
+	}
+
+	gasFeeCap = new(big.Int).Add(new(big.Int).Mul(header.BaseFee, big.NewInt(2)), gasTipCap)
+	if gasFeeCap.Cmp(maxGasPrice) == 1 {
+		gasFeeCap = maxGasPrice
+	}
+
+	return gasTipCap, gasFeeCap, nil
+}
+
+// BumpDynamicFees raises the EIP-1559 tip and fee caps of a tx by bumpPercent, to replace it in the mempool,
+// and at least to the suggested ones. It fails if the fee cap exceeds maxGasPrice.
+func BumpDynamicFees(gasTipCap, gasFeeCap, suggestedTipCap, suggestedFeeCap *big.Int, bumpPercent uint64, maxGasPrice *big.Int) (*big.Int, *big.Int, error) {
+	bump := func(fee *big.Int) *big.Int {
+		bumped := new(big.Int).Mul(fee, new(big.Int).SetUint64(100+bumpPercent))
+		return bumped.Div(bumped, big.NewInt(100))
+	}
+
+	newTipCap := bump(gasTipCap)
+	if suggestedTipCap.Cmp(newTipCap) == 1 {
+		newTipCap = new(big.Int).Set(suggestedTipCap)
+	}
+
+	newFeeCap := bump(gasFeeCap)
+	if suggestedFeeCap.Cmp(newFeeCap) == 1 {
+		newFeeCap = new(big.Int).Set(suggestedFeeCap)
+	}
+
+	if newTipCap.Cmp(newFeeCap) == 1 {
+		newFeeCap = new(big.Int).Set(newTipCap)
+	}
+
+	if newFeeCap.Cmp(maxGasPrice) == 1 {
+		return nil, nil, fmt.Errorf("bumped fee cap is more than max_gas_price, feeCap = %v, maxGasPrice = %v", newFeeCap, maxGasPrice)
+	}
+
+	return newTipCap, newFeeCap, nil
+}
+
 // SendCheckpoint sends checkpoint to rootchain contract
 // todo return err
 func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress common.Address, rootChainInstance *rootchain.Rootchain) (er error) {
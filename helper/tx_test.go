@@ -0,0 +1,36 @@
+package helper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBumpDynamicFees(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := big.NewInt(1000)
+
+	// bumped by the percent
+	tipCap, feeCap, err := BumpDynamicFees(big.NewInt(10), big.NewInt(100), big.NewInt(5), big.NewInt(50), 20, maxGasPrice)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(12), tipCap)
+	require.Equal(t, big.NewInt(120), feeCap)
+
+	// raised to the suggested fees
+	tipCap, feeCap, err = BumpDynamicFees(big.NewInt(10), big.NewInt(100), big.NewInt(30), big.NewInt(300), 20, maxGasPrice)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(30), tipCap)
+	require.Equal(t, big.NewInt(300), feeCap)
+
+	// the fee cap covers the tip
+	tipCap, feeCap, err = BumpDynamicFees(new(big.Int), new(big.Int), big.NewInt(30), big.NewInt(20), 20, maxGasPrice)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(30), tipCap)
+	require.Equal(t, big.NewInt(30), feeCap)
+
+	// capped by the max gas price
+	_, _, err = BumpDynamicFees(big.NewInt(10), big.NewInt(900), big.NewInt(5), big.NewInt(50), 20, maxGasPrice)
+	require.Error(t, err)
+}
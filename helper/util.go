@@ -371,7 +371,7 @@ func GetSignedTxBytes(cliCtx context.CLIContext,
 			return nil, nil
 		}
 		txBldr = txBldr.WithChainID(testOpts[0].chainId)
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return txBldr.BuildAndSignWithSigner(GetSigner(), msgs)
 	}
 
 	txBldr, err := PrepareTxBuilder(cliCtx, txBldr)
@@ -381,7 +381,7 @@ func GetSignedTxBytes(cliCtx context.CLIContext,
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return txBldr.BuildAndSignWithSigner(GetSigner(), msgs)
 	}
 
 	if !cliCtx.SkipConfirm {
@@ -428,7 +428,7 @@ func GetSignedTxBytesWithCLI(cliCtx context.CLIContext, txBldr authTypes.TxBuild
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return txBldr.BuildAndSignWithSigner(GetSigner(), msgs)
 	}
 
 	if cliCtx.Simulate {
@@ -563,7 +563,7 @@ func SignStdTx(cliCtx context.CLIContext, stdTx authTypes.StdTx, appendSig bool,
 		return txBldr.SignStdTxWithPassphrase(fromName, passphrase, stdTx, appendSig)
 	}
 
-	return txBldr.SignStdTx(GetPrivKey(), stdTx, appendSig)
+	return txBldr.SignStdTxWithSigner(GetSigner(), stdTx, appendSig)
 }
 
 // ReadStdTxFromFile and decode a StdTx from the given filename.  Can pass "-" to read from stdin.
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/spf13/cobra"
+
+	"github.com/zenanetwork/iris/logregistry/types"
+	"github.com/zenanetwork/iris/version"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the logregistry module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetQueryParams(cdc),
+			GetQueryProcessedLog(cdc),
+		)...,
+	)
+
+	return queryCmd
+}
+
+// GetQueryParams implements the params query command.
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current logregistry parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as logregistry parameters.
+
+Example:
+$ %s query logregistry params
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err = jsoniter.ConfigFastest.Unmarshal(bz, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetQueryProcessedLog implements the processed log query command.
+func GetQueryProcessedLog(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "processed-log [tx-hash] [log-index]",
+		Args:  cobra.ExactArgs(2),
+		Short: "show the processed log of a L1 tx",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the log of a L1 tx was processed, and by which module.
+
+Example:
+$ %s query logregistry processed-log 0x9d8f...2e4a 3
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			logIndex, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid log index: %w", err)
+			}
+
+			data, err := cliCtx.Codec.MarshalJSON(types.NewQueryProcessedLogParams(args[0], logIndex))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProcessedLog)
+			bz, _, err := cliCtx.QueryWithData(route, data)
+			if err != nil {
+				return err
+			}
+
+			if len(bz) == 0 {
+				return fmt.Errorf("log %d of tx %s was not processed", logIndex, args[0])
+			}
+
+			var processedLog types.ProcessedLog
+			if err = jsoniter.ConfigFastest.Unmarshal(bz, &processedLog); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(processedLog)
+		},
+	}
+}
@@ -0,0 +1,134 @@
+// nolint
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/zenanetwork/iris/logregistry/types"
+	hmRest "github.com/zenanetwork/iris/types/rest"
+)
+
+//swagger:response logregistryParamsResponse
+type logregistryParamsResponse struct {
+	//in:body
+	Output logregistryParams `json:"output"`
+}
+
+type logregistryParams struct {
+	Height string       `json:"height"`
+	Result types.Params `json:"result"`
+}
+
+// It represents the processed log of a tx
+//
+//swagger:response logregistryIsOldTxResponse
+type logregistryIsOldTxResponse struct {
+	//in:body
+	Output logregistryIsOldTx `json:"output"`
+}
+
+type logregistryIsOldTx struct {
+	Height string             `json:"height"`
+	Result types.ProcessedLog `json:"result"`
+}
+
+// swagger:route GET /logregistry/params logregistry logregistryParams
+// It returns the logregistry parameters
+// responses:
+//
+//	200: logregistryParamsResponse
+func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// swagger:route GET /logregistry/isoldtx logregistry logregistryIsOldTx
+// It returns the processed log of a tx, whichever module processed it.
+// responses:
+//
+//	200: logregistryIsOldTxResponse
+func processedLogHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get logIndex
+		logIndex, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("logindex"))
+		if !ok {
+			return
+		}
+
+		txHash := vars.Get("txhash")
+		if txHash == "" {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "txhash is required")
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProcessedLogParams(txHash, logIndex))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProcessedLog)
+
+		res, height, err := cliCtx.QueryWithData(route, queryParams)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// error if the log was not processed
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No processed log found"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//swagger:parameters logregistryParams logregistryIsOldTx
+type Height struct {
+
+	//Block Height
+	//in:query
+	Height string `json:"height"`
+}
+
+//swagger:parameters logregistryIsOldTx
+type logregistryTxParams struct {
+
+	//Log Index of the transaction
+	//required:true
+	//in:query
+	LogIndex int64 `json:"logindex"`
+
+	//Hash of the transaction
+	//required:true
+	//in:query
+	Txhash string `json:"txhash"`
+}
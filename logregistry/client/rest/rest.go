@@ -0,0 +1,12 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes registers the logregistry module REST routes.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/logregistry/params", paramsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/logregistry/isoldtx", processedLogHandlerFn(cliCtx)).Methods("GET")
+}
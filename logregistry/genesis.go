@@ -0,0 +1,48 @@
+package logregistry
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zenanetwork/iris/logregistry/types"
+)
+
+// InitGenesis sets the logregistry module's state from genesis. Before the store is added,
+// only the params are set.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	if !keeper.upgradeKeeper.HasStores(ctx) {
+		return
+	}
+
+	for _, processedLog := range data.ProcessedLogs {
+		keeper.SetProcessedLog(ctx, processedLog)
+	}
+
+	keeper.setUint64(ctx, types.PrunedBelowKey, data.PrunedBelow)
+	keeper.setUint64(ctx, types.LastAckCountKey, data.LastAckCount)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	if !keeper.upgradeKeeper.HasStores(ctx) {
+		return types.NewGenesisState(keeper.GetParams(ctx), []types.ProcessedLog{}, 0, 0)
+	}
+
+	// the logs below the pruned block not deleted yet are left out
+	prunedBelow := keeper.GetPrunedBelow(ctx)
+
+	processedLogs := []types.ProcessedLog{}
+	for _, processedLog := range keeper.GetProcessedLogs(ctx) {
+		if processedLog.BlockNumber >= prunedBelow {
+			processedLogs = append(processedLogs, processedLog)
+		}
+	}
+
+	return types.NewGenesisState(
+		keeper.GetParams(ctx),
+		processedLogs,
+		prunedBelow,
+		keeper.GetLastAckCount(ctx),
+	)
+}
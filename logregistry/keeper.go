@@ -0,0 +1,234 @@
+package logregistry
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/zenanetwork/iris/chainmanager"
+	"github.com/zenanetwork/iris/logregistry/types"
+	"github.com/zenanetwork/iris/params/subspace"
+	"github.com/zenanetwork/iris/upgrade"
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
+)
+
+// ModuleCommunicator manages different module interaction
+type ModuleCommunicator interface {
+	GetACKCount(ctx sdk.Context) uint64
+	GetLastAckBlock(ctx sdk.Context) uint64
+}
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// param space
+	paramSpace subspace.Subspace
+	// chain manager keeper
+	chainKeeper chainmanager.Keeper
+	// upgrade keeper
+	upgradeKeeper upgrade.Keeper
+	// module communicator
+	moduleCommunicator ModuleCommunicator
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	paramSpace subspace.Subspace,
+	codespace sdk.CodespaceType,
+	chainKeeper chainmanager.Keeper,
+	upgradeKeeper upgrade.Keeper,
+	moduleCommunicator ModuleCommunicator,
+) Keeper {
+	return Keeper{
+		cdc:                cdc,
+		storeKey:           storeKey,
+		paramSpace:         paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:          codespace,
+		chainKeeper:        chainKeeper,
+		upgradeKeeper:      upgradeKeeper,
+		moduleCommunicator: moduleCommunicator,
+	}
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// IsActive returns true if the processed logs are recorded by the registry at the block.
+// Before, every module keeps its own sequences.
+func (k Keeper) IsActive(ctx sdk.Context) bool {
+	return k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.LogRegistryUpgrade)
+}
+
+// -----------------------------------------------------------------------------
+// Processed logs
+
+// SetProcessedLog records a processed log
+func (k Keeper) SetProcessedLog(ctx sdk.Context, processedLog types.ProcessedLog) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetProcessedLogKey(processedLog.BlockNumber, processedLog.LogIndex), k.cdc.MustMarshalBinaryBare(processedLog))
+}
+
+// HasProcessedLog checks if the log at logIndex of the L1 block was processed by any module.
+// The logs below the pruned block are processed, as the pruned block is the prune depth of L1
+// blocks behind the last acked checkpoint, past which no log is synced late.
+func (k Keeper) HasProcessedLog(ctx sdk.Context, blockNumber uint64, logIndex uint64) bool {
+	if blockNumber < k.GetPrunedBelow(ctx) {
+		return true
+	}
+
+	return ctx.KVStore(k.storeKey).Has(types.GetProcessedLogKey(blockNumber, logIndex))
+}
+
+// GetProcessedLog returns the log at logIndex of the L1 block if it is recorded
+func (k Keeper) GetProcessedLog(ctx sdk.Context, blockNumber uint64, logIndex uint64) (processedLog types.ProcessedLog, ok bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetProcessedLogKey(blockNumber, logIndex))
+	if bz == nil {
+		return processedLog, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &processedLog)
+
+	return processedLog, true
+}
+
+// GetProcessedLogs returns all recorded logs, by L1 block number and log index
+func (k Keeper) GetProcessedLogs(ctx sdk.Context) (processedLogs []types.ProcessedLog) {
+	k.IterateProcessedLogsAndApplyFn(ctx, func(processedLog types.ProcessedLog) error {
+		processedLogs = append(processedLogs, processedLog)
+		return nil
+	})
+
+	return
+}
+
+// IterateProcessedLogsAndApplyFn iterates the recorded logs and applies the given function.
+func (k Keeper) IterateProcessedLogsAndApplyFn(ctx sdk.Context, f func(processedLog types.ProcessedLog) error) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ProcessedLogPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var processedLog types.ProcessedLog
+
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &processedLog)
+
+		if err := f(processedLog); err != nil {
+			return
+		}
+	}
+}
+
+// MigrateSequences records the sequences a module kept the logs it processed under.
+// The migrated logs have no tx hash.
+func (k Keeper) MigrateSequences(ctx sdk.Context, module string, sequences []string) {
+	for _, sequence := range sequences {
+		blockNumber, logIndex, err := types.ParseSequence(sequence)
+		if err != nil {
+			k.Logger(ctx).Error("Skipping invalid sequence", "module", module, "sequence", sequence, "error", err)
+			continue
+		}
+
+		k.SetProcessedLog(ctx, types.ProcessedLog{
+			Module:      module,
+			LogIndex:    logIndex,
+			BlockNumber: blockNumber,
+		})
+	}
+
+	k.Logger(ctx).Info("Migrated sequences to the log registry", "module", module, "count", len(sequences))
+}
+
+// -----------------------------------------------------------------------------
+// Pruning
+
+// PruneProcessedLogs moves, when a new checkpoint is acked, the pruned block to the prune depth
+// of L1 blocks behind the block of the ack, and deletes at most MaxPrunedLogsPerBlock logs below
+// the pruned block. The logs below the pruned block stay processed.
+func (k Keeper) PruneProcessedLogs(ctx sdk.Context) {
+	if ackCount := k.moduleCommunicator.GetACKCount(ctx); ackCount > k.GetLastAckCount(ctx) {
+		k.setUint64(ctx, types.LastAckCountKey, ackCount)
+
+		depth := k.GetParams(ctx).PruneDepth
+		ackBlock := k.moduleCommunicator.GetLastAckBlock(ctx)
+
+		if depth != 0 && ackBlock > depth && ackBlock-depth > k.GetPrunedBelow(ctx) {
+			k.setUint64(ctx, types.PrunedBelowKey, ackBlock-depth)
+			k.Logger(ctx).Info("Moved the pruned block of the processed logs", "prunedBelow", ackBlock-depth, "ackBlock", ackBlock, "ackCount", ackCount)
+		}
+	}
+
+	prunedBelow := k.GetPrunedBelow(ctx)
+	if prunedBelow == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// the keys sort by block number, up to the first log of the pruned block
+	iterator := store.Iterator(types.ProcessedLogPrefixKey, types.GetBlockProcessedLogsKey(prunedBelow))
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < types.MaxPrunedLogsPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	if len(keys) > 0 {
+		k.Logger(ctx).Debug("Pruned processed logs", "prunedBelow", prunedBelow, "count", len(keys))
+	}
+}
+
+// GetPrunedBelow returns the L1 block below which the processed logs are pruned
+func (k Keeper) GetPrunedBelow(ctx sdk.Context) uint64 {
+	return k.getUint64(ctx, types.PrunedBelowKey)
+}
+
+// GetLastAckCount returns the checkpoint ack count the processed logs were last pruned at
+func (k Keeper) GetLastAckCount(ctx sdk.Context) uint64 {
+	return k.getUint64(ctx, types.LastAckCountKey)
+}
+
+func (k Keeper) getUint64(ctx sdk.Context, key []byte) (value uint64) {
+	if bz := ctx.KVStore(k.storeKey).Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &value)
+	}
+
+	return
+}
+
+func (k Keeper) setUint64(ctx sdk.Context, key []byte, value uint64) {
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(value))
+}
+
+// -----------------------------------------------------------------------------
+// Params
+
+// SetParams sets the logregistry module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the logregistry module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	params = types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+
+	return
+}
@@ -0,0 +1,202 @@
+package logregistry_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/zenanetwork/iris/app"
+	"github.com/zenanetwork/iris/logregistry"
+	"github.com/zenanetwork/iris/logregistry/types"
+	hmTypes "github.com/zenanetwork/iris/types"
+	"github.com/zenanetwork/iris/upgrade"
+	upgradeTypes "github.com/zenanetwork/iris/upgrade/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.IrisApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(false)
+	suite.ctx = suite.ctx.WithBlockHeight(5)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// Tests
+
+func (suite *KeeperTestSuite) TestSetProcessedLog() {
+	t, k, ctx := suite.T(), suite.app.LogRegistryKeeper, suite.ctx
+
+	txHash := hmTypes.HexToIrisHash("123")
+
+	require.True(t, k.IsActive(ctx))
+	require.False(t, k.HasProcessedLog(ctx, 10, 2))
+
+	k.SetProcessedLog(ctx, types.NewProcessedLog("clerk", txHash, 2, 10))
+	k.SetProcessedLog(ctx, types.NewProcessedLog("topup", txHash, 1, 5))
+
+	require.True(t, k.HasProcessedLog(ctx, 10, 2))
+	require.False(t, k.HasProcessedLog(ctx, 10, 1))
+
+	processedLog, ok := k.GetProcessedLog(ctx, 10, 2)
+	require.True(t, ok)
+	require.Equal(t, "clerk", processedLog.Module)
+	require.Equal(t, txHash, processedLog.TxHash)
+
+	// sorted by block number
+	processedLogs := k.GetProcessedLogs(ctx)
+	require.Len(t, processedLogs, 2)
+	require.Equal(t, uint64(5), processedLogs[0].BlockNumber)
+	require.Equal(t, uint64(10), processedLogs[1].BlockNumber)
+
+	// any module sees the logs of the others
+	require.True(t, suite.app.StakingKeeper.HasProcessedLog(ctx, 5, 1))
+	require.True(t, suite.app.TopupKeeper.HasProcessedLog(ctx, 10, 2))
+}
+
+func (suite *KeeperTestSuite) TestPruneProcessedLogs() {
+	t, k, ctx := suite.T(), suite.app.LogRegistryKeeper, suite.ctx
+
+	for _, blockNumber := range []uint64{5, 20, 30} {
+		k.SetProcessedLog(ctx, types.NewProcessedLog("staking", hmTypes.HexToIrisHash("123"), 0, blockNumber))
+	}
+
+	suite.app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
+
+	// pruning is disabled by default
+	k.PruneProcessedLogs(ctx)
+	require.Len(t, k.GetProcessedLogs(ctx), 3)
+	require.Equal(t, uint64(0), k.GetPrunedBelow(ctx))
+	require.Equal(t, uint64(1), k.GetLastAckCount(ctx))
+
+	k.SetParams(ctx, types.Params{PruneDepth: 10})
+
+	// no new checkpoint acked
+	k.PruneProcessedLogs(ctx)
+	require.Len(t, k.GetProcessedLogs(ctx), 3)
+
+	// anchored on the L1 block of the ack, not on the latest processed log
+	suite.app.CheckpointKeeper.SetLastAckBlock(ctx, 30)
+	suite.app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 2)
+	k.PruneProcessedLogs(ctx)
+
+	processedLogs := k.GetProcessedLogs(ctx)
+	require.Len(t, processedLogs, 2)
+	require.Equal(t, uint64(20), processedLogs[0].BlockNumber)
+	require.Equal(t, uint64(20), k.GetPrunedBelow(ctx))
+
+	// the pruned logs stay processed, the late logs above the pruned block do not
+	require.True(t, k.HasProcessedLog(ctx, 5, 0))
+	require.True(t, k.HasProcessedLog(ctx, 19, 7))
+	require.False(t, k.HasProcessedLog(ctx, 20, 7))
+	require.False(t, k.HasProcessedLog(ctx, 25, 0))
+
+	// an older ack block does not move the pruned block back
+	suite.app.CheckpointKeeper.SetLastAckBlock(ctx, 25)
+	suite.app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 3)
+	k.PruneProcessedLogs(ctx)
+	require.Equal(t, uint64(20), k.GetPrunedBelow(ctx))
+}
+
+func (suite *KeeperTestSuite) TestPruneProcessedLogsCap() {
+	t, k, ctx := suite.T(), suite.app.LogRegistryKeeper, suite.ctx
+
+	for logIndex := 0; logIndex < types.MaxPrunedLogsPerBlock+10; logIndex++ {
+		k.SetProcessedLog(ctx, types.NewProcessedLog("clerk", hmTypes.HexToIrisHash("123"), uint64(logIndex), 5))
+	}
+
+	k.SetParams(ctx, types.Params{PruneDepth: 10})
+	suite.app.CheckpointKeeper.SetLastAckBlock(ctx, 100)
+	suite.app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
+
+	k.PruneProcessedLogs(ctx)
+	require.Len(t, k.GetProcessedLogs(ctx), 10)
+	require.Equal(t, uint64(90), k.GetPrunedBelow(ctx))
+
+	// the rest is pruned in the next block, without a new ack
+	k.PruneProcessedLogs(ctx)
+	require.Empty(t, k.GetProcessedLogs(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigrateSequences() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	app.StakingKeeper.SetStakingSequence(ctx, types.GetSequence(10, 1))
+	app.ClerkKeeper.SetRecordSequence(ctx, types.GetSequence(10, 2))
+	app.TopupKeeper.SetTopupSequence(ctx, types.GetSequence(11, 0))
+	app.SlashingKeeper.SetSlashingSequence(ctx, types.GetSequence(12, 3))
+	app.TopupKeeper.SetTopupSequence(ctx, "invalid")
+
+	app.StakingKeeper.MigrateStakingSequences(ctx)
+	app.ClerkKeeper.MigrateRecordSequences(ctx)
+	app.TopupKeeper.MigrateTopupSequences(ctx)
+	app.SlashingKeeper.MigrateSlashingSequences(ctx)
+
+	k := app.LogRegistryKeeper
+	require.True(t, k.HasProcessedLog(ctx, 10, 1))
+	require.True(t, k.HasProcessedLog(ctx, 10, 2))
+	require.True(t, k.HasProcessedLog(ctx, 11, 0))
+	require.True(t, k.HasProcessedLog(ctx, 12, 3))
+	require.Len(t, k.GetProcessedLogs(ctx), 4)
+
+	processedLog, ok := k.GetProcessedLog(ctx, 12, 3)
+	require.True(t, ok)
+	require.Equal(t, "slashing", processedLog.Module)
+
+	require.Empty(t, app.StakingKeeper.GetStakingSequences(ctx))
+	require.Empty(t, app.ClerkKeeper.GetRecordSequences(ctx))
+	require.Empty(t, app.TopupKeeper.GetTopupSequences(ctx))
+	require.Empty(t, app.SlashingKeeper.GetSlashingSequences(ctx))
+}
+
+func (suite *KeeperTestSuite) TestUpgrade() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	upgrade.InitGenesis(ctx, app.UpgradeKeeper, upgradeTypes.NewGenesisState([]upgradeTypes.Plan{
+		upgradeTypes.NewPlan(upgradeTypes.LogRegistryUpgrade, 10, ""),
+	}))
+	upgrade.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.UpgradeKeeper)
+
+	// the modules keep their own sequences until the upgrade
+	require.False(t, app.LogRegistryKeeper.IsActive(ctx))
+
+	app.TopupKeeper.SetProcessedLog(ctx, hmTypes.HexToIrisHash("123"), 4, 8)
+	require.True(t, app.TopupKeeper.HasTopupSequence(ctx, types.GetSequence(8, 4)))
+	require.True(t, app.TopupKeeper.HasProcessedLog(ctx, 8, 4))
+	require.False(t, app.ClerkKeeper.HasProcessedLog(ctx, 8, 4))
+
+	ctx = ctx.WithBlockHeight(10)
+	upgrade.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.UpgradeKeeper)
+
+	require.True(t, app.LogRegistryKeeper.IsActive(ctx))
+	require.False(t, app.TopupKeeper.HasTopupSequence(ctx, types.GetSequence(8, 4)))
+	require.True(t, app.ClerkKeeper.HasProcessedLog(ctx, 8, 4))
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	k := app.LogRegistryKeeper
+	k.SetParams(ctx, types.Params{PruneDepth: 100})
+	k.SetProcessedLog(ctx, types.NewProcessedLog("clerk", hmTypes.HexToIrisHash("123"), 2, 10))
+
+	genesisState := logregistry.ExportGenesis(ctx, k)
+	require.NoError(t, types.ValidateGenesis(genesisState))
+
+	app2, ctx2 := createTestApp(false)
+	logregistry.InitGenesis(ctx2, app2.LogRegistryKeeper, genesisState)
+
+	require.Equal(t, genesisState, logregistry.ExportGenesis(ctx2, app2.LogRegistryKeeper))
+	require.True(t, app2.LogRegistryKeeper.HasProcessedLog(ctx2, 10, 2))
+}
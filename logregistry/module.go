@@ -0,0 +1,153 @@
+package logregistry
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/zenanetwork/iris/helper"
+	logregistryCli "github.com/zenanetwork/iris/logregistry/client/cli"
+	logregistryRest "github.com/zenanetwork/iris/logregistry/client/rest"
+	"github.com/zenanetwork/iris/logregistry/types"
+	hmModule "github.com/zenanetwork/iris/types/module"
+)
+
+var (
+	_ module.AppModule         = AppModule{}
+	_ module.AppModuleBasic    = AppModuleBasic{}
+	_ hmModule.IrisModuleBasic = AppModule{}
+)
+
+// AppModuleBasic defines the basic application module used by the logregistry module.
+type AppModuleBasic struct{}
+
+// Name returns the logregistry module's name.
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterCodec registers the logregistry module's types for the given codec.
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	types.RegisterCodec(cdc)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the logregistry
+// module.
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return types.ModuleCdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the logregistry module.
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+
+	return types.ValidateGenesis(data)
+}
+
+// VerifyGenesis performs verification on logregistry module state.
+func (AppModuleBasic) VerifyGenesis(bz map[string]json.RawMessage) error {
+	return nil
+}
+
+// RegisterRESTRoutes registers the REST routes for the logregistry module.
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
+	logregistryRest.RegisterRoutes(ctx, rtr)
+}
+
+// GetTxCmd returns the root tx command for the logregistry module.
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return nil
+}
+
+// GetQueryCmd returns the root query command for the logregistry module.
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return logregistryCli.GetQueryCmd(cdc)
+}
+
+//____________________________________________________________________________
+
+// AppModule implements an application module for the logregistry module.
+type AppModule struct {
+	AppModuleBasic
+
+	keeper         Keeper
+	contractCaller helper.IContractCaller
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper, contractCaller helper.IContractCaller) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+		contractCaller: contractCaller,
+	}
+}
+
+// Name returns the logregistry module's name.
+func (AppModule) Name() string {
+	return types.ModuleName
+}
+
+// RegisterInvariants performs a no-op.
+func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns the message routing key for the logregistry module.
+func (AppModule) Route() string {
+	return types.RouterKey
+}
+
+// NewHandler returns an sdk.Handler for the module.
+func (am AppModule) NewHandler() sdk.Handler {
+	return nil
+}
+
+// QuerierRoute returns the logregistry module's querier route name.
+func (AppModule) QuerierRoute() string {
+	return types.QuerierRoute
+}
+
+// NewQuerierHandler returns the logregistry module sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper, am.contractCaller)
+}
+
+// InitGenesis performs genesis initialization for the logregistry module. It returns
+// no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+
+	types.ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+
+	InitGenesis(ctx, am.keeper, genesisState)
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the logregistry
+// module.
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return types.ModuleCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock returns the begin blocker for the logregistry module.
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock moves the pruned block once a new checkpoint is acked, and prunes the processed
+// logs below it. It returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if am.keeper.IsActive(ctx) {
+		am.keeper.PruneProcessedLogs(ctx)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,77 @@
+package logregistry
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	jsoniter "github.com/json-iterator/go"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/zenanetwork/iris/helper"
+	"github.com/zenanetwork/iris/logregistry/types"
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+// NewQuerier creates a querier for logregistry REST endpoints
+func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParams:
+			return queryParams(ctx, req, keeper)
+		case types.QueryProcessedLog:
+			return queryProcessedLog(ctx, req, keeper, contractCaller)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown logregistry query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := jsoniter.ConfigFastest.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryProcessedLog(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+	var params types.QueryProcessedLogParams
+
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// before, the logs are recorded by the modules processing them
+	if !keeper.IsActive(ctx) {
+		return nil, sdk.ErrInternal("the log registry is not active yet")
+	}
+
+	chainParams := keeper.chainKeeper.GetParams(ctx)
+
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(hmTypes.HexToIrisHash(params.TxHash).EthHash(), chainParams.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return nil, sdk.ErrInternal("Transaction is not confirmed yet. Please wait for sometime and try again")
+	}
+
+	blockNumber := receipt.BlockNumber.Uint64()
+
+	// check if incoming tx was processed
+	if !keeper.HasProcessedLog(ctx, blockNumber, params.LogIndex) {
+		return nil, nil
+	}
+
+	// the pruned logs are only known to be processed, by no module in particular
+	processedLog, ok := keeper.GetProcessedLog(ctx, blockNumber, params.LogIndex)
+	if !ok {
+		processedLog = types.NewProcessedLog("", hmTypes.HexToIrisHash(params.TxHash), params.LogIndex, blockNumber)
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(processedLog)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
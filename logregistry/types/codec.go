@@ -0,0 +1,17 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc module codec
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
+
+// RegisterCodec registers all necessary logregistry module types with a given codec.
+func RegisterCodec(cdc *codec.Codec) {}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisState - all logregistry state that must be provided at genesis
+type GenesisState struct {
+	Params        Params         `json:"params" yaml:"params"`
+	ProcessedLogs []ProcessedLog `json:"processed_logs" yaml:"processed_logs"`
+	PrunedBelow   uint64         `json:"pruned_below" yaml:"pruned_below"`
+	LastAckCount  uint64         `json:"last_ack_count" yaml:"last_ack_count"`
+}
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(params Params, processedLogs []ProcessedLog, prunedBelow uint64, lastAckCount uint64) GenesisState {
+	return GenesisState{
+		Params:        params,
+		ProcessedLogs: processedLogs,
+		PrunedBelow:   prunedBelow,
+		LastAckCount:  lastAckCount,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), []ProcessedLog{}, 0, 0)
+}
+
+// ValidateGenesis performs basic validation of logregistry genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	processed := make(map[string]bool)
+
+	for _, log := range data.ProcessedLogs {
+		if log.BlockNumber < data.PrunedBelow {
+			return fmt.Errorf("processed log at block %d is below the pruned block %d", log.BlockNumber, data.PrunedBelow)
+		}
+
+		key := string(GetProcessedLogKey(log.BlockNumber, log.LogIndex))
+		if processed[key] {
+			return fmt.Errorf("duplicate processed log at block %d, log index %d", log.BlockNumber, log.LogIndex)
+		}
+
+		processed[key] = true
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns logregistry GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		ModuleCdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+
+	return genesisState
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "logregistry"
+
+	// StoreKey is the store key string for logregistry
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for logregistry
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for logregistry
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+)
+
+var (
+	ProcessedLogPrefixKey = []byte{0x11} // prefix key for the processed logs, by L1 block number and log index
+	PrunedBelowKey        = []byte{0x12} // key for the L1 block below which the logs are pruned
+	LastAckCountKey       = []byte{0x14} // key for the checkpoint ack count the pruned block was last moved at
+)
+
+// MaxPrunedLogsPerBlock is the max number of processed logs deleted in a block
+const MaxPrunedLogsPerBlock = 1000
+
+// GetBlockProcessedLogsKey returns the prefix key of the logs of the L1 block. Keys sort by block number.
+func GetBlockProcessedLogsKey(blockNumber uint64) []byte {
+	blockNumberBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(blockNumberBytes, blockNumber)
+
+	return append(append([]byte{}, ProcessedLogPrefixKey...), blockNumberBytes...)
+}
+
+// GetProcessedLogKey returns the key of the log at logIndex of the L1 block
+func GetProcessedLogKey(blockNumber uint64, logIndex uint64) []byte {
+	logIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(logIndexBytes, logIndex)
+
+	return append(GetBlockProcessedLogsKey(blockNumber), logIndexBytes...)
+}
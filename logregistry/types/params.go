@@ -0,0 +1,75 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/zenanetwork/iris/params/subspace"
+)
+
+// Default parameter values
+const (
+	// DefaultPruneDepth disables pruning, processed logs being kept forever
+	DefaultPruneDepth uint64 = 0
+)
+
+// Parameter keys
+var (
+	KeyPruneDepth = []byte("PruneDepth")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the logregistry module.
+type Params struct {
+	PruneDepth uint64 `json:"prune_depth" yaml:"prune_depth"` // L1 blocks of processed logs kept at each checkpoint ack, 0 to disable pruning
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of logregistry module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeyPruneDepth, &p.PruneDepth},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+
+	return bytes.Equal(bz1, bz2)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("PruneDepth: %d\n", p.PruneDepth))
+
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	return nil
+}
+
+//
+// Extra functions
+//
+
+// ParamKeyTable for logregistry module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		PruneDepth: DefaultPruneDepth,
+	}
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+// ProcessedLog is a L1 log processed by a module
+type ProcessedLog struct {
+	Module      string           `json:"module" yaml:"module"`
+	TxHash      hmTypes.IrisHash `json:"tx_hash" yaml:"tx_hash"` // empty for the logs migrated from the module sequences
+	LogIndex    uint64           `json:"log_index" yaml:"log_index"`
+	BlockNumber uint64           `json:"block_number" yaml:"block_number"`
+}
+
+// NewProcessedLog creates a new processed log
+func NewProcessedLog(module string, txHash hmTypes.IrisHash, logIndex uint64, blockNumber uint64) ProcessedLog {
+	return ProcessedLog{
+		Module:      module,
+		TxHash:      txHash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+// String returns the string representation of the processed log
+func (l ProcessedLog) String() string {
+	return fmt.Sprintf(
+		"ProcessedLog: module %v, txHash %v, logIndex %v, blockNumber %v",
+		l.Module,
+		l.TxHash.Hex(),
+		l.LogIndex,
+		l.BlockNumber,
+	)
+}
+
+// GetSequence returns the sequence the modules kept the log at logIndex of the L1 block under
+func GetSequence(blockNumber uint64, logIndex uint64) string {
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(blockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(logIndex))
+
+	return sequence.String()
+}
+
+// ParseSequence returns the L1 block number and the log index of a module sequence
+func ParseSequence(sequence string) (blockNumber uint64, logIndex uint64, err error) {
+	seq, ok := new(big.Int).SetString(sequence, 10)
+	if !ok || seq.Sign() < 0 {
+		return 0, 0, fmt.Errorf("invalid sequence %q", sequence)
+	}
+
+	block, index := new(big.Int).DivMod(seq, big.NewInt(hmTypes.DefaultLogIndexUnit), new(big.Int))
+	if !block.IsUint64() {
+		return 0, 0, errors.New("sequence block number overflows uint64")
+	}
+
+	return block.Uint64(), index.Uint64(), nil
+}
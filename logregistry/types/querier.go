@@ -0,0 +1,18 @@
+package types
+
+// query endpoints supported by the logregistry Querier
+const (
+	QueryParams       = "params"
+	QueryProcessedLog = "processed-log"
+)
+
+// QueryProcessedLogParams defines the params for querying the processed log of a tx
+type QueryProcessedLogParams struct {
+	TxHash   string
+	LogIndex uint64
+}
+
+// NewQueryProcessedLogParams creates a new instance of QueryProcessedLogParams.
+func NewQueryProcessedLogParams(txHash string, logIndex uint64) QueryProcessedLogParams {
+	return QueryProcessedLogParams{TxHash: txHash, LogIndex: logIndex}
+}
@@ -23,6 +23,9 @@ func NewParamChangeProposalHandler(k Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p types.ParameterChangeProposal) sdk.Error {
+	// subspaces updated by the proposal, validated once all the changes are set
+	var updated []string
+
 	for _, c := range p.Changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
@@ -36,7 +39,28 @@ func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p types.ParameterC
 		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
 			return types.ErrSettingParameter(k.codespace, c.Key, c.Value, err.Error())
 		}
+
+		if !contains(updated, c.Subspace) {
+			updated = append(updated, c.Subspace)
+		}
+	}
+
+	for _, name := range updated {
+		ss, _ := k.GetSubspace(name)
+		if err := ss.ValidateParamSet(ctx); err != nil {
+			return types.ErrInvalidParamSet(k.codespace, name, err.Error())
+		}
 	}
 
 	return nil
 }
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -119,6 +120,28 @@ func TestProposalHandlerFailed(t *testing.T) {
 	require.Error(t, hdlr(input.ctx, invalidParamProposal{}))
 }
 
+func TestProposalHandlerInvalidValue(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		subspace.NewKeyTable().
+			RegisterParamSet(&testParams{}).
+			RegisterValidator([]byte(keyMaxValidators), func(value interface{}) error {
+				if value.(uint16) == 0 {
+					return errors.New("max validators must be positive")
+				}
+
+				return nil
+			}),
+	)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.Error(t, hdlr(input.ctx, testProposal(paramTypes.NewParamChange(testSubspace, keyMaxValidators, "0"))))
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+
+	require.NoError(t, hdlr(input.ctx, testProposal(paramTypes.NewParamChange(testSubspace, keyMaxValidators, "2"))))
+	require.True(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+}
+
 func TestProposalHandlerSubspaceFailed(t *testing.T) {
 	input := newTestInput(t)
 
@@ -149,3 +172,33 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+func TestProposalHandlerInvalidParamSet(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		subspace.NewKeyTable().
+			RegisterParamSet(&testParams{}).
+			RegisterParamSetValidator(&testParams{}, func(ps subspace.ParamSet) error {
+				if p := ps.(*testParams); p.SlashingRate.Downtime > p.MaxValidators {
+					return errors.New("downtime slashing rate must not exceed max validators")
+				}
+
+				return nil
+			}),
+	)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	// the changes are validated together once set
+	cacheCtx, _ := input.ctx.CacheContext()
+	require.Error(t, hdlr(cacheCtx, testProposal(paramTypes.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`))))
+
+	require.NoError(t, hdlr(input.ctx, testProposal(
+		paramTypes.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`),
+		paramTypes.NewParamChange(testSubspace, keyMaxValidators, "10"),
+	)))
+	require.True(t, ss.Has(input.ctx, []byte(keySlashingRate)))
+
+	cacheCtx, _ = input.ctx.CacheContext()
+	require.Error(t, hdlr(cacheCtx, testProposal(paramTypes.NewParamChange(testSubspace, keyMaxValidators, "5"))))
+}
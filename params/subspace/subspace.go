@@ -38,7 +38,8 @@ func NewSubspace(cdc *codec.Codec, key sdk.StoreKey, tkey sdk.StoreKey, name str
 		tkey: tkey,
 		name: []byte(name),
 		table: KeyTable{
-			m: make(map[string]attribute),
+			m:        make(map[string]attribute),
+			paramSet: &paramSetAttribute{},
 		},
 	}
 
@@ -59,6 +60,10 @@ func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 		s.table.m[k] = v
 	}
 
+	if table.paramSet != nil {
+		*s.table.paramSet = *table.paramSet
+	}
+
 	// Allocate additional capacity for Subspace.name
 	// So we don't have to allocate extra space each time appending to the key
 	name := s.name
@@ -178,6 +183,12 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
+	if attr.validator != nil {
+		if err := attr.validator(reflect.ValueOf(dest).Elem().Interface()); err != nil {
+			return err
+		}
+	}
+
 	s.Set(ctx, key, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(key, []byte{})
@@ -185,6 +196,19 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	return nil
 }
 
+// ValidateParamSet runs the validator of the param set of the subspace, if registered, on the
+// stored values of its parameters
+func (s Subspace) ValidateParamSet(ctx sdk.Context) error {
+	if s.table.paramSet.validator == nil {
+		return nil
+	}
+
+	ps := reflect.New(s.table.paramSet.ty).Interface().(ParamSet)
+	s.GetParamSetIfExists(ctx, ps)
+
+	return s.table.paramSet.validator(ps)
+}
+
 // Get to ParamSet
 func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
@@ -192,6 +216,15 @@ func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	}
 }
 
+// GetParamSetIfExists iterates through each ParamSetPair where for each pair
+// it will retrieve the value and set it to the corresponding value in the
+// ParamSet, leaving the value unchanged if the parameter is not stored
+func (s Subspace) GetParamSetIfExists(ctx sdk.Context, ps ParamSet) {
+	for _, pair := range ps.ParamSetPairs() {
+		s.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+}
+
 // Set from ParamSet
 func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
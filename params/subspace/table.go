@@ -6,11 +6,22 @@ import (
 
 type attribute struct {
 	ty reflect.Type
+	// validator run on the value of the parameter before an update
+	validator func(value interface{}) error
+}
+
+// paramSetAttribute is the param set of a table, with the validator of its values
+type paramSetAttribute struct {
+	ty reflect.Type
+	// validator run on the values of the param set after an update, for the checks across
+	// its parameters
+	validator func(ps ParamSet) error
 }
 
 // KeyTable subspaces appropriate type for each parameter key
 type KeyTable struct {
-	m map[string]attribute
+	m        map[string]attribute
+	paramSet *paramSetAttribute
 }
 
 // Constructs new table
@@ -20,7 +31,8 @@ func NewKeyTable(keytypes ...interface{}) (res KeyTable) {
 	}
 
 	res = KeyTable{
-		m: make(map[string]attribute),
+		m:        make(map[string]attribute),
+		paramSet: &paramSetAttribute{},
 	}
 
 	for i := 0; i < len(keytypes); i += 2 {
@@ -71,6 +83,35 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 	return t
 }
 
+// RegisterValidator registers the validator of the value of a registered parameter key, run
+// before the parameter is updated, e.g. by a param change proposal
+func (t KeyTable) RegisterValidator(key []byte, validator func(value interface{}) error) KeyTable {
+	attr, ok := t.m[string(key)]
+	if !ok {
+		panic("parameter not registered")
+	}
+
+	attr.validator = validator
+	t.m[string(key)] = attr
+
+	return t
+}
+
+// RegisterParamSetValidator registers the validator of the values of the param set of ps, run
+// after the parameters of a param change proposal are updated, for the checks across parameters
+func (t KeyTable) RegisterParamSetValidator(ps ParamSet, validator func(ps ParamSet) error) KeyTable {
+	for _, kvp := range ps.ParamSetPairs() {
+		if _, ok := t.m[string(kvp.Key)]; !ok {
+			panic("parameter not registered")
+		}
+	}
+
+	t.paramSet.ty = reflect.TypeOf(ps).Elem()
+	t.paramSet.validator = validator
+
+	return t
+}
+
 // RegisterParamSet registers multiple pairs from ParamSet
 func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, kvp := range ps.ParamSetPairs() {
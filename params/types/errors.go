@@ -13,6 +13,7 @@ const (
 	CodeUnknownSubspace  sdk.CodeType = 1
 	CodeSettingParameter sdk.CodeType = 2
 	CodeEmptyData        sdk.CodeType = 3
+	CodeInvalidParamSet  sdk.CodeType = 4
 )
 
 // ErrUnknownSubspace returns an unknown subspace error.
@@ -25,6 +26,11 @@ func ErrSettingParameter(codespace sdk.CodespaceType, key, value, msg string) sd
 	return sdk.NewError(codespace, CodeSettingParameter, fmt.Sprintf("error setting parameter %s on %s: %s", value, key, msg))
 }
 
+// ErrInvalidParamSet returns an error for parameters invalid together once set.
+func ErrInvalidParamSet(codespace sdk.CodespaceType, space, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamSet, fmt.Sprintf("invalid parameters of subspace %s: %s", space, msg))
+}
+
 // ErrEmptyChanges returns an error for empty parameter changes.
 func ErrEmptyChanges(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEmptyData, "submitted parameter changes are empty")
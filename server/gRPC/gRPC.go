@@ -11,6 +11,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/zenanetwork/iris/server/gRPC/querypb"
+	"github.com/zenanetwork/iris/server/stream"
 )
 
 const (
@@ -32,7 +35,7 @@ type IrisGRPCServer struct {
 	cdc *codec.Codec
 }
 
-func SetupGRPCServer(shutDownCtx context.Context, cdc *codec.Codec, addr string, lggr tmLog.Logger) error {
+func SetupGRPCServer(shutDownCtx context.Context, cdc *codec.Codec, addr string, lggr tmLog.Logger, hub *stream.Hub) error {
 	logger = lggr
 	grpcServer := grpc.NewServer(withLoggingUnaryInterceptor())
 	proto.RegisterIrisServer(grpcServer,
@@ -40,6 +43,9 @@ func SetupGRPCServer(shutDownCtx context.Context, cdc *codec.Codec, addr string,
 			cdc: cdc,
 		})
 
+	// native query service, iris.query.Query
+	querypb.RegisterQueryServer(grpcServer, NewIrisQueryServer(cdc, hub))
+
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
 		return err
@@ -69,7 +75,7 @@ func loggingServerInterceptor(ctx context.Context, req interface{}, info *grpc.U
 	start := time.Now()
 
 	h, err := handler(ctx, req)
-	if err != nil {
+	if _, ok := status.FromError(err); err != nil && !ok {
 		err = status.Error(codes.Internal, err.Error())
 	}
 
@@ -0,0 +1,66 @@
+package gRPC
+
+import (
+	"fmt"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zenanetwork/iris/server/gRPC/querypb"
+	"github.com/zenanetwork/iris/server/stream"
+)
+
+// IrisQueryServer implements the native iris query service, querypb.QueryServer.
+// Unlike IrisServer it does not go through the REST server: every method
+// queries the keepers through their ABCI querier routes.
+type IrisQueryServer struct {
+	querypb.UnimplementedQueryServer
+
+	cdc *codec.Codec
+	hub *stream.Hub
+
+	// abciQuery runs an ABCI query at height, through the node of the CLI context
+	abciQuery func(height int64, path string, data []byte) ([]byte, int64, error)
+}
+
+var _ querypb.QueryServer = (*IrisQueryServer)(nil)
+
+// NewIrisQueryServer creates a new native query server.
+// Subscriptions are served from hub, they are disabled if it is nil.
+func NewIrisQueryServer(cdc *codec.Codec, hub *stream.Hub) *IrisQueryServer {
+	return &IrisQueryServer{
+		cdc: cdc,
+		hub: hub,
+		abciQuery: func(height int64, path string, data []byte) ([]byte, int64, error) {
+			return cliContext.NewCLIContext().WithCodec(cdc).WithHeight(height).QueryWithData(path, data)
+		},
+	}
+}
+
+// query runs an ABCI custom query against the given module querier route at height
+func (q *IrisQueryServer) query(height int64, route string, path string, params interface{}) ([]byte, int64, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if params != nil {
+		if data, err = q.cdc.MarshalJSON(params); err != nil {
+			return nil, 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	res, resHeight, err := q.abciQuery(height, fmt.Sprintf("custom/%s/%s", route, path), data)
+	if err != nil {
+		logger.Error("Error while querying", "route", route, "path", path, "error", err)
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	if len(res) == 0 {
+		return nil, resHeight, status.Errorf(codes.NotFound, "no result for %s/%s", route, path)
+	}
+
+	return res, resHeight, nil
+}
@@ -0,0 +1,73 @@
+package gRPC
+
+import (
+	"context"
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	checkpointTypes "github.com/zenanetwork/iris/checkpoint/types"
+	"github.com/zenanetwork/iris/server/gRPC/querypb"
+)
+
+// checkpointPath returns the checkpoint querier path of query, scoped to the zena child chain if set
+func checkpointPath(query string, chainID string) string {
+	if chainID == "" {
+		return query
+	}
+
+	return fmt.Sprintf("%s/%s", query, chainID)
+}
+
+func (q *IrisQueryServer) Finality(_ context.Context, in *querypb.FinalityRequest) (*querypb.FinalityResponse, error) {
+	if in.BlockNumber == 0 && in.BlockHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "block_number or block_hash is required")
+	}
+
+	params := checkpointTypes.NewQueryFinalityParams(in.BlockNumber, in.BlockHash)
+
+	res, height, err := q.query(in.Height, checkpointTypes.QuerierRoute, checkpointPath(checkpointTypes.QueryFinality, in.ChainId), params)
+	if err != nil {
+		return nil, err
+	}
+
+	var finality checkpointTypes.BlockFinality
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &finality); err != nil {
+		logger.Error("Error unmarshalling block finality", "error", err)
+		return nil, err
+	}
+
+	return &querypb.FinalityResponse{Height: height, Finality: toProtoBlockFinality(&finality)}, nil
+}
+
+func (q *IrisQueryServer) FinalityRange(_ context.Context, in *querypb.FinalityRangeRequest) (*querypb.FinalityRangeResponse, error) {
+	if in.ToBlock < in.FromBlock {
+		return nil, status.Error(codes.InvalidArgument, "to_block must not be before from_block")
+	}
+
+	if in.ToBlock-in.FromBlock >= checkpointTypes.MaxFinalityRange {
+		return nil, status.Errorf(codes.InvalidArgument, "range must not be more than %d blocks", checkpointTypes.MaxFinalityRange)
+	}
+
+	params := checkpointTypes.NewQueryFinalityRangeParams(in.FromBlock, in.ToBlock)
+
+	res, height, err := q.query(in.Height, checkpointTypes.QuerierRoute, checkpointPath(checkpointTypes.QueryFinalityRange, in.ChainId), params)
+	if err != nil {
+		return nil, err
+	}
+
+	var finalities []checkpointTypes.BlockFinality
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &finalities); err != nil {
+		logger.Error("Error unmarshalling block finalities", "error", err)
+		return nil, err
+	}
+
+	resp := &querypb.FinalityRangeResponse{Height: height, Finalities: make([]*querypb.BlockFinality, 0, len(finalities))}
+	for i := range finalities {
+		resp.Finalities = append(resp.Finalities, toProtoBlockFinality(&finalities[i]))
+	}
+
+	return resp, nil
+}
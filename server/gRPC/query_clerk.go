@@ -0,0 +1,80 @@
+package gRPC
+
+import (
+	"context"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	jsoniter "github.com/json-iterator/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clerkTypes "github.com/zenanetwork/iris/clerk/types"
+	"github.com/zenanetwork/iris/server/gRPC/querypb"
+	hmRest "github.com/zenanetwork/iris/types/rest"
+)
+
+func (q *IrisQueryServer) EventRecord(_ context.Context, in *querypb.EventRecordRequest) (*querypb.EventRecordResponse, error) {
+	params := clerkTypes.NewQueryRecordParams(in.Id)
+
+	res, height, err := q.query(in.Height, clerkTypes.QuerierRoute, clerkTypes.QueryRecord, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var record clerkTypes.EventRecord
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &record); err != nil {
+		logger.Error("Error unmarshalling event record", "error", err)
+		return nil, err
+	}
+
+	return &querypb.EventRecordResponse{Height: height, Record: toProtoEventRecord(&record)}, nil
+}
+
+func (q *IrisQueryServer) EventRecordsByTime(_ context.Context, in *querypb.EventRecordsByTimeRequest) (*querypb.EventRecordsResponse, error) {
+	fromTime, toTime := fromProtoTime(in.FromTime), fromProtoTime(in.ToTime)
+	if toTime.Before(fromTime) {
+		return nil, status.Error(codes.InvalidArgument, "to_time must not be before from_time")
+	}
+
+	params := clerkTypes.NewQueryTimeRangePaginationParams(fromTime, toTime, in.Page, in.Limit)
+
+	res, height, err := q.query(in.Height, clerkTypes.QuerierRoute, clerkTypes.QueryRecordListWithTime, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []clerkTypes.EventRecord
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &records); err != nil {
+		logger.Error("Error unmarshalling event records", "error", err)
+		return nil, err
+	}
+
+	return &querypb.EventRecordsResponse{Height: height, Records: toProtoEventRecords(records)}, nil
+}
+
+// EventRecordProof returns the record with the IAVL proof of its state ID against the app hash
+// of the next header, or the proof of its absence
+func (q *IrisQueryServer) EventRecordProof(_ context.Context, in *querypb.EventRecordRequest) (*querypb.EventRecordProofResponse, error) {
+	cliCtx := cliContext.NewCLIContext().WithCodec(q.cdc).WithHeight(in.Height)
+
+	proof, err := hmRest.QueryStoreProof(cliCtx, clerkTypes.StoreKey, clerkTypes.GetEventRecordKey(in.Id))
+	if err != nil {
+		logger.Error("Error while querying event record proof", "id", in.Id, "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	recordProof, err := clerkTypes.NewRecordProof(in.Id, proof)
+	if err != nil {
+		logger.Error("Error unmarshalling event record", "error", err)
+		return nil, err
+	}
+
+	return &querypb.EventRecordProofResponse{
+		Height: proof.Height,
+		Proof: &querypb.RecordProof{
+			StateId: recordProof.StateID,
+			Record:  toProtoEventRecord(recordProof.Record),
+			Proof:   toProtoStoreProof(recordProof.Proof),
+		},
+	}, nil
+}
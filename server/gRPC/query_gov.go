@@ -0,0 +1,88 @@
+package gRPC
+
+import (
+	"context"
+
+	jsoniter "github.com/json-iterator/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
+	govTypes "github.com/zenanetwork/iris/gov/types"
+	"github.com/zenanetwork/iris/server/gRPC/querypb"
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+func (q *IrisQueryServer) ChainManagerParams(_ context.Context, in *querypb.HeightRequest) (*querypb.ChainManagerParamsResponse, error) {
+	res, height, err := q.query(in.Height, chainmanagerTypes.QuerierRoute, chainmanagerTypes.QueryParams, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var params chainmanagerTypes.Params
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &params); err != nil {
+		logger.Error("Error unmarshalling chain manager params", "error", err)
+		return nil, err
+	}
+
+	return &querypb.ChainManagerParamsResponse{Height: height, Params: toProtoChainManagerParams(&params)}, nil
+}
+
+func (q *IrisQueryServer) Proposal(_ context.Context, in *querypb.ProposalRequest) (*querypb.ProposalResponse, error) {
+	params := govTypes.NewQueryProposalParams(in.Id)
+
+	res, height, err := q.query(in.Height, govTypes.QuerierRoute, govTypes.QueryProposal, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// gov encodes proposals with amino, the content is an interface
+	var proposal govTypes.Proposal
+	if err := q.cdc.UnmarshalJSON(res, &proposal); err != nil {
+		logger.Error("Error unmarshalling proposal", "error", err)
+		return nil, err
+	}
+
+	resp := &querypb.ProposalResponse{Height: height}
+	if resp.Proposal, err = toProtoProposal(q.cdc, &proposal); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (q *IrisQueryServer) Proposals(_ context.Context, in *querypb.ProposalsRequest) (*querypb.ProposalsResponse, error) {
+	var proposalStatus govTypes.ProposalStatus
+
+	if in.Status != "" {
+		var err error
+		if proposalStatus, err = govTypes.ProposalStatusFromString(in.Status); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	params := govTypes.NewQueryProposalsParams(proposalStatus, in.Limit, hmTypes.NewValidatorID(in.Voter), hmTypes.NewValidatorID(in.Depositor))
+
+	res, height, err := q.query(in.Height, govTypes.QuerierRoute, govTypes.QueryProposals, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals govTypes.Proposals
+	if err := q.cdc.UnmarshalJSON(res, &proposals); err != nil {
+		logger.Error("Error unmarshalling proposals", "error", err)
+		return nil, err
+	}
+
+	resp := &querypb.ProposalsResponse{Height: height, Proposals: make([]*querypb.Proposal, 0, len(proposals))}
+	for i := range proposals {
+		proposal, err := toProtoProposal(q.cdc, &proposals[i])
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Proposals = append(resp.Proposals, proposal)
+	}
+
+	return resp, nil
+}
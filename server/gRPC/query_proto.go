@@ -0,0 +1,234 @@
+package gRPC
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
+	checkpointTypes "github.com/zenanetwork/iris/checkpoint/types"
+	clerkTypes "github.com/zenanetwork/iris/clerk/types"
+	govTypes "github.com/zenanetwork/iris/gov/types"
+	"github.com/zenanetwork/iris/server/gRPC/querypb"
+	"github.com/zenanetwork/iris/server/stream"
+	hmTypes "github.com/zenanetwork/iris/types"
+	hmRest "github.com/zenanetwork/iris/types/rest"
+)
+
+// Conversions of the iris types to the messages of the native query service
+
+func toProtoValidator(v *hmTypes.Validator) *querypb.Validator {
+	if v == nil {
+		return nil
+	}
+
+	return &querypb.Validator{
+		Id:               v.ID.Uint64(),
+		StartEpoch:       v.StartEpoch,
+		EndEpoch:         v.EndEpoch,
+		Nonce:            v.Nonce,
+		VotingPower:      v.VotingPower,
+		PubKey:           v.PubKey.Bytes(),
+		Signer:           v.Signer.Bytes(),
+		LastUpdated:      v.LastUpdated,
+		Jailed:           v.Jailed,
+		ProposerPriority: v.ProposerPriority,
+	}
+}
+
+func toProtoValidators(validators []hmTypes.Validator) []*querypb.Validator {
+	res := make([]*querypb.Validator, 0, len(validators))
+	for i := range validators {
+		res = append(res, toProtoValidator(&validators[i]))
+	}
+
+	return res
+}
+
+func toProtoValidatorSet(set *hmTypes.ValidatorSet) *querypb.ValidatorSet {
+	res := &querypb.ValidatorSet{
+		Validators: make([]*querypb.Validator, 0, len(set.Validators)),
+		Proposer:   toProtoValidator(set.Proposer),
+	}
+
+	for _, v := range set.Validators {
+		res.Validators = append(res.Validators, toProtoValidator(v))
+	}
+
+	return res
+}
+
+func toProtoEventRecord(record *clerkTypes.EventRecord) *querypb.EventRecord {
+	if record == nil {
+		return nil
+	}
+
+	return &querypb.EventRecord{
+		Id:         record.ID,
+		Contract:   record.Contract.Bytes(),
+		Data:       record.Data.Bytes(),
+		TxHash:     record.TxHash.Bytes(),
+		LogIndex:   record.LogIndex,
+		ChainId:    record.ChainID,
+		RecordTime: timestamppb.New(record.RecordTime),
+	}
+}
+
+func toProtoEventRecords(records []clerkTypes.EventRecord) []*querypb.EventRecord {
+	res := make([]*querypb.EventRecord, 0, len(records))
+	for i := range records {
+		res = append(res, toProtoEventRecord(&records[i]))
+	}
+
+	return res
+}
+
+func toProtoStoreProof(proof hmRest.StoreProof) *querypb.StoreProof {
+	res := &querypb.StoreProof{
+		Height:  proof.Height,
+		AppHash: proof.AppHash,
+		Store:   proof.Store,
+		Key:     proof.Key,
+		Value:   proof.Value,
+	}
+
+	if proof.Proof != nil {
+		for _, op := range proof.Proof.Ops {
+			res.Ops = append(res.Ops, &querypb.ProofOp{Type: op.Type, Key: op.Key, Data: op.Data})
+		}
+	}
+
+	return res
+}
+
+func toProtoBlockFinality(finality *checkpointTypes.BlockFinality) *querypb.BlockFinality {
+	return &querypb.BlockFinality{
+		BlockNumber:      finality.BlockNumber,
+		BlockHash:        finality.BlockHash,
+		Level:            string(finality.Level),
+		MilestoneNumber:  finality.MilestoneNumber,
+		MilestoneId:      finality.MilestoneID,
+		CheckpointNumber: finality.CheckpointNumber,
+		FinalizedAt:      finality.FinalizedAt,
+	}
+}
+
+func toProtoChainManagerParams(params *chainmanagerTypes.Params) *querypb.ChainManagerParams {
+	chainParams := params.ChainParams
+
+	res := &querypb.ChainManagerParams{
+		MainchainTxConfirmations:  params.MainchainTxConfirmations,
+		MaticchainTxConfirmations: params.MaticchainTxConfirmations,
+		ChainParams: &querypb.ChainParams{
+			ZenaChainId:           chainParams.ZenaChainID,
+			MaticTokenAddress:     chainParams.MaticTokenAddress.Bytes(),
+			StakingManagerAddress: chainParams.StakingManagerAddress.Bytes(),
+			SlashManagerAddress:   chainParams.SlashManagerAddress.Bytes(),
+			RootChainAddress:      chainParams.RootChainAddress.Bytes(),
+			StakingInfoAddress:    chainParams.StakingInfoAddress.Bytes(),
+			StateSenderAddress:    chainParams.StateSenderAddress.Bytes(),
+			StateReceiverAddress:  chainParams.StateReceiverAddress.Bytes(),
+			ValidatorSetAddress:   chainParams.ValidatorSetAddress.Bytes(),
+		},
+	}
+
+	for _, childChain := range params.ChildChains {
+		res.ChildChains = append(res.ChildChains, &querypb.ChildChainParams{
+			ZenaChainId:          childChain.ZenaChainID,
+			RootChainAddress:     childChain.RootChainAddress.Bytes(),
+			StateReceiverAddress: childChain.StateReceiverAddress.Bytes(),
+			ValidatorSetAddress:  childChain.ValidatorSetAddress.Bytes(),
+		})
+	}
+
+	return res
+}
+
+// toProtoProposal converts a gov proposal, its content is encoded with the amino JSON codec
+func toProtoProposal(cdc *codec.Codec, proposal *govTypes.Proposal) (*querypb.Proposal, error) {
+	content, err := cdc.MarshalJSON(proposal.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	tally := proposal.FinalTallyResult
+
+	res := &querypb.Proposal{
+		Id:          proposal.ProposalID,
+		ContentType: proposal.ProposalType(),
+		Title:       proposal.GetTitle(),
+		Description: proposal.GetDescription(),
+		Content:     content,
+		Status:      proposal.Status.String(),
+		FinalTallyResult: &querypb.TallyResult{
+			Yes:        tally.Yes.String(),
+			Abstain:    tally.Abstain.String(),
+			No:         tally.No.String(),
+			NoWithVeto: tally.NoWithVeto.String(),
+		},
+		SubmitTime:      timestamppb.New(proposal.SubmitTime),
+		DepositEndTime:  timestamppb.New(proposal.DepositEndTime),
+		VotingStartTime: timestamppb.New(proposal.VotingStartTime),
+		VotingEndTime:   timestamppb.New(proposal.VotingEndTime),
+	}
+
+	for _, coin := range proposal.TotalDeposit {
+		res.TotalDeposit = append(res.TotalDeposit, &querypb.Coin{Denom: coin.Denom, Amount: coin.Amount.String()})
+	}
+
+	return res, nil
+}
+
+// toProtoEvent converts a subscription event
+func toProtoEvent(event *stream.Event) *querypb.Event {
+	res := &querypb.Event{
+		Kind:   string(event.Kind),
+		Cursor: event.Cursor,
+		Height: event.Height,
+	}
+
+	switch {
+	case event.Checkpoint != nil:
+		res.Object = &querypb.Event_Checkpoint{Checkpoint: &querypb.Checkpoint{
+			Proposer:    event.Checkpoint.Proposer.Bytes(),
+			StartBlock:  event.Checkpoint.StartBlock,
+			EndBlock:    event.Checkpoint.EndBlock,
+			RootHash:    event.Checkpoint.RootHash.Bytes(),
+			ZenaChainId: event.Checkpoint.ZenaChainID,
+			Timestamp:   event.Checkpoint.TimeStamp,
+		}}
+	case event.Milestone != nil:
+		res.Object = &querypb.Event_Milestone{Milestone: &querypb.Milestone{
+			Proposer:    event.Milestone.Proposer.Bytes(),
+			StartBlock:  event.Milestone.StartBlock,
+			EndBlock:    event.Milestone.EndBlock,
+			Hash:        event.Milestone.Hash.Bytes(),
+			ZenaChainId: event.Milestone.ZenaChainID,
+			MilestoneId: event.Milestone.MilestoneID,
+			Timestamp:   event.Milestone.TimeStamp,
+		}}
+	case event.Span != nil:
+		res.Object = &querypb.Event_Span{Span: &querypb.Span{
+			Id:                event.Span.ID,
+			StartBlock:        event.Span.StartBlock,
+			EndBlock:          event.Span.EndBlock,
+			ValidatorSet:      toProtoValidatorSet(&event.Span.ValidatorSet),
+			SelectedProducers: toProtoValidators(event.Span.SelectedProducers),
+			ZenaChainId:       event.Span.ChainID,
+		}}
+	case event.Record != nil:
+		res.Object = &querypb.Event_Record{Record: toProtoEventRecord(event.Record)}
+	}
+
+	return res
+}
+
+// fromProtoTime returns the time of a request timestamp, the zero time if unset
+func fromProtoTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
@@ -0,0 +1,84 @@
+package gRPC
+
+import (
+	"context"
+	"math"
+
+	jsoniter "github.com/json-iterator/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zenanetwork/iris/server/gRPC/querypb"
+	slashingTypes "github.com/zenanetwork/iris/slashing/types"
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+func (q *IrisQueryServer) SigningInfo(_ context.Context, in *querypb.ValidatorRequest) (*querypb.SigningInfoResponse, error) {
+	params := slashingTypes.NewQuerySigningInfoParams(hmTypes.NewValidatorID(in.Id))
+
+	res, height, err := q.query(in.Height, slashingTypes.QuerierRoute, slashingTypes.QuerySigningInfo, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var info hmTypes.ValidatorSigningInfo
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &info); err != nil {
+		logger.Error("Error unmarshalling signing info", "error", err)
+		return nil, err
+	}
+
+	return &querypb.SigningInfoResponse{
+		Height: height,
+		SigningInfo: &querypb.ValidatorSigningInfo{
+			ValId:               info.ValID.Uint64(),
+			StartHeight:         info.StartHeight,
+			IndexOffset:         info.IndexOffset,
+			MissedBlocksCounter: info.MissedBlocksCounter,
+		},
+	}, nil
+}
+
+func (q *IrisQueryServer) TickSlashingInfos(_ context.Context, in *querypb.PaginationRequest) (*querypb.SlashingInfosResponse, error) {
+	if in.Page > math.MaxInt32 || in.Limit > math.MaxInt32 {
+		return nil, status.Error(codes.InvalidArgument, "page or limit out of range")
+	}
+
+	params := slashingTypes.NewQueryTickSlashingInfosParams(int(in.Page), int(in.Limit))
+
+	res, height, err := q.query(in.Height, slashingTypes.QuerierRoute, slashingTypes.QueryTickSlashingInfos, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []hmTypes.ValidatorSlashingInfo
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &infos); err != nil {
+		logger.Error("Error unmarshalling tick slashing infos", "error", err)
+		return nil, err
+	}
+
+	resp := &querypb.SlashingInfosResponse{Height: height, SlashingInfos: make([]*querypb.ValidatorSlashingInfo, 0, len(infos))}
+	for _, info := range infos {
+		resp.SlashingInfos = append(resp.SlashingInfos, &querypb.ValidatorSlashingInfo{
+			Id:            info.ID.Uint64(),
+			SlashedAmount: info.SlashedAmount,
+			IsJailed:      info.IsJailed,
+		})
+	}
+
+	return resp, nil
+}
+
+func (q *IrisQueryServer) TickCount(_ context.Context, in *querypb.HeightRequest) (*querypb.CountResponse, error) {
+	res, height, err := q.query(in.Height, slashingTypes.QuerierRoute, slashingTypes.QueryTickCount, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &querypb.CountResponse{Height: height}
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &resp.Count); err != nil {
+		logger.Error("Error unmarshalling tick count", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,109 @@
+package gRPC
+
+import (
+	"context"
+
+	jsoniter "github.com/json-iterator/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zenanetwork/iris/server/gRPC/querypb"
+	stakingTypes "github.com/zenanetwork/iris/staking/types"
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+func (q *IrisQueryServer) Validator(_ context.Context, in *querypb.ValidatorRequest) (*querypb.ValidatorResponse, error) {
+	params := stakingTypes.NewQueryValidatorParams(hmTypes.NewValidatorID(in.Id))
+
+	res, height, err := q.query(in.Height, stakingTypes.QuerierRoute, stakingTypes.QueryValidator, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var validator hmTypes.Validator
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &validator); err != nil {
+		logger.Error("Error unmarshalling validator", "error", err)
+		return nil, err
+	}
+
+	return &querypb.ValidatorResponse{Height: height, Validator: toProtoValidator(&validator)}, nil
+}
+
+func (q *IrisQueryServer) ValidatorBySigner(_ context.Context, in *querypb.ValidatorBySignerRequest) (*querypb.ValidatorResponse, error) {
+	if in.Signer == "" {
+		return nil, status.Error(codes.InvalidArgument, "signer address is required")
+	}
+
+	params := stakingTypes.NewQuerySignerParams(hmTypes.HexToIrisAddress(in.Signer).Bytes())
+
+	res, height, err := q.query(in.Height, stakingTypes.QuerierRoute, stakingTypes.QuerySigner, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var validator hmTypes.Validator
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &validator); err != nil {
+		logger.Error("Error unmarshalling validator", "error", err)
+		return nil, err
+	}
+
+	return &querypb.ValidatorResponse{Height: height, Validator: toProtoValidator(&validator)}, nil
+}
+
+func (q *IrisQueryServer) ValidatorSet(_ context.Context, in *querypb.HeightRequest) (*querypb.ValidatorSetResponse, error) {
+	res, height, err := q.query(in.Height, stakingTypes.QuerierRoute, stakingTypes.QueryCurrentValidatorSet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var validatorSet hmTypes.ValidatorSet
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &validatorSet); err != nil {
+		logger.Error("Error unmarshalling validator set", "error", err)
+		return nil, err
+	}
+
+	return &querypb.ValidatorSetResponse{Height: height, ValidatorSet: toProtoValidatorSet(&validatorSet)}, nil
+}
+
+func (q *IrisQueryServer) Proposers(_ context.Context, in *querypb.ProposersRequest) (*querypb.ValidatorsResponse, error) {
+	return q.proposers(in, stakingTypes.QueryProposer)
+}
+
+func (q *IrisQueryServer) MilestoneProposers(_ context.Context, in *querypb.ProposersRequest) (*querypb.ValidatorsResponse, error) {
+	return q.proposers(in, stakingTypes.QueryMilestoneProposer)
+}
+
+func (q *IrisQueryServer) CurrentProposer(_ context.Context, in *querypb.HeightRequest) (*querypb.ValidatorResponse, error) {
+	res, height, err := q.query(in.Height, stakingTypes.QuerierRoute, stakingTypes.QueryCurrentProposer, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var validator hmTypes.Validator
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &validator); err != nil {
+		logger.Error("Error unmarshalling current proposer", "error", err)
+		return nil, err
+	}
+
+	return &querypb.ValidatorResponse{Height: height, Validator: toProtoValidator(&validator)}, nil
+}
+
+func (q *IrisQueryServer) proposers(in *querypb.ProposersRequest, path string) (*querypb.ValidatorsResponse, error) {
+	times := in.Times
+	if times == 0 {
+		times = 1
+	}
+
+	res, height, err := q.query(in.Height, stakingTypes.QuerierRoute, path, stakingTypes.NewQueryProposerParams(times))
+	if err != nil {
+		return nil, err
+	}
+
+	var validators []hmTypes.Validator
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &validators); err != nil {
+		logger.Error("Error unmarshalling proposers", "error", err)
+		return nil, err
+	}
+
+	return &querypb.ValidatorsResponse{Height: height, Validators: toProtoValidators(validators)}, nil
+}
@@ -0,0 +1,58 @@
+package gRPC
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zenanetwork/iris/server/gRPC/querypb"
+	"github.com/zenanetwork/iris/server/stream"
+)
+
+// eventSender is the server side of a subscription stream
+type eventSender interface {
+	Send(*querypb.Event) error
+	Context() context.Context
+}
+
+func (q *IrisQueryServer) SubscribeCheckpoints(in *querypb.SubscribeRequest, srv querypb.Query_SubscribeCheckpointsServer) error {
+	return q.subscribe(stream.KindCheckpoint, in, srv)
+}
+
+func (q *IrisQueryServer) SubscribeMilestones(in *querypb.SubscribeRequest, srv querypb.Query_SubscribeMilestonesServer) error {
+	return q.subscribe(stream.KindMilestone, in, srv)
+}
+
+func (q *IrisQueryServer) SubscribeSpans(in *querypb.SubscribeRequest, srv querypb.Query_SubscribeSpansServer) error {
+	return q.subscribe(stream.KindSpan, in, srv)
+}
+
+func (q *IrisQueryServer) SubscribeStateSyncs(in *querypb.SubscribeRequest, srv querypb.Query_SubscribeStateSyncsServer) error {
+	return q.subscribe(stream.KindStateSync, in, srv)
+}
+
+// subscribe sends the objects of kind of the request chain after its cursor until the client
+// goes away
+func (q *IrisQueryServer) subscribe(kind stream.Kind, in *querypb.SubscribeRequest, srv eventSender) error {
+	if q.hub == nil {
+		return status.Error(codes.Unavailable, "subscriptions are not enabled")
+	}
+
+	events, err := q.hub.Subscribe(srv.Context(), kind, in.ChainId, in.Cursor)
+	if errors.Is(err, stream.ErrUnknownChainID) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for event := range events {
+		event := event
+		if err := srv.Send(toProtoEvent(&event)); err != nil {
+			return err
+		}
+	}
+
+	return srv.Context().Err()
+}
@@ -0,0 +1,271 @@
+package gRPC
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	checkpointTypes "github.com/zenanetwork/iris/checkpoint/types"
+	clerkTypes "github.com/zenanetwork/iris/clerk/types"
+	"github.com/zenanetwork/iris/server/gRPC/querypb"
+	stakingTypes "github.com/zenanetwork/iris/staking/types"
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+const queryHeight = int64(42)
+
+func init() {
+	logger = log.NewNopLogger()
+}
+
+// fakeQuerier answers the ABCI queries of the query server with canned results by path
+type fakeQuerier struct {
+	cdc     *codec.Codec
+	results map[string]interface{}
+
+	// path and data of the last query
+	path string
+	data []byte
+}
+
+func (f *fakeQuerier) query(height int64, path string, data []byte) ([]byte, int64, error) {
+	f.path, f.data = path, data
+
+	res, ok := f.results[path]
+	if !ok {
+		return nil, height, nil
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(res)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if height == 0 {
+		height = queryHeight
+	}
+
+	return bz, height, nil
+}
+
+// params decodes the params of the last query
+func (f *fakeQuerier) params(t *testing.T, params interface{}) {
+	t.Helper()
+	require.NoError(t, f.cdc.UnmarshalJSON(f.data, params))
+}
+
+// newTestQueryClient serves the query service over an in-memory connection
+func newTestQueryClient(t *testing.T, results map[string]interface{}) (querypb.QueryClient, *fakeQuerier) {
+	t.Helper()
+
+	cdc := codec.New()
+	querier := &fakeQuerier{cdc: cdc, results: results}
+
+	server := NewIrisQueryServer(cdc, nil)
+	server.abciQuery = querier.query
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	querypb.RegisterQueryServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return querypb.NewQueryClient(conn), querier
+}
+
+func testValidator(id uint64, power int64) hmTypes.Validator {
+	return hmTypes.Validator{
+		ID:          hmTypes.NewValidatorID(id),
+		StartEpoch:  1,
+		VotingPower: power,
+		Signer:      hmTypes.BytesToIrisAddress([]byte{byte(id)}),
+		LastUpdated: "10",
+	}
+}
+
+func TestQueryStaking(t *testing.T) {
+	t.Parallel()
+
+	validator := testValidator(1, 100)
+	other := testValidator(2, 50)
+
+	client, querier := newTestQueryClient(t, map[string]interface{}{
+		"custom/staking/validator": validator,
+		"custom/staking/current-validator-set": hmTypes.ValidatorSet{
+			Validators: []*hmTypes.Validator{&validator, &other},
+			Proposer:   &validator,
+		},
+		"custom/staking/proposer": []hmTypes.Validator{validator, other},
+	})
+
+	ctx := context.Background()
+
+	// validator by ID, at the requested height
+	res, err := client.Validator(ctx, &querypb.ValidatorRequest{Height: 7, Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(7), res.Height)
+	require.Equal(t, uint64(1), res.Validator.Id)
+	require.Equal(t, int64(100), res.Validator.VotingPower)
+	require.Equal(t, validator.Signer.Bytes(), res.Validator.Signer)
+	require.Equal(t, "10", res.Validator.LastUpdated)
+
+	var validatorParams stakingTypes.QueryValidatorParams
+	querier.params(t, &validatorParams)
+	require.Equal(t, hmTypes.NewValidatorID(1), validatorParams.ValidatorID)
+
+	// validator set, at the latest height
+	setRes, err := client.ValidatorSet(ctx, &querypb.HeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, queryHeight, setRes.Height)
+	require.Len(t, setRes.ValidatorSet.Validators, 2)
+	require.Equal(t, uint64(2), setRes.ValidatorSet.Validators[1].Id)
+	require.Equal(t, uint64(1), setRes.ValidatorSet.Proposer.Id)
+
+	// proposers, once by default
+	proposersRes, err := client.Proposers(ctx, &querypb.ProposersRequest{})
+	require.NoError(t, err)
+	require.Len(t, proposersRes.Validators, 2)
+
+	var proposerParams stakingTypes.QueryProposerParams
+	querier.params(t, &proposerParams)
+	require.Equal(t, uint64(1), proposerParams.Times)
+
+	// no milestone proposers
+	_, err = client.MilestoneProposers(ctx, &querypb.ProposersRequest{Times: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.ValidatorBySigner(ctx, &querypb.ValidatorBySignerRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryClerk(t *testing.T) {
+	t.Parallel()
+
+	recordTime := time.Unix(1700000000, 0).UTC()
+	record := clerkTypes.NewEventRecord(
+		hmTypes.HexToIrisHash("0x01"),
+		3,
+		11,
+		hmTypes.HexToIrisAddress("0x02"),
+		hmTypes.HexBytes{0x0a, 0x0b},
+		"15001",
+		recordTime,
+	)
+
+	client, querier := newTestQueryClient(t, map[string]interface{}{
+		"custom/clerk/record":           record,
+		"custom/clerk/record-list-time": []clerkTypes.EventRecord{record, record},
+	})
+
+	ctx := context.Background()
+
+	res, err := client.EventRecord(ctx, &querypb.EventRecordRequest{Id: 11})
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), res.Record.Id)
+	require.Equal(t, uint64(3), res.Record.LogIndex)
+	require.Equal(t, "15001", res.Record.ChainId)
+	require.Equal(t, []byte{0x0a, 0x0b}, res.Record.Data)
+	require.Equal(t, record.Contract.Bytes(), res.Record.Contract)
+	require.True(t, recordTime.Equal(res.Record.RecordTime.AsTime()))
+
+	var recordParams clerkTypes.QueryRecordParams
+	querier.params(t, &recordParams)
+	require.Equal(t, uint64(11), recordParams.RecordID)
+
+	listRes, err := client.EventRecordsByTime(ctx, &querypb.EventRecordsByTimeRequest{
+		FromTime: timestamppb.New(recordTime.Add(-time.Hour)),
+		ToTime:   timestamppb.New(recordTime),
+		Page:     1,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, listRes.Records, 2)
+
+	var timeParams clerkTypes.QueryRecordTimePaginationParams
+	querier.params(t, &timeParams)
+	require.True(t, recordTime.Add(-time.Hour).Equal(timeParams.FromTime))
+	require.True(t, recordTime.Equal(timeParams.ToTime))
+	require.Equal(t, uint64(10), timeParams.Limit)
+
+	_, err = client.EventRecordsByTime(ctx, &querypb.EventRecordsByTimeRequest{
+		FromTime: timestamppb.New(recordTime),
+		ToTime:   timestamppb.New(recordTime.Add(-time.Hour)),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	finality := checkpointTypes.BlockFinality{
+		BlockNumber:      100,
+		Level:            checkpointTypes.FinalityCheckpointed,
+		MilestoneNumber:  4,
+		MilestoneID:      "milestone-4",
+		CheckpointNumber: 2,
+		FinalizedAt:      30,
+	}
+
+	client, querier := newTestQueryClient(t, map[string]interface{}{
+		"custom/checkpoint/finality":             finality,
+		"custom/checkpoint/finality-range/15005": []checkpointTypes.BlockFinality{finality, finality},
+	})
+
+	ctx := context.Background()
+
+	res, err := client.Finality(ctx, &querypb.FinalityRequest{BlockNumber: 100})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), res.Finality.BlockNumber)
+	require.Equal(t, string(checkpointTypes.FinalityCheckpointed), res.Finality.Level)
+	require.Equal(t, "milestone-4", res.Finality.MilestoneId)
+	require.Equal(t, uint64(2), res.Finality.CheckpointNumber)
+	require.Equal(t, int64(30), res.Finality.FinalizedAt)
+
+	var finalityParams checkpointTypes.QueryFinalityParams
+	querier.params(t, &finalityParams)
+	require.Equal(t, uint64(100), finalityParams.BlockNumber)
+
+	// scoped to a child chain
+	rangeRes, err := client.FinalityRange(ctx, &querypb.FinalityRangeRequest{FromBlock: 100, ToBlock: 101, ChainId: "15005"})
+	require.NoError(t, err)
+	require.Len(t, rangeRes.Finalities, 2)
+	require.Equal(t, "custom/checkpoint/finality-range/15005", querier.path)
+
+	_, err = client.Finality(ctx, &querypb.FinalityRequest{BlockNumber: 100, ChainId: "15006"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, "custom/checkpoint/finality/15006", querier.path)
+
+	_, err = client.Finality(ctx, &querypb.FinalityRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.FinalityRange(ctx, &querypb.FinalityRangeRequest{FromBlock: 101, ToBlock: 100})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,77 @@
+package gRPC
+
+import (
+	"context"
+
+	jsoniter "github.com/json-iterator/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zenanetwork/iris/server/gRPC/querypb"
+	topupTypes "github.com/zenanetwork/iris/topup/types"
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+func (q *IrisQueryServer) DividendAccount(_ context.Context, in *querypb.AddressRequest) (*querypb.DividendAccountResponse, error) {
+	if in.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	params := topupTypes.NewQueryDividendAccountParams(hmTypes.HexToIrisAddress(in.Address))
+
+	res, height, err := q.query(in.Height, topupTypes.QuerierRoute, topupTypes.QueryDividendAccount, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var account hmTypes.DividendAccount
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &account); err != nil {
+		logger.Error("Error unmarshalling dividend account", "error", err)
+		return nil, err
+	}
+
+	return &querypb.DividendAccountResponse{
+		Height: height,
+		DividendAccount: &querypb.DividendAccount{
+			User:      account.User.Bytes(),
+			FeeAmount: account.FeeAmount,
+		},
+	}, nil
+}
+
+func (q *IrisQueryServer) DividendAccountRoot(_ context.Context, in *querypb.HeightRequest) (*querypb.HashResponse, error) {
+	res, height, err := q.query(in.Height, topupTypes.QuerierRoute, topupTypes.QueryDividendAccountRoot, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &querypb.HashResponse{Height: height, Hash: hmTypes.BytesToIrisHash(res).Bytes()}, nil
+}
+
+func (q *IrisQueryServer) AccountProof(_ context.Context, in *querypb.AddressRequest) (*querypb.AccountProofResponse, error) {
+	if in.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	params := topupTypes.NewQueryAccountProofParams(hmTypes.HexToIrisAddress(in.Address))
+
+	res, height, err := q.query(in.Height, topupTypes.QuerierRoute, topupTypes.QueryAccountProof, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var proof hmTypes.DividendAccountProof
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &proof); err != nil {
+		logger.Error("Error unmarshalling account proof", "error", err)
+		return nil, err
+	}
+
+	return &querypb.AccountProofResponse{
+		Height: height,
+		AccountProof: &querypb.DividendAccountProof{
+			User:  proof.User.Bytes(),
+			Proof: proof.Proof.Bytes(),
+			Index: proof.Index,
+		},
+	}, nil
+}
@@ -0,0 +1,1121 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: iris/query.proto
+
+package querypb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	Query_Validator_FullMethodName            = "/iris.query.Query/Validator"
+	Query_ValidatorBySigner_FullMethodName    = "/iris.query.Query/ValidatorBySigner"
+	Query_ValidatorSet_FullMethodName         = "/iris.query.Query/ValidatorSet"
+	Query_Proposers_FullMethodName            = "/iris.query.Query/Proposers"
+	Query_CurrentProposer_FullMethodName      = "/iris.query.Query/CurrentProposer"
+	Query_MilestoneProposers_FullMethodName   = "/iris.query.Query/MilestoneProposers"
+	Query_DividendAccount_FullMethodName      = "/iris.query.Query/DividendAccount"
+	Query_DividendAccountRoot_FullMethodName  = "/iris.query.Query/DividendAccountRoot"
+	Query_AccountProof_FullMethodName         = "/iris.query.Query/AccountProof"
+	Query_EventRecord_FullMethodName          = "/iris.query.Query/EventRecord"
+	Query_EventRecordsByTime_FullMethodName   = "/iris.query.Query/EventRecordsByTime"
+	Query_EventRecordProof_FullMethodName     = "/iris.query.Query/EventRecordProof"
+	Query_Finality_FullMethodName             = "/iris.query.Query/Finality"
+	Query_FinalityRange_FullMethodName        = "/iris.query.Query/FinalityRange"
+	Query_SigningInfo_FullMethodName          = "/iris.query.Query/SigningInfo"
+	Query_TickSlashingInfos_FullMethodName    = "/iris.query.Query/TickSlashingInfos"
+	Query_TickCount_FullMethodName            = "/iris.query.Query/TickCount"
+	Query_ChainManagerParams_FullMethodName   = "/iris.query.Query/ChainManagerParams"
+	Query_Proposal_FullMethodName             = "/iris.query.Query/Proposal"
+	Query_Proposals_FullMethodName            = "/iris.query.Query/Proposals"
+	Query_SubscribeCheckpoints_FullMethodName = "/iris.query.Query/SubscribeCheckpoints"
+	Query_SubscribeMilestones_FullMethodName  = "/iris.query.Query/SubscribeMilestones"
+	Query_SubscribeSpans_FullMethodName       = "/iris.query.Query/SubscribeSpans"
+	Query_SubscribeStateSyncs_FullMethodName  = "/iris.query.Query/SubscribeStateSyncs"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query is the native iris query service. Unlike the Iris service it does not go through the
+// REST server: every method queries the keepers through their ABCI querier routes.
+//
+// Height is optional on every request, zero queries the latest committed state. Height on
+// every response is the height the state was read at.
+type QueryClient interface {
+	// staking
+	Validator(ctx context.Context, in *ValidatorRequest, opts ...grpc.CallOption) (*ValidatorResponse, error)
+	ValidatorBySigner(ctx context.Context, in *ValidatorBySignerRequest, opts ...grpc.CallOption) (*ValidatorResponse, error)
+	ValidatorSet(ctx context.Context, in *HeightRequest, opts ...grpc.CallOption) (*ValidatorSetResponse, error)
+	Proposers(ctx context.Context, in *ProposersRequest, opts ...grpc.CallOption) (*ValidatorsResponse, error)
+	CurrentProposer(ctx context.Context, in *HeightRequest, opts ...grpc.CallOption) (*ValidatorResponse, error)
+	MilestoneProposers(ctx context.Context, in *ProposersRequest, opts ...grpc.CallOption) (*ValidatorsResponse, error)
+	// topup
+	DividendAccount(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*DividendAccountResponse, error)
+	DividendAccountRoot(ctx context.Context, in *HeightRequest, opts ...grpc.CallOption) (*HashResponse, error)
+	AccountProof(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*AccountProofResponse, error)
+	// clerk
+	EventRecord(ctx context.Context, in *EventRecordRequest, opts ...grpc.CallOption) (*EventRecordResponse, error)
+	EventRecordsByTime(ctx context.Context, in *EventRecordsByTimeRequest, opts ...grpc.CallOption) (*EventRecordsResponse, error)
+	EventRecordProof(ctx context.Context, in *EventRecordRequest, opts ...grpc.CallOption) (*EventRecordProofResponse, error)
+	// checkpoint
+	Finality(ctx context.Context, in *FinalityRequest, opts ...grpc.CallOption) (*FinalityResponse, error)
+	FinalityRange(ctx context.Context, in *FinalityRangeRequest, opts ...grpc.CallOption) (*FinalityRangeResponse, error)
+	// slashing
+	SigningInfo(ctx context.Context, in *ValidatorRequest, opts ...grpc.CallOption) (*SigningInfoResponse, error)
+	TickSlashingInfos(ctx context.Context, in *PaginationRequest, opts ...grpc.CallOption) (*SlashingInfosResponse, error)
+	TickCount(ctx context.Context, in *HeightRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	// chainmanager
+	ChainManagerParams(ctx context.Context, in *HeightRequest, opts ...grpc.CallOption) (*ChainManagerParamsResponse, error)
+	// gov
+	Proposal(ctx context.Context, in *ProposalRequest, opts ...grpc.CallOption) (*ProposalResponse, error)
+	Proposals(ctx context.Context, in *ProposalsRequest, opts ...grpc.CallOption) (*ProposalsResponse, error)
+	// subscriptions, streaming the objects after the request cursor
+	SubscribeCheckpoints(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Query_SubscribeCheckpointsClient, error)
+	SubscribeMilestones(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Query_SubscribeMilestonesClient, error)
+	SubscribeSpans(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Query_SubscribeSpansClient, error)
+	SubscribeStateSyncs(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Query_SubscribeStateSyncsClient, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Validator(ctx context.Context, in *ValidatorRequest, opts ...grpc.CallOption) (*ValidatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatorResponse)
+	err := c.cc.Invoke(ctx, Query_Validator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorBySigner(ctx context.Context, in *ValidatorBySignerRequest, opts ...grpc.CallOption) (*ValidatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatorResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorBySigner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSet(ctx context.Context, in *HeightRequest, opts ...grpc.CallOption) (*ValidatorSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatorSetResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposers(ctx context.Context, in *ProposersRequest, opts ...grpc.CallOption) (*ValidatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatorsResponse)
+	err := c.cc.Invoke(ctx, Query_Proposers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentProposer(ctx context.Context, in *HeightRequest, opts ...grpc.CallOption) (*ValidatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatorResponse)
+	err := c.cc.Invoke(ctx, Query_CurrentProposer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MilestoneProposers(ctx context.Context, in *ProposersRequest, opts ...grpc.CallOption) (*ValidatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatorsResponse)
+	err := c.cc.Invoke(ctx, Query_MilestoneProposers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DividendAccount(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*DividendAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DividendAccountResponse)
+	err := c.cc.Invoke(ctx, Query_DividendAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DividendAccountRoot(ctx context.Context, in *HeightRequest, opts ...grpc.CallOption) (*HashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HashResponse)
+	err := c.cc.Invoke(ctx, Query_DividendAccountRoot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountProof(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*AccountProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountProofResponse)
+	err := c.cc.Invoke(ctx, Query_AccountProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EventRecord(ctx context.Context, in *EventRecordRequest, opts ...grpc.CallOption) (*EventRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventRecordResponse)
+	err := c.cc.Invoke(ctx, Query_EventRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EventRecordsByTime(ctx context.Context, in *EventRecordsByTimeRequest, opts ...grpc.CallOption) (*EventRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_EventRecordsByTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EventRecordProof(ctx context.Context, in *EventRecordRequest, opts ...grpc.CallOption) (*EventRecordProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventRecordProofResponse)
+	err := c.cc.Invoke(ctx, Query_EventRecordProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Finality(ctx context.Context, in *FinalityRequest, opts ...grpc.CallOption) (*FinalityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinalityResponse)
+	err := c.cc.Invoke(ctx, Query_Finality_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityRange(ctx context.Context, in *FinalityRangeRequest, opts ...grpc.CallOption) (*FinalityRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinalityRangeResponse)
+	err := c.cc.Invoke(ctx, Query_FinalityRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfo(ctx context.Context, in *ValidatorRequest, opts ...grpc.CallOption) (*SigningInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SigningInfoResponse)
+	err := c.cc.Invoke(ctx, Query_SigningInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TickSlashingInfos(ctx context.Context, in *PaginationRequest, opts ...grpc.CallOption) (*SlashingInfosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlashingInfosResponse)
+	err := c.cc.Invoke(ctx, Query_TickSlashingInfos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TickCount(ctx context.Context, in *HeightRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, Query_TickCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChainManagerParams(ctx context.Context, in *HeightRequest, opts ...grpc.CallOption) (*ChainManagerParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChainManagerParamsResponse)
+	err := c.cc.Invoke(ctx, Query_ChainManagerParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposal(ctx context.Context, in *ProposalRequest, opts ...grpc.CallOption) (*ProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposalResponse)
+	err := c.cc.Invoke(ctx, Query_Proposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposals(ctx context.Context, in *ProposalsRequest, opts ...grpc.CallOption) (*ProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_Proposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubscribeCheckpoints(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Query_SubscribeCheckpointsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_SubscribeCheckpoints_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &querySubscribeCheckpointsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_SubscribeCheckpointsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type querySubscribeCheckpointsClient struct {
+	grpc.ClientStream
+}
+
+func (x *querySubscribeCheckpointsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) SubscribeMilestones(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Query_SubscribeMilestonesClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[1], Query_SubscribeMilestones_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &querySubscribeMilestonesClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_SubscribeMilestonesClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type querySubscribeMilestonesClient struct {
+	grpc.ClientStream
+}
+
+func (x *querySubscribeMilestonesClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) SubscribeSpans(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Query_SubscribeSpansClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[2], Query_SubscribeSpans_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &querySubscribeSpansClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_SubscribeSpansClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type querySubscribeSpansClient struct {
+	grpc.ClientStream
+}
+
+func (x *querySubscribeSpansClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) SubscribeStateSyncs(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Query_SubscribeStateSyncsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[3], Query_SubscribeStateSyncs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &querySubscribeStateSyncsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_SubscribeStateSyncsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type querySubscribeStateSyncsClient struct {
+	grpc.ClientStream
+}
+
+func (x *querySubscribeStateSyncsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+//
+// Query is the native iris query service. Unlike the Iris service it does not go through the
+// REST server: every method queries the keepers through their ABCI querier routes.
+//
+// Height is optional on every request, zero queries the latest committed state. Height on
+// every response is the height the state was read at.
+type QueryServer interface {
+	// staking
+	Validator(context.Context, *ValidatorRequest) (*ValidatorResponse, error)
+	ValidatorBySigner(context.Context, *ValidatorBySignerRequest) (*ValidatorResponse, error)
+	ValidatorSet(context.Context, *HeightRequest) (*ValidatorSetResponse, error)
+	Proposers(context.Context, *ProposersRequest) (*ValidatorsResponse, error)
+	CurrentProposer(context.Context, *HeightRequest) (*ValidatorResponse, error)
+	MilestoneProposers(context.Context, *ProposersRequest) (*ValidatorsResponse, error)
+	// topup
+	DividendAccount(context.Context, *AddressRequest) (*DividendAccountResponse, error)
+	DividendAccountRoot(context.Context, *HeightRequest) (*HashResponse, error)
+	AccountProof(context.Context, *AddressRequest) (*AccountProofResponse, error)
+	// clerk
+	EventRecord(context.Context, *EventRecordRequest) (*EventRecordResponse, error)
+	EventRecordsByTime(context.Context, *EventRecordsByTimeRequest) (*EventRecordsResponse, error)
+	EventRecordProof(context.Context, *EventRecordRequest) (*EventRecordProofResponse, error)
+	// checkpoint
+	Finality(context.Context, *FinalityRequest) (*FinalityResponse, error)
+	FinalityRange(context.Context, *FinalityRangeRequest) (*FinalityRangeResponse, error)
+	// slashing
+	SigningInfo(context.Context, *ValidatorRequest) (*SigningInfoResponse, error)
+	TickSlashingInfos(context.Context, *PaginationRequest) (*SlashingInfosResponse, error)
+	TickCount(context.Context, *HeightRequest) (*CountResponse, error)
+	// chainmanager
+	ChainManagerParams(context.Context, *HeightRequest) (*ChainManagerParamsResponse, error)
+	// gov
+	Proposal(context.Context, *ProposalRequest) (*ProposalResponse, error)
+	Proposals(context.Context, *ProposalsRequest) (*ProposalsResponse, error)
+	// subscriptions, streaming the objects after the request cursor
+	SubscribeCheckpoints(*SubscribeRequest, Query_SubscribeCheckpointsServer) error
+	SubscribeMilestones(*SubscribeRequest, Query_SubscribeMilestonesServer) error
+	SubscribeSpans(*SubscribeRequest, Query_SubscribeSpansServer) error
+	SubscribeStateSyncs(*SubscribeRequest, Query_SubscribeStateSyncsServer) error
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Validator(context.Context, *ValidatorRequest) (*ValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+}
+func (UnimplementedQueryServer) ValidatorBySigner(context.Context, *ValidatorBySignerRequest) (*ValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorBySigner not implemented")
+}
+func (UnimplementedQueryServer) ValidatorSet(context.Context, *HeightRequest) (*ValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSet not implemented")
+}
+func (UnimplementedQueryServer) Proposers(context.Context, *ProposersRequest) (*ValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposers not implemented")
+}
+func (UnimplementedQueryServer) CurrentProposer(context.Context, *HeightRequest) (*ValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentProposer not implemented")
+}
+func (UnimplementedQueryServer) MilestoneProposers(context.Context, *ProposersRequest) (*ValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MilestoneProposers not implemented")
+}
+func (UnimplementedQueryServer) DividendAccount(context.Context, *AddressRequest) (*DividendAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DividendAccount not implemented")
+}
+func (UnimplementedQueryServer) DividendAccountRoot(context.Context, *HeightRequest) (*HashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DividendAccountRoot not implemented")
+}
+func (UnimplementedQueryServer) AccountProof(context.Context, *AddressRequest) (*AccountProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountProof not implemented")
+}
+func (UnimplementedQueryServer) EventRecord(context.Context, *EventRecordRequest) (*EventRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventRecord not implemented")
+}
+func (UnimplementedQueryServer) EventRecordsByTime(context.Context, *EventRecordsByTimeRequest) (*EventRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventRecordsByTime not implemented")
+}
+func (UnimplementedQueryServer) EventRecordProof(context.Context, *EventRecordRequest) (*EventRecordProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventRecordProof not implemented")
+}
+func (UnimplementedQueryServer) Finality(context.Context, *FinalityRequest) (*FinalityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Finality not implemented")
+}
+func (UnimplementedQueryServer) FinalityRange(context.Context, *FinalityRangeRequest) (*FinalityRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityRange not implemented")
+}
+func (UnimplementedQueryServer) SigningInfo(context.Context, *ValidatorRequest) (*SigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfo not implemented")
+}
+func (UnimplementedQueryServer) TickSlashingInfos(context.Context, *PaginationRequest) (*SlashingInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickSlashingInfos not implemented")
+}
+func (UnimplementedQueryServer) TickCount(context.Context, *HeightRequest) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickCount not implemented")
+}
+func (UnimplementedQueryServer) ChainManagerParams(context.Context, *HeightRequest) (*ChainManagerParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainManagerParams not implemented")
+}
+func (UnimplementedQueryServer) Proposal(context.Context, *ProposalRequest) (*ProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+}
+func (UnimplementedQueryServer) Proposals(context.Context, *ProposalsRequest) (*ProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+func (UnimplementedQueryServer) SubscribeCheckpoints(*SubscribeRequest, Query_SubscribeCheckpointsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeCheckpoints not implemented")
+}
+func (UnimplementedQueryServer) SubscribeMilestones(*SubscribeRequest, Query_SubscribeMilestonesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMilestones not implemented")
+}
+func (UnimplementedQueryServer) SubscribeSpans(*SubscribeRequest, Query_SubscribeSpansServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSpans not implemented")
+}
+func (UnimplementedQueryServer) SubscribeStateSyncs(*SubscribeRequest, Query_SubscribeStateSyncsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeStateSyncs not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Validator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validator(ctx, req.(*ValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorBySigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorBySignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorBySigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorBySigner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorBySigner(ctx, req.(*ValidatorBySignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSet(ctx, req.(*HeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Proposers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposers(ctx, req.(*ProposersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentProposer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CurrentProposer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentProposer(ctx, req.(*HeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MilestoneProposers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MilestoneProposers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MilestoneProposers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MilestoneProposers(ctx, req.(*ProposersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DividendAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DividendAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DividendAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DividendAccount(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DividendAccountRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DividendAccountRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DividendAccountRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DividendAccountRoot(ctx, req.(*HeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountProof(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EventRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EventRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EventRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EventRecord(ctx, req.(*EventRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EventRecordsByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRecordsByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EventRecordsByTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EventRecordsByTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EventRecordsByTime(ctx, req.(*EventRecordsByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EventRecordProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EventRecordProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EventRecordProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EventRecordProof(ctx, req.(*EventRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Finality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Finality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Finality_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Finality(ctx, req.(*FinalityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalityRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FinalityRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityRange(ctx, req.(*FinalityRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfo(ctx, req.(*ValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TickSlashingInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaginationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TickSlashingInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TickSlashingInfos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickSlashingInfos(ctx, req.(*PaginationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TickCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TickCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TickCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickCount(ctx, req.(*HeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainManagerParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainManagerParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChainManagerParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainManagerParams(ctx, req.(*HeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Proposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposal(ctx, req.(*ProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Proposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposals(ctx, req.(*ProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubscribeCheckpoints_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).SubscribeCheckpoints(m, &querySubscribeCheckpointsServer{ServerStream: stream})
+}
+
+type Query_SubscribeCheckpointsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type querySubscribeCheckpointsServer struct {
+	grpc.ServerStream
+}
+
+func (x *querySubscribeCheckpointsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_SubscribeMilestones_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).SubscribeMilestones(m, &querySubscribeMilestonesServer{ServerStream: stream})
+}
+
+type Query_SubscribeMilestonesServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type querySubscribeMilestonesServer struct {
+	grpc.ServerStream
+}
+
+func (x *querySubscribeMilestonesServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_SubscribeSpans_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).SubscribeSpans(m, &querySubscribeSpansServer{ServerStream: stream})
+}
+
+type Query_SubscribeSpansServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type querySubscribeSpansServer struct {
+	grpc.ServerStream
+}
+
+func (x *querySubscribeSpansServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_SubscribeStateSyncs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).SubscribeStateSyncs(m, &querySubscribeStateSyncsServer{ServerStream: stream})
+}
+
+type Query_SubscribeStateSyncsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type querySubscribeStateSyncsServer struct {
+	grpc.ServerStream
+}
+
+func (x *querySubscribeStateSyncsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iris.query.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validator",
+			Handler:    _Query_Validator_Handler,
+		},
+		{
+			MethodName: "ValidatorBySigner",
+			Handler:    _Query_ValidatorBySigner_Handler,
+		},
+		{
+			MethodName: "ValidatorSet",
+			Handler:    _Query_ValidatorSet_Handler,
+		},
+		{
+			MethodName: "Proposers",
+			Handler:    _Query_Proposers_Handler,
+		},
+		{
+			MethodName: "CurrentProposer",
+			Handler:    _Query_CurrentProposer_Handler,
+		},
+		{
+			MethodName: "MilestoneProposers",
+			Handler:    _Query_MilestoneProposers_Handler,
+		},
+		{
+			MethodName: "DividendAccount",
+			Handler:    _Query_DividendAccount_Handler,
+		},
+		{
+			MethodName: "DividendAccountRoot",
+			Handler:    _Query_DividendAccountRoot_Handler,
+		},
+		{
+			MethodName: "AccountProof",
+			Handler:    _Query_AccountProof_Handler,
+		},
+		{
+			MethodName: "EventRecord",
+			Handler:    _Query_EventRecord_Handler,
+		},
+		{
+			MethodName: "EventRecordsByTime",
+			Handler:    _Query_EventRecordsByTime_Handler,
+		},
+		{
+			MethodName: "EventRecordProof",
+			Handler:    _Query_EventRecordProof_Handler,
+		},
+		{
+			MethodName: "Finality",
+			Handler:    _Query_Finality_Handler,
+		},
+		{
+			MethodName: "FinalityRange",
+			Handler:    _Query_FinalityRange_Handler,
+		},
+		{
+			MethodName: "SigningInfo",
+			Handler:    _Query_SigningInfo_Handler,
+		},
+		{
+			MethodName: "TickSlashingInfos",
+			Handler:    _Query_TickSlashingInfos_Handler,
+		},
+		{
+			MethodName: "TickCount",
+			Handler:    _Query_TickCount_Handler,
+		},
+		{
+			MethodName: "ChainManagerParams",
+			Handler:    _Query_ChainManagerParams_Handler,
+		},
+		{
+			MethodName: "Proposal",
+			Handler:    _Query_Proposal_Handler,
+		},
+		{
+			MethodName: "Proposals",
+			Handler:    _Query_Proposals_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeCheckpoints",
+			Handler:       _Query_SubscribeCheckpoints_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeMilestones",
+			Handler:       _Query_SubscribeMilestones_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeSpans",
+			Handler:       _Query_SubscribeSpans_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeStateSyncs",
+			Handler:       _Query_SubscribeStateSyncs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "iris/query.proto",
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/zenanetwork/iris/app"
 	tx "github.com/zenanetwork/iris/client/tx"
 	"github.com/zenanetwork/iris/helper"
+	"github.com/zenanetwork/iris/server/stream"
 	hmRest "github.com/zenanetwork/iris/types/rest"
 
 	// unnamed import of statik for swagger UI support
@@ -48,6 +49,14 @@ func StartRestServer(mainCtx ctx.Context, cdc *codec.Codec, registerRoutesFn fun
 
 	registerRoutesFn(cliCtx, router)
 
+	// subscriptions to new checkpoints, milestones, spans and state-sync records
+	streamHub := stream.NewHub(cdc, cliCtx.NodeURI, logger.With("module", "stream-hub"))
+	if err := streamHub.Start(mainCtx); err != nil {
+		logger.Error("Error while subscribing to the event bus, subscriptions fall back to polling", "error", err)
+	}
+
+	router.Handle("/subscribe", stream.NewWebsocketHandler(streamHub, cdc, logger)).Methods("GET")
+
 	// server configuration
 	cfg := rpcserver.DefaultConfig()
 	cfg.MaxOpenConnections = viper.GetInt(client.FlagMaxOpenConnections)
@@ -97,7 +106,7 @@ func StartRestServer(mainCtx ctx.Context, cdc *codec.Codec, registerRoutesFn fun
 
 	// Setup gRPC server
 	gRPCLogger := tmLog.NewTMLogger(log.NewSyncWriter(logsWriter)).With("module", "gRPC-server")
-	if err := gRPC.SetupGRPCServer(mainCtx, cdc, viper.GetString(FlagGrpcAddr), gRPCLogger); err != nil {
+	if err := gRPC.SetupGRPCServer(mainCtx, cdc, viper.GetString(FlagGrpcAddr), gRPCLogger, streamHub); err != nil {
 		return err
 	}
 
@@ -0,0 +1,590 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/tendermint/tendermint/libs/log"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
+	checkpointTypes "github.com/zenanetwork/iris/checkpoint/types"
+	clerkTypes "github.com/zenanetwork/iris/clerk/types"
+	"github.com/zenanetwork/iris/helper"
+	hmTypes "github.com/zenanetwork/iris/types"
+	zenaTypes "github.com/zenanetwork/iris/zena/types"
+)
+
+// Kind is the type of objects pushed by a subscription
+type Kind string
+
+const (
+	KindCheckpoint Kind = "checkpoint"
+	KindMilestone  Kind = "milestone"
+	KindSpan       Kind = "span"
+	KindStateSync  Kind = "state-sync"
+)
+
+const (
+	// subscriber name on the tendermint event bus
+	subscriberName = "iris-stream-hub"
+
+	// pollInterval is the fallback interval when the event bus is not available
+	pollInterval = 5 * time.Second
+
+	// eventBufferSize is the capacity of a subscription channel
+	eventBufferSize = 16
+
+	// feedSize is the number of latest events a feed keeps for its subscriptions, the ones
+	// further behind read the objects themselves
+	feedSize = 256
+)
+
+// ErrUnknownChainID is returned subscribing to a zena chain not secured by iris
+var ErrUnknownChainID = errors.New("unknown zena chain id")
+
+// ParseKind returns the kind for s
+func ParseKind(s string) (Kind, error) {
+	switch kind := Kind(s); kind {
+	case KindCheckpoint, KindMilestone, KindSpan, KindStateSync:
+		return kind, nil
+	default:
+		return "", fmt.Errorf("unknown subscription type %q", s)
+	}
+}
+
+// Event is pushed to subscribers for every new object.
+// Cursor is the number of the object (checkpoint number, milestone number, span id
+// or state-sync record id); clients resume a subscription with the last cursor received.
+// Height is the height of the block the object was committed at.
+type Event struct {
+	Kind       Kind                    `json:"kind"`
+	Cursor     uint64                  `json:"cursor"`
+	Height     int64                   `json:"height"`
+	Checkpoint *hmTypes.Checkpoint     `json:"checkpoint,omitempty"`
+	Milestone  *hmTypes.Milestone      `json:"milestone,omitempty"`
+	Span       *hmTypes.Span           `json:"span,omitempty"`
+	Record     *clerkTypes.EventRecord `json:"record,omitempty"`
+}
+
+// feedKey identifies the objects read by a feed
+type feedKey struct {
+	kind Kind
+
+	// id of a zena child chain the queries are scoped to, empty for the chain of the chain
+	// params and for state-sync records, which are filtered by subscription
+	chainID string
+}
+
+// feed reads the new objects of a kind once per block for all the subscriptions to them
+type feed struct {
+	// height the feed was last polled at, zero until it is first polled, and number of the
+	// latest object at that height
+	height int64
+	cursor uint64
+
+	// latest events, in order
+	events []Event
+
+	// polled is closed and replaced every time the feed is polled
+	polled chan struct{}
+
+	subscriptions int
+}
+
+// Hub pushes new checkpoints, milestones, spans and state-sync records to subscribers.
+//
+// Checkpoint acks, milestones, spans and records are committed by side-tx post handlers,
+// so on every new block of the tendermint event bus the hub polls the objects after the
+// latest ones through the module queriers, once for all the subscriptions to them. The new
+// objects of a feed polled at the next height were committed at that height, the commit
+// height of the others is bisected from the state at earlier heights.
+// Reading by number makes resuming from a cursor gapless across reconnects.
+type Hub struct {
+	cdc     *codec.Codec
+	nodeURI string
+	logger  log.Logger
+
+	// abciQuery runs an ABCI query at height, the latest one if zero, through the node
+	abciQuery func(height int64, path string, data []byte) ([]byte, int64, error)
+
+	mu    sync.Mutex
+	feeds map[feedKey]*feed
+
+	// wake polls the feeds before the next block, for new subscriptions
+	wake chan struct{}
+}
+
+// NewHub creates a new subscription hub reading from the node at nodeURI
+func NewHub(cdc *codec.Codec, nodeURI string, logger log.Logger) *Hub {
+	return &Hub{
+		cdc:     cdc,
+		nodeURI: nodeURI,
+		logger:  logger,
+		abciQuery: func(height int64, path string, data []byte) ([]byte, int64, error) {
+			return cliContext.NewCLIContext().WithCodec(cdc).WithHeight(height).QueryWithData(path, data)
+		},
+		feeds: make(map[feedKey]*feed),
+		wake:  make(chan struct{}, 1),
+	}
+}
+
+// Start polls the feeds on every new block of the tendermint event bus until ctx is done.
+// An error is returned if the hub cannot subscribe to new blocks, feeds are polled every
+// pollInterval regardless.
+func (h *Hub) Start(ctx context.Context) error {
+	blocks, err := h.subscribeBlocks(ctx)
+
+	go h.run(ctx, blocks)
+
+	if err != nil {
+		return err
+	}
+
+	h.logger.Info("Stream hub subscribed to new blocks", "node", h.nodeURI)
+
+	return nil
+}
+
+// subscribeBlocks subscribes to new blocks on the tendermint event bus until ctx is done
+func (h *Hub) subscribeBlocks(ctx context.Context) (<-chan ctypes.ResultEvent, error) {
+	client := httpClient.NewHTTP(h.nodeURI, "/websocket")
+	if err := client.Start(); err != nil {
+		return nil, err
+	}
+
+	query := tmTypes.QueryForEvent(tmTypes.EventNewBlockHeader).String()
+
+	blocks, err := client.Subscribe(ctx, subscriberName, query)
+	if err != nil {
+		_ = client.Stop()
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		if err := client.UnsubscribeAll(context.Background(), subscriberName); err != nil {
+			h.logger.Error("Error while unsubscribing from event bus", "error", err)
+		}
+
+		_ = client.Stop()
+	}()
+
+	return blocks, nil
+}
+
+// run polls the feeds on every new block, every pollInterval and on new subscriptions
+func (h *Hub) run(ctx context.Context, blocks <-chan ctypes.ResultEvent) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-blocks:
+			if !ok {
+				h.logger.Error("Event bus subscription closed, falling back to polling")
+
+				// a nil channel is never ready
+				blocks = nil
+
+				continue
+			}
+		case <-ticker.C:
+		case <-h.wake:
+		}
+
+		h.poll()
+	}
+}
+
+// poll polls the feeds of the subscriptions up to the latest committed height
+func (h *Hub) poll() {
+	height, err := h.latestHeight()
+	if err != nil {
+		h.logger.Error("Error while fetching latest height", "error", err)
+		return
+	}
+
+	h.mu.Lock()
+
+	feeds := make(map[feedKey]*feed, len(h.feeds))
+	for key, f := range h.feeds {
+		feeds[key] = f
+	}
+
+	h.mu.Unlock()
+
+	for key, f := range feeds {
+		h.pollFeed(key, f, height)
+	}
+}
+
+// pollFeed reads the objects of a feed committed up to height
+func (h *Hub) pollFeed(key feedKey, f *feed, height int64) {
+	h.mu.Lock()
+	fromHeight, cursor := f.height, f.cursor
+	h.mu.Unlock()
+
+	var events []Event
+
+	if fromHeight == 0 {
+		// a new feed starts after the latest object
+		cursor = h.latest(key, height)
+	} else if fromHeight < height {
+		events, cursor = h.read(key, cursor, fromHeight, height, 0)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if height > f.height {
+		f.height, f.cursor = height, cursor
+	}
+
+	f.events = append(f.events, events...)
+	if len(f.events) > feedSize {
+		f.events = append([]Event(nil), f.events[len(f.events)-feedSize:]...)
+	}
+
+	close(f.polled)
+	f.polled = make(chan struct{})
+}
+
+// read returns the objects after cursor found at height, at most limit if not zero, with the
+// height each was committed at, and the last cursor read. Objects after cursor are not
+// committed at fromHeight.
+func (h *Hub) read(key feedKey, cursor uint64, fromHeight int64, height int64, limit int) ([]Event, uint64) {
+	var events []Event
+
+	for limit == 0 || len(events) < limit {
+		event, err := h.fetch(key, cursor+1, height)
+		if err != nil {
+			// the queriers return an error for objects not committed yet
+			break
+		}
+
+		event.Height = h.commitHeight(key, event.Cursor, fromHeight+1, height)
+		events = append(events, event)
+
+		// a later object is not committed before the previous one
+		cursor, fromHeight = event.Cursor, event.Height-1
+	}
+
+	return events, cursor
+}
+
+// commitHeight returns the height object number was committed at, between fromHeight and
+// height it exists at. The state of the heights pruned from the store cannot be read, the
+// first height still in the store is returned for the objects committed before.
+func (h *Hub) commitHeight(key feedKey, number uint64, fromHeight int64, height int64) int64 {
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+
+	for fromHeight < height {
+		mid := fromHeight + (height-fromHeight)/2
+
+		if _, err := h.fetch(key, number, mid); err == nil {
+			height = mid
+		} else {
+			fromHeight = mid + 1
+		}
+	}
+
+	return height
+}
+
+// latest returns the number of the latest object of a feed at height. The objects are numbered
+// in sequence, the latest is searched doubling the number then bisecting.
+func (h *Hub) latest(key feedKey, height int64) uint64 {
+	var number uint64
+	if key.kind == KindMilestone {
+		number = h.skipPrunedMilestones(key, 0, height)
+	}
+
+	exists := func(number uint64) bool {
+		_, err := h.fetch(key, number, height)
+		return err == nil
+	}
+
+	step := uint64(1)
+	for exists(number + step) {
+		number += step
+		step *= 2
+	}
+
+	// number exists, number+step does not
+	for next := number + step; next-number > 1; {
+		mid := number + (next-number)/2
+		if exists(mid) {
+			number = mid
+		} else {
+			next = mid
+		}
+	}
+
+	return number
+}
+
+// latestHeight returns the latest height committed by the node
+func (h *Hub) latestHeight() (int64, error) {
+	_, height, err := h.abciQuery(0, fmt.Sprintf("custom/%s/%s", chainmanagerTypes.QuerierRoute, chainmanagerTypes.QueryParams), nil)
+	return height, err
+}
+
+// feedKey returns the key of the feed of kind of the zena chain chainID, and the chain id the
+// state-sync records are filtered by
+func (h *Hub) feedKey(kind Kind, chainID string) (feedKey, string, error) {
+	if chainID == "" {
+		return feedKey{kind: kind}, "", nil
+	}
+
+	res, _, err := h.abciQuery(0, fmt.Sprintf("custom/%s/%s", chainmanagerTypes.QuerierRoute, chainmanagerTypes.QueryParams), nil)
+	if err != nil {
+		return feedKey{}, "", err
+	}
+
+	var params chainmanagerTypes.Params
+	if err := jsoniter.ConfigFastest.Unmarshal(res, &params); err != nil {
+		return feedKey{}, "", err
+	}
+
+	if _, ok := params.GetChainParams(chainID); !ok {
+		return feedKey{}, "", fmt.Errorf("%w %s", ErrUnknownChainID, chainID)
+	}
+
+	switch {
+	case kind == KindStateSync:
+		return feedKey{kind: kind}, chainID, nil
+	case chainID == params.ChainParams.ZenaChainID:
+		return feedKey{kind: kind}, "", nil
+	default:
+		return feedKey{kind: kind, chainID: chainID}, "", nil
+	}
+}
+
+// Subscribe returns a channel receiving the objects of kind of the zena chain chainID after
+// cursor, in order. An empty chainID is the chain of the chain params, and receives the
+// state-sync records of every chain. The channel is closed when ctx is done.
+func (h *Hub) Subscribe(ctx context.Context, kind Kind, chainID string, cursor uint64) (<-chan Event, error) {
+	key, recordChainID, err := h.feedKey(kind, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+
+	f, ok := h.feeds[key]
+	if !ok {
+		f = &feed{polled: make(chan struct{})}
+		h.feeds[key] = f
+	}
+
+	f.subscriptions++
+
+	h.mu.Unlock()
+
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+
+	events := make(chan Event, eventBufferSize)
+
+	go func() {
+		defer close(events)
+		defer h.unsubscribe(key, f)
+
+		// height of the object at cursor, later objects are not committed before
+		var height int64
+
+		for {
+			pending, polled := h.pending(key, f, cursor, height)
+
+			for _, event := range pending {
+				if recordChainID != "" && event.Record.ChainID != recordChainID {
+					cursor, height = event.Cursor, event.Height
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case events <- event:
+					cursor, height = event.Cursor, event.Height
+				}
+			}
+
+			if len(pending) > 0 {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-polled:
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// pending returns the events of a feed after cursor, committed from fromHeight, and the channel
+// closed on the next poll of the feed. The events not kept by the feed are read from the store,
+// eventBufferSize at a time.
+func (h *Hub) pending(key feedKey, f *feed, cursor uint64, fromHeight int64) ([]Event, <-chan struct{}) {
+	h.mu.Lock()
+
+	var (
+		height = f.height
+		latest = f.cursor
+		polled = f.polled
+		events []Event
+	)
+
+	if height == 0 || cursor >= latest {
+		h.mu.Unlock()
+		return nil, polled
+	}
+
+	if len(f.events) > 0 && cursor+1 >= f.events[0].Cursor {
+		for _, event := range f.events {
+			if event.Cursor > cursor {
+				events = append(events, event)
+			}
+		}
+
+		h.mu.Unlock()
+
+		return events, polled
+	}
+
+	h.mu.Unlock()
+
+	// behind the feed, the objects are read at the height of the feed
+	if key.kind == KindMilestone {
+		cursor = h.skipPrunedMilestones(key, cursor, height)
+	}
+
+	if fromHeight > 0 {
+		fromHeight--
+	}
+
+	events, _ = h.read(key, cursor, fromHeight, height, eventBufferSize)
+
+	return events, polled
+}
+
+// unsubscribe removes a subscription of a feed, and the feed with the last one
+func (h *Hub) unsubscribe(key feedKey, f *feed) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	f.subscriptions--
+	if f.subscriptions == 0 && h.feeds[key] == f {
+		delete(h.feeds, key)
+	}
+}
+
+// skipPrunedMilestones moves cursor past the milestones pruned from the store at height
+func (h *Hub) skipPrunedMilestones(key feedKey, cursor uint64, height int64) uint64 {
+	res, err := h.query(checkpointTypes.QuerierRoute, checkpointTypes.QueryCount, key.chainID, height, nil)
+	if err != nil {
+		return cursor
+	}
+
+	var count uint64
+	if err := json.Unmarshal(res, &count); err != nil {
+		return cursor
+	}
+
+	if count > helper.MilestonePruneNumber && cursor < count-helper.MilestonePruneNumber {
+		return count - helper.MilestonePruneNumber
+	}
+
+	return cursor
+}
+
+// fetch queries object number of a feed at height, an error is returned if it does not exist
+func (h *Hub) fetch(key feedKey, number uint64, height int64) (Event, error) {
+	event := Event{Kind: key.kind, Cursor: number}
+
+	var (
+		res []byte
+		err error
+	)
+
+	switch key.kind {
+	case KindCheckpoint:
+		res, err = h.query(checkpointTypes.QuerierRoute, checkpointTypes.QueryCheckpoint, key.chainID, height, checkpointTypes.NewQueryCheckpointParams(number))
+		if err == nil {
+			event.Checkpoint = new(hmTypes.Checkpoint)
+			err = jsoniter.ConfigFastest.Unmarshal(res, event.Checkpoint)
+		}
+	case KindMilestone:
+		res, err = h.query(checkpointTypes.QuerierRoute, checkpointTypes.QueryMilestoneByNumber, key.chainID, height, checkpointTypes.NewQueryMilestoneParams(number))
+		if err == nil {
+			event.Milestone = new(hmTypes.Milestone)
+			err = json.Unmarshal(res, event.Milestone)
+		}
+	case KindSpan:
+		res, err = h.query(zenaTypes.QuerierRoute, zenaTypes.QuerySpan, key.chainID, height, zenaTypes.NewQuerySpanParams(number))
+		if err == nil {
+			event.Span = new(hmTypes.Span)
+			err = jsoniter.ConfigFastest.Unmarshal(res, event.Span)
+		}
+	case KindStateSync:
+		res, err = h.query(clerkTypes.QuerierRoute, clerkTypes.QueryRecord, "", height, clerkTypes.NewQueryRecordParams(number))
+		if err == nil {
+			event.Record = new(clerkTypes.EventRecord)
+			err = jsoniter.ConfigFastest.Unmarshal(res, event.Record)
+		}
+	default:
+		err = fmt.Errorf("unknown subscription type %q", key.kind)
+	}
+
+	return event, err
+}
+
+// query runs an ABCI custom query at height, scoped to the zena child chain if set
+func (h *Hub) query(route string, path string, chainID string, height int64, params interface{}) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if params != nil {
+		if data, err = h.cdc.MarshalJSON(params); err != nil {
+			return nil, err
+		}
+	}
+
+	queryPath := fmt.Sprintf("custom/%s/%s", route, path)
+	if chainID != "" {
+		queryPath = fmt.Sprintf("%s/%s", queryPath, chainID)
+	}
+
+	res, _, err := h.abciQuery(height, queryPath, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no result for %s/%s", route, path)
+	}
+
+	return res, nil
+}
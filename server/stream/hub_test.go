@@ -0,0 +1,287 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
+	checkpointTypes "github.com/zenanetwork/iris/checkpoint/types"
+	clerkTypes "github.com/zenanetwork/iris/clerk/types"
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+const (
+	testChainID      = "15001"
+	testChildChainID = "15002"
+)
+
+// fakeNode serves the queries of the hub from objects committed at given heights
+type fakeNode struct {
+	cdc *codec.Codec
+
+	mtx    sync.Mutex
+	height int64
+
+	// commit heights of the checkpoints by querier path, and of the records
+	checkpoints map[string][]int64
+	records     []int64
+	// chain id of the records
+	recordChains []string
+
+	// number of queries by querier path
+	queries map[string]int
+}
+
+func (f *fakeNode) query(height int64, path string, data []byte) ([]byte, int64, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if height == 0 {
+		height = f.height
+	}
+
+	if height > f.height {
+		return nil, 0, fmt.Errorf("height %d is not committed yet", height)
+	}
+
+	f.queries[path]++
+
+	if path == "custom/chainmanager/params" {
+		params := chainmanagerTypes.DefaultParams()
+		params.ChainParams.ZenaChainID = testChainID
+		params.ChildChains = []chainmanagerTypes.ChildChainParams{{ZenaChainID: testChildChainID}}
+
+		res, err := jsoniter.ConfigFastest.Marshal(params)
+
+		return res, height, err
+	}
+
+	if path == "custom/clerk/record" {
+		var params clerkTypes.QueryRecordParams
+		if err := f.cdc.UnmarshalJSON(data, &params); err != nil {
+			return nil, 0, err
+		}
+
+		if !committed(f.records, params.RecordID, height) {
+			return nil, 0, errors.New("record not found")
+		}
+
+		res, err := jsoniter.ConfigFastest.Marshal(clerkTypes.EventRecord{ID: params.RecordID, ChainID: f.recordChains[params.RecordID-1]})
+
+		return res, height, err
+	}
+
+	commitHeights, ok := f.checkpoints[strings.TrimPrefix(path, "custom/checkpoint/checkpoint")]
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown path %s", path)
+	}
+
+	var params checkpointTypes.QueryCheckpointParams
+	if err := f.cdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, 0, err
+	}
+
+	if !committed(commitHeights, params.Number, height) {
+		return nil, 0, errors.New("checkpoint not found")
+	}
+
+	res, err := jsoniter.ConfigFastest.Marshal(hmTypes.Checkpoint{StartBlock: params.Number})
+
+	return res, height, err
+}
+
+// committed returns true if object number, numbered from one, is committed at height
+func committed(commitHeights []int64, number uint64, height int64) bool {
+	return number >= 1 && number <= uint64(len(commitHeights)) && commitHeights[number-1] <= height
+}
+
+// commit commits the objects at the next height
+func (f *fakeNode) commit(checkpoints map[string]int, records ...string) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.height++
+
+	for path, count := range checkpoints {
+		for i := 0; i < count; i++ {
+			f.checkpoints[path] = append(f.checkpoints[path], f.height)
+		}
+	}
+
+	for _, chainID := range records {
+		f.records = append(f.records, f.height)
+		f.recordChains = append(f.recordChains, chainID)
+	}
+}
+
+// takeQueries returns the number of queries by path since the last take
+func (f *fakeNode) takeQueries() map[string]int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	queries := f.queries
+	f.queries = make(map[string]int)
+
+	return queries
+}
+
+func newTestHub(t *testing.T) (*Hub, *fakeNode) {
+	t.Helper()
+
+	cdc := codec.New()
+	node := &fakeNode{
+		cdc:         cdc,
+		checkpoints: map[string][]int64{"": nil, "/" + testChildChainID: nil},
+		queries:     make(map[string]int),
+	}
+
+	hub := NewHub(cdc, "", log.NewNopLogger())
+	hub.abciQuery = node.query
+
+	return hub, node
+}
+
+func feedCount(hub *Hub) int {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	return len(hub.feeds)
+}
+
+// receive returns the next n events of a subscription
+func receive(t *testing.T, events <-chan Event, n int) []Event {
+	t.Helper()
+
+	received := make([]Event, 0, n)
+
+	for len(received) < n {
+		select {
+		case event := <-events:
+			received = append(received, event)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no event received", "received %d of %d", len(received), n)
+		}
+	}
+
+	return received
+}
+
+// cursors returns the cursor and the height of the events
+func cursors(events []Event) [][2]int64 {
+	res := make([][2]int64, 0, len(events))
+	for _, event := range events {
+		res = append(res, [2]int64{int64(event.Cursor), event.Height})
+	}
+
+	return res
+}
+
+func TestHubCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	hub, node := newTestHub(t)
+
+	// checkpoints 1 to 3 committed at heights 2, 4 and 4
+	node.commit(nil)
+	node.commit(map[string]int{"": 1})
+	node.commit(map[string]int{"/" + testChildChainID: 1})
+	node.commit(map[string]int{"": 2})
+	node.commit(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first, err := hub.Subscribe(ctx, KindCheckpoint, "", 0)
+	require.NoError(t, err)
+
+	second, err := hub.Subscribe(ctx, KindCheckpoint, testChainID, 2)
+	require.NoError(t, err)
+
+	child, err := hub.Subscribe(ctx, KindCheckpoint, testChildChainID, 0)
+	require.NoError(t, err)
+
+	_, err = hub.Subscribe(ctx, KindCheckpoint, "unknown", 0)
+	require.ErrorIs(t, err, ErrUnknownChainID)
+
+	// the chain of the chain params is the default one
+	require.Equal(t, 2, feedCount(hub))
+
+	hub.poll()
+
+	require.Equal(t, [][2]int64{{1, 2}, {2, 4}, {3, 4}}, cursors(receive(t, first, 3)))
+	require.Equal(t, [][2]int64{{3, 4}}, cursors(receive(t, second, 1)))
+	require.Equal(t, [][2]int64{{1, 3}}, cursors(receive(t, child, 1)))
+
+	// a new checkpoint is read once for all the subscriptions, at the height it is committed
+	node.takeQueries()
+	node.commit(map[string]int{"": 1})
+	hub.poll()
+
+	require.Equal(t, [][2]int64{{4, 6}}, cursors(receive(t, first, 1)))
+	require.Equal(t, [][2]int64{{4, 6}}, cursors(receive(t, second, 1)))
+	require.Equal(t, map[string]int{
+		"custom/chainmanager/params":                       1,
+		"custom/checkpoint/checkpoint":                     2,
+		"custom/checkpoint/checkpoint/" + testChildChainID: 1,
+	}, node.takeQueries())
+
+	// the commit height of the checkpoints of the heights not polled is bisected
+	node.commit(nil)
+	node.commit(map[string]int{"": 1})
+	node.commit(nil)
+	node.commit(map[string]int{"": 1})
+	hub.poll()
+
+	require.Equal(t, [][2]int64{{5, 8}, {6, 10}}, cursors(receive(t, first, 2)))
+	require.Equal(t, [][2]int64{{5, 8}, {6, 10}}, cursors(receive(t, second, 2)))
+
+	// the feed goes with its last subscription
+	cancel()
+
+	require.Eventually(t, func() bool { return feedCount(hub) == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestHubStateSyncs(t *testing.T) {
+	t.Parallel()
+
+	hub, node := newTestHub(t)
+
+	node.commit(nil, testChainID, testChildChainID)
+	node.commit(nil, testChainID)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	all, err := hub.Subscribe(ctx, KindStateSync, "", 0)
+	require.NoError(t, err)
+
+	child, err := hub.Subscribe(ctx, KindStateSync, testChildChainID, 0)
+	require.NoError(t, err)
+
+	// one feed for the records of every chain
+	require.Equal(t, 1, feedCount(hub))
+
+	hub.poll()
+
+	require.Equal(t, [][2]int64{{1, 1}, {2, 1}, {3, 2}}, cursors(receive(t, all, 3)))
+	require.Equal(t, [][2]int64{{2, 1}}, cursors(receive(t, child, 1)))
+
+	node.commit(nil, testChildChainID, testChainID)
+	hub.poll()
+
+	require.Equal(t, [][2]int64{{4, 3}, {5, 3}}, cursors(receive(t, all, 2)))
+
+	events := receive(t, child, 1)
+	require.Equal(t, [][2]int64{{4, 3}}, cursors(events))
+	require.Equal(t, testChildChainID, events[0].Record.ChainID)
+}
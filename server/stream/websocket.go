@@ -0,0 +1,125 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gorilla/websocket"
+	"github.com/tendermint/tendermint/libs/log"
+
+	hmRest "github.com/zenanetwork/iris/types/rest"
+)
+
+const (
+	// writeWait is the time allowed to write a message to the client
+	writeWait = 10 * time.Second
+
+	// pingInterval is the interval of websocket pings keeping idle subscriptions alive
+	pingInterval = 30 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	// subscriptions are read only, allow browser dashboards from any origin
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// WebsocketHandler serves subscriptions over websocket.
+// The subscription is selected with the `type` (checkpoint, milestone, span or state-sync),
+// `chain_id` and `cursor` query params, events are sent as JSON text messages.
+type WebsocketHandler struct {
+	hub    *Hub
+	cdc    *codec.Codec
+	logger log.Logger
+}
+
+// NewWebsocketHandler creates a websocket handler for the subscriptions of hub
+func NewWebsocketHandler(hub *Hub, cdc *codec.Codec, logger log.Logger) *WebsocketHandler {
+	return &WebsocketHandler{hub: hub, cdc: cdc, logger: logger}
+}
+
+func (h *WebsocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vars := r.URL.Query()
+
+	kind, err := ParseKind(vars.Get("type"))
+	if err != nil {
+		hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var cursor uint64
+	if vars.Get("cursor") != "" {
+		if cursor, err = strconv.ParseUint(vars.Get("cursor"), 10, 64); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := h.hub.Subscribe(ctx, kind, vars.Get("chain_id"), cursor)
+	if errors.Is(err, ErrUnknownChainID) {
+		hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
+		hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// upgrader already replied to the client
+		h.logger.Error("Error while upgrading to websocket", "error", err)
+		return
+	}
+
+	defer conn.Close()
+
+	// the connection outlives the http server read timeout
+	_ = conn.SetReadDeadline(time.Time{})
+
+	// clients do not send messages, read until the connection is closed
+	go func() {
+		defer cancel()
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			bz, err := h.cdc.MarshalJSON(event)
+			if err != nil {
+				h.logger.Error("Error while marshalling event", "kind", kind, "cursor", event.Cursor, "error", err)
+				return
+			}
+
+			_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+
+			if err := conn.WriteMessage(websocket.TextMessage, bz); err != nil {
+				return
+			}
+		}
+	}
+}
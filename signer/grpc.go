@@ -0,0 +1,242 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/zenanetwork/go-zenanet/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+)
+
+//
+// Protocol
+//
+// The signing service has two unary methods, PubKey and Sign, whose messages are
+// encoded in JSON (bytes in base64) under the CodecName content-subtype, so that
+// it is simple to implement in front of any key store or HSM.
+//
+
+const (
+	// ServiceName is the full name of the gRPC signing service
+	ServiceName = "iris.Signer"
+
+	// CodecName is the content-subtype of the gRPC signing service
+	CodecName = "signer-json"
+
+	// DefaultTimeout is the default timeout of a request to the signing service
+	DefaultTimeout = 5 * time.Second
+)
+
+// PubKeyRequest requests the public key of the validator
+type PubKeyRequest struct{}
+
+// PubKeyResponse is the 65 byte uncompressed public key of the validator
+type PubKeyResponse struct {
+	PubKey []byte `json:"pub_key"`
+}
+
+// SignRequest requests the signature of a 32 byte digest of a payload of the given kind
+type SignRequest struct {
+	Digest []byte `json:"digest"`
+	Kind   string `json:"kind"`
+}
+
+// SignResponse is the 65 byte [R || S || V] signature of a digest
+type SignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return CodecName
+}
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+func method(name string) string {
+	return fmt.Sprintf("/%s/%s", ServiceName, name)
+}
+
+//
+// Client
+//
+
+// GRPCSigner signs with the validator key held by a gRPC signing service
+type GRPCSigner struct {
+	conn    *grpc.ClientConn
+	pubKey  secp256k1.PubKeySecp256k1
+	timeout time.Duration
+}
+
+var _ Signer = (*GRPCSigner)(nil)
+
+// NewGRPCSigner connects to the signing service at address and fetches the public key of the validator
+func NewGRPCSigner(address string, timeout time.Duration) (*GRPCSigner, error) {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	opts := []grpc_retry.CallOption{
+		grpc_retry.WithMax(3),
+		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(500 * time.Millisecond)),
+		grpc_retry.WithCodes(codes.Unavailable),
+	}
+
+	conn, err := grpc.NewClient(address,
+		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(opts...)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(CodecName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &GRPCSigner{conn: conn, timeout: timeout}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var res PubKeyResponse
+	if err := conn.Invoke(ctx, method("PubKey"), &PubKeyRequest{}, &res); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("unable to fetch the public key from the signer at %s: %w", address, err)
+	}
+
+	if len(res.PubKey) != secp256k1.PubKeySecp256k1Size {
+		conn.Close()
+		return nil, fmt.Errorf("invalid public key length %d from the signer at %s", len(res.PubKey), address)
+	}
+
+	copy(s.pubKey[:], res.PubKey)
+
+	return s, nil
+}
+
+// PubKey implements Signer
+func (s *GRPCSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+// Sign implements Signer. The signature is checked against the public key of the validator.
+func (s *GRPCSigner) Sign(digest []byte, kind string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	var res SignResponse
+	if err := s.conn.Invoke(ctx, method("Sign"), &SignRequest{Digest: digest, Kind: kind}, &res); err != nil {
+		return nil, err
+	}
+
+	if err := VerifySignature(s, digest, res.Signature); err != nil {
+		return nil, fmt.Errorf("invalid signature from the signer: %w", err)
+	}
+
+	return res.Signature, nil
+}
+
+// Close closes the connection to the signing service
+func (s *GRPCSigner) Close() error {
+	return s.conn.Close()
+}
+
+//
+// Server
+//
+
+// signerServer serves the signatures of a signer
+type signerServer struct {
+	signer Signer
+	logger log.Logger
+}
+
+// RegisterSignerServer registers the signing service on the gRPC server, signing with signer
+func RegisterSignerServer(s *grpc.Server, signer Signer, logger log.Logger) {
+	s.RegisterService(&serviceDesc, &signerServer{signer: signer, logger: logger})
+}
+
+func (s *signerServer) pubKey(_ context.Context, _ *PubKeyRequest) (*PubKeyResponse, error) {
+	pubKey := s.signer.PubKey()
+	return &PubKeyResponse{PubKey: pubKey[:]}, nil
+}
+
+func (s *signerServer) sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	if len(req.Digest) != crypto.DigestLength || bytes.Equal(req.Digest, make([]byte, crypto.DigestLength)) {
+		return nil, status.Error(codes.InvalidArgument, "invalid digest")
+	}
+
+	sig, err := s.signer.Sign(req.Digest, req.Kind)
+	if err != nil {
+		s.logger.Error("Unable to sign", "kind", req.Kind, "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.logger.Info("Signed", "kind", req.Kind, "digest", fmt.Sprintf("%X", req.Digest))
+
+	return &SignResponse{Signature: sig}, nil
+}
+
+func pubKeyHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(*signerServer).pubKey(ctx, req.(*PubKeyRequest))
+	}
+
+	if interceptor == nil {
+		return call(ctx, in)
+	}
+
+	return interceptor(ctx, in, &grpc.UnaryServerInfo{FullMethod: method("PubKey")}, call)
+}
+
+func signHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(*signerServer).sign(ctx, req.(*SignRequest))
+	}
+
+	if interceptor == nil {
+		return call(ctx, in)
+	}
+
+	return interceptor(ctx, in, &grpc.UnaryServerInfo{FullMethod: method("Sign")}, call)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "PubKey", Handler: pubKeyHandler},
+		{MethodName: "Sign", Handler: signHandler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iris/signer",
+}
@@ -0,0 +1,45 @@
+package signer
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/zenanetwork/go-zenanet/crypto"
+)
+
+// LocalSigner signs with a key held in memory
+type LocalSigner struct {
+	privKey secp256k1.PrivKeySecp256k1
+	pubKey  secp256k1.PubKeySecp256k1
+}
+
+var _ Signer = (*LocalSigner)(nil)
+
+// NewLocalSigner creates a signer holding privKey
+func NewLocalSigner(privKey secp256k1.PrivKeySecp256k1) *LocalSigner {
+	pubKey, _ := privKey.PubKey().(secp256k1.PubKeySecp256k1)
+
+	return &LocalSigner{
+		privKey: privKey,
+		pubKey:  pubKey,
+	}
+}
+
+// PubKey implements Signer
+func (s *LocalSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+// Sign implements Signer
+func (s *LocalSigner) Sign(digest []byte, _ string) ([]byte, error) {
+	if len(digest) != crypto.DigestLength {
+		return nil, fmt.Errorf("invalid digest length %d", len(digest))
+	}
+
+	key, err := crypto.ToECDSA(s.privKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Sign(digest, key)
+}
@@ -0,0 +1,89 @@
+package signer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// PrivValidator signs votes and proposals with a tendermint private validator and the
+// side-tx results of votes with a Signer. The tendermint privval socket protocol has no
+// message for side-tx results, so a socket signer alone would leave them unsigned.
+type PrivValidator struct {
+	tmTypes.PrivValidator
+
+	signer Signer
+}
+
+var _ tmTypes.PrivValidator = (*PrivValidator)(nil)
+
+// NewPrivValidator wraps pv to sign side-tx results with signer, which must hold the same key
+func NewPrivValidator(pv tmTypes.PrivValidator, signer Signer) (*PrivValidator, error) {
+	pubKey := pv.GetPubKey()
+	if pubKey == nil {
+		return nil, fmt.Errorf("could not retrieve public key from private validator")
+	}
+
+	if !pubKey.Equals(signer.PubKey()) {
+		return nil, fmt.Errorf("private validator key %X and signer key %X differ", pubKey.Bytes(), signer.PubKey().Bytes())
+	}
+
+	return &PrivValidator{
+		PrivValidator: pv,
+		signer:        signer,
+	}, nil
+}
+
+// NewSocketPrivValidator listens on listenAddr for a tendermint privval socket signer,
+// as tendermint does for priv_validator_laddr, and signs side-tx results with signer.
+func NewSocketPrivValidator(listenAddr string, signer Signer, logger log.Logger) (*PrivValidator, error) {
+	endpoint, err := privval.NewSignerListener(listenAddr, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start private validator: %w", err)
+	}
+
+	client, err := privval.NewSignerClient(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start private validator: %w", err)
+	}
+
+	return NewPrivValidator(client, signer)
+}
+
+// SignSideTxResult implements tmTypes.PrivValidator
+func (pv *PrivValidator) SignSideTxResult(sideTxResult *tmTypes.SideTxResultWithData) error {
+	sig, err := SignBytes(pv.signer, sideTxResult.GetBytes(), KindSideTx)
+	if err != nil {
+		return err
+	}
+
+	sideTxResult.Sig = sig
+
+	return nil
+}
+
+// NewSocketSignerServer creates a tendermint privval socket signer serving pv, which dials
+// the node listening on its priv_validator_laddr, either tcp:// or unix://.
+func NewSocketSignerServer(addr string, chainID string, pv tmTypes.PrivValidator, logger log.Logger) (*privval.SignerServer, error) {
+	var dialer privval.SocketDialer
+
+	protocol, address := cmn.ProtocolAndAddress(addr)
+
+	switch protocol {
+	case "unix":
+		dialer = privval.DialUnixFn(address)
+	case "tcp":
+		dialer = privval.DialTCPFn(address, 3*time.Second, ed25519.GenPrivKey())
+	default:
+		return nil, fmt.Errorf("wrong node address: expected either 'tcp' or 'unix' protocols, got %s", protocol)
+	}
+
+	endpoint := privval.NewSignerDialerEndpoint(logger.With("module", "privval"), dialer)
+
+	return privval.NewSignerServer(endpoint, chainID, pv), nil
+}
@@ -0,0 +1,85 @@
+// Package signer signs with the validator key, which may be held by a remote signing service
+// instead of the validator host.
+package signer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/zenanetwork/go-zenanet/accounts/abi/bind"
+	"github.com/zenanetwork/go-zenanet/common"
+	"github.com/zenanetwork/go-zenanet/core/types"
+	"github.com/zenanetwork/go-zenanet/crypto"
+)
+
+// Kinds of the payloads a digest is signed for, passed to the signer for auditing
+const (
+	KindDigest     = "digest"       // raw digest
+	KindEthTx      = "eth-tx"       // Ethereum transaction sent to the main or matic chain
+	KindStdSignMsg = "std-sign-msg" // iris transaction
+	KindSideTx     = "side-tx"      // side-tx result of a vote
+)
+
+// Signer signs secp256k1 digests with the validator key
+type Signer interface {
+	// PubKey returns the public key of the validator
+	PubKey() secp256k1.PubKeySecp256k1
+
+	// Sign signs a 32 byte digest of a payload of the given kind, returning
+	// a 65 byte [R || S || V] signature
+	Sign(digest []byte, kind string) ([]byte, error)
+}
+
+// Address returns the Ethereum address of the signer
+func Address(s Signer) common.Address {
+	return common.BytesToAddress(s.PubKey().Address().Bytes())
+}
+
+// SignBytes signs the keccak256 digest of msg, as secp256k1 keys of the validator sign bytes
+func SignBytes(s Signer, msg []byte, kind string) ([]byte, error) {
+	return s.Sign(crypto.Keccak256(msg), kind)
+}
+
+// NewTransactor creates a transaction signer for the Ethereum chain with chainID.
+// It replaces bind.NewKeyedTransactorWithChainID for a key held by s.
+func NewTransactor(s Signer, chainID *big.Int) (*bind.TransactOpts, error) {
+	if chainID == nil {
+		return nil, bind.ErrNoChainID
+	}
+
+	from := Address(s)
+	txSigner := types.LatestSignerForChainID(chainID)
+
+	return &bind.TransactOpts{
+		From:    from,
+		Context: context.Background(),
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+
+			sig, err := s.Sign(txSigner.Hash(tx).Bytes(), KindEthTx)
+			if err != nil {
+				return nil, err
+			}
+
+			return tx.WithSignature(txSigner, sig)
+		},
+	}, nil
+}
+
+// VerifySignature checks that sig is a signature of digest by the public key of s
+func VerifySignature(s Signer, digest []byte, sig []byte) error {
+	pubKey, err := crypto.Ecrecover(digest, sig)
+	if err != nil {
+		return err
+	}
+
+	if addr := common.BytesToAddress(crypto.Keccak256(pubKey[1:])[12:]); addr != Address(s) {
+		return fmt.Errorf("signature by %s instead of %s", addr, Address(s))
+	}
+
+	return nil
+}
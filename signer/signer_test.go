@@ -0,0 +1,132 @@
+package signer
+
+import (
+	"math/big"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"github.com/zenanetwork/go-zenanet/common"
+	"github.com/zenanetwork/go-zenanet/core/types"
+	"github.com/zenanetwork/go-zenanet/crypto"
+	"google.golang.org/grpc"
+)
+
+func startGRPCSigner(t *testing.T, s Signer) *GRPCSigner {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	RegisterSignerServer(server, s, log.NewNopLogger())
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	client, err := NewGRPCSigner(listener.Addr().String(), 0)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
+
+func TestGRPCSigner(t *testing.T) {
+	t.Parallel()
+
+	local := NewLocalSigner(secp256k1.GenPrivKey())
+	remote := startGRPCSigner(t, local)
+
+	require.Equal(t, local.PubKey(), remote.PubKey())
+	require.Equal(t, Address(local), Address(remote))
+
+	msg := []byte("checkpoint")
+
+	localSig, err := SignBytes(local, msg, KindDigest)
+	require.NoError(t, err)
+
+	remoteSig, err := SignBytes(remote, msg, KindDigest)
+	require.NoError(t, err)
+
+	// signatures are deterministic (RFC 6979)
+	require.Equal(t, localSig, remoteSig)
+	require.NoError(t, VerifySignature(local, crypto.Keccak256(msg), remoteSig))
+
+	// the service only signs digests
+	_, err = remote.Sign(msg, KindDigest)
+	require.Error(t, err)
+
+	_, err = remote.Sign(make([]byte, crypto.DigestLength), KindDigest)
+	require.Error(t, err)
+}
+
+func TestNewTransactor(t *testing.T) {
+	t.Parallel()
+
+	remote := startGRPCSigner(t, NewLocalSigner(secp256k1.GenPrivKey()))
+	chainID := big.NewInt(80002)
+
+	auth, err := NewTransactor(remote, chainID)
+	require.NoError(t, err)
+	require.Equal(t, Address(remote), auth.From)
+
+	to := common.HexToAddress("0x1")
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21000,
+		To:        &to,
+	})
+
+	signedTx, err := auth.Signer(auth.From, tx)
+	require.NoError(t, err)
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+	require.NoError(t, err)
+	require.Equal(t, auth.From, sender)
+
+	_, err = auth.Signer(to, tx)
+	require.Error(t, err)
+}
+
+func TestPrivValidator(t *testing.T) {
+	t.Parallel()
+
+	filePV := privval.GenFilePV("", "")
+
+	privKey, ok := filePV.Key.PrivKey.(secp256k1.PrivKeySecp256k1)
+	require.True(t, ok)
+
+	remote := startGRPCSigner(t, NewLocalSigner(privKey))
+
+	pv, err := NewPrivValidator(filePV, remote)
+	require.NoError(t, err)
+
+	// side-tx results are signed as the file private validator signs them
+	expected := &tmTypes.SideTxResultWithData{
+		SideTxResult: tmTypes.SideTxResult{TxHash: []byte("tx"), Result: 1},
+	}
+	require.NoError(t, filePV.SignSideTxResult(expected))
+
+	result := &tmTypes.SideTxResultWithData{
+		SideTxResult: tmTypes.SideTxResult{TxHash: []byte("tx"), Result: 1},
+	}
+	require.NoError(t, pv.SignSideTxResult(result))
+	require.Equal(t, expected.Sig, result.Sig)
+
+	// the signer must hold the validator key
+	_, err = NewPrivValidator(filePV, NewLocalSigner(secp256k1.GenPrivKey()))
+	require.Error(t, err)
+}
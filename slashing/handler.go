@@ -3,7 +3,6 @@ package slashing
 import (
 	"bytes"
 	"encoding/hex"
-	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -113,13 +112,8 @@ func handleMsgUnjail(ctx sdk.Context, msg types.MsgUnjail, k Keeper, contractCal
 		"blockNumber", msg.BlockNumber,
 	)
 
-	// sequence id
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx is older
-	if k.HasSlashingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -155,13 +149,8 @@ func handleMsgTickAck(ctx sdk.Context, msg types.MsgTickAck, k Keeper, contractC
 		"blockNumber", msg.BlockNumber,
 	)
 
-	// sequence id
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx is older
-	if k.HasSlashingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
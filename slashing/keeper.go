@@ -10,6 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/zenanetwork/iris/chainmanager"
+	"github.com/zenanetwork/iris/logregistry"
+	logregistryTypes "github.com/zenanetwork/iris/logregistry/types"
 	"github.com/zenanetwork/iris/params/subspace"
 	"github.com/zenanetwork/iris/slashing/types"
 	"github.com/zenanetwork/iris/staking"
@@ -27,10 +29,12 @@ type Keeper struct {
 
 	// chain manager keeper
 	chainKeeper chainmanager.Keeper
+	// log registry keeper
+	logRegistry logregistry.Keeper
 }
 
 // NewKeeper creates a slashing keeper
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk staking.Keeper, paramSpace subspace.Subspace, codespace sdk.CodespaceType, chainKeeper chainmanager.Keeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk staking.Keeper, paramSpace subspace.Subspace, codespace sdk.CodespaceType, chainKeeper chainmanager.Keeper, logRegistry logregistry.Keeper) Keeper {
 	return Keeper{
 		storeKey:    key,
 		cdc:         cdc,
@@ -38,6 +42,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk staking.Keeper, paramSpace
 		paramSpace:  paramSpace.WithKeyTable(types.ParamKeyTable()),
 		codespace:   codespace,
 		chainKeeper: chainKeeper,
+		logRegistry: logRegistry,
 	}
 }
 
@@ -588,6 +593,37 @@ func (k *Keeper) GetSlashingSequences(ctx sdk.Context) (sequences []string) {
 	return
 }
 
+// HasProcessedLog checks if the slashing log at logIndex of the L1 block was already processed,
+// by any module once the log registry is active
+func (k *Keeper) HasProcessedLog(ctx sdk.Context, blockNumber uint64, logIndex uint64) bool {
+	if k.logRegistry.IsActive(ctx) {
+		return k.logRegistry.HasProcessedLog(ctx, blockNumber, logIndex)
+	}
+
+	return k.HasSlashingSequence(ctx, logregistryTypes.GetSequence(blockNumber, logIndex))
+}
+
+// SetProcessedLog marks the slashing log at logIndex of the L1 block as processed
+func (k *Keeper) SetProcessedLog(ctx sdk.Context, txHash hmTypes.IrisHash, logIndex uint64, blockNumber uint64) {
+	if k.logRegistry.IsActive(ctx) {
+		k.logRegistry.SetProcessedLog(ctx, logregistryTypes.NewProcessedLog(types.ModuleName, txHash, logIndex, blockNumber))
+		return
+	}
+
+	k.SetSlashingSequence(ctx, logregistryTypes.GetSequence(blockNumber, logIndex))
+}
+
+// MigrateSlashingSequences moves the slashing sequences to the log registry
+func (k *Keeper) MigrateSlashingSequences(ctx sdk.Context) {
+	sequences := k.GetSlashingSequences(ctx)
+	k.logRegistry.MigrateSequences(ctx, types.ModuleName, sequences)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, sequence := range sequences {
+		store.Delete(types.GetSlashingSequenceKey(sequence))
+	}
+}
+
 // IterateSlashingSequencesAndApplyFn iterate validators and apply the given function.
 func (k *Keeper) IterateSlashingSequencesAndApplyFn(ctx sdk.Context, f func(sequence string) error) {
 	store := ctx.KVStore(k.storeKey)
@@ -231,17 +231,16 @@ func querySlashingSequence(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 		return nil, sdk.ErrInternal("Transaction is not confirmed yet. Please wait for sometime and try again")
 	}
 
-	// sequence id
-
-	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(params.LogIndex))
-
 	// check if incoming tx already exists
-	if !keeper.HasSlashingSequence(ctx, sequence.String()) {
+	if !keeper.HasProcessedLog(ctx, receipt.BlockNumber.Uint64(), params.LogIndex) {
 		keeper.Logger(ctx).Error("No slashing sequence exist: %s %s", params.TxHash, params.LogIndex)
 		return nil, nil
 	}
 
+	// sequence id
+	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(params.LogIndex))
+
 	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, sequence)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
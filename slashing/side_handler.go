@@ -3,7 +3,6 @@ package slashing
 import (
 	"bytes"
 	"encoding/hex"
-	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -246,11 +245,7 @@ func PostHandleMsgTickAck(ctx sdk.Context, k Keeper, msg types.MsgTickAck, sideT
 	}
 
 	// check for replay -  check if incoming tx is older
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
-	if k.HasSlashingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -281,7 +276,7 @@ func PostHandleMsgTickAck(ctx sdk.Context, k Keeper, msg types.MsgTickAck, sideT
 	k.Logger(ctx).Debug("Successfully flushed tick slash info in tick-ack handler")
 
 	// save staking sequence
-	k.SetSlashingSequence(ctx, sequence.String())
+	k.SetProcessedLog(ctx, msg.TxHash, msg.LogIndex, msg.BlockNumber)
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -313,11 +308,7 @@ func PostHandleMsgUnjail(ctx sdk.Context, k Keeper, msg types.MsgUnjail, sideTxR
 	}
 
 	// check if incoming tx is older
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
-	if k.HasSlashingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -335,7 +326,7 @@ func PostHandleMsgUnjail(ctx sdk.Context, k Keeper, msg types.MsgUnjail, sideTxR
 	}
 
 	// save staking sequence
-	k.SetSlashingSequence(ctx, sequence.String())
+	k.SetProcessedLog(ctx, msg.TxHash, msg.LogIndex, msg.BlockNumber)
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -0,0 +1,122 @@
+package snapshot
+
+import (
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// App wraps the ABCI application of a running node to snapshot the node every interval blocks.
+//
+// Tendermint saves its state at a height after the application commits it, so the snapshot at
+// height h is taken as block h+1 is committed: every db of the node is then at h, and views of
+// them are taken before the application commits. The snapshot is written from the views while
+// the node carries on. A snapshot is skipped while the previous one is written.
+type App struct {
+	abci.Application
+
+	dir        string
+	dbs        *DBs
+	interval   uint64
+	keepRecent int
+	logger     log.Logger
+
+	// height of the block being executed
+	height int64
+
+	mtx     sync.Mutex
+	running bool
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+// NewApp wraps app to snapshot the node every interval blocks to dir, keeping the keepRecent
+// latest snapshots. The tendermint dbs may be set once the node is created.
+func NewApp(app abci.Application, dir string, dbs *DBs, interval uint64, keepRecent int, logger log.Logger) *App {
+	return &App{
+		Application: app,
+		dir:         dir,
+		dbs:         dbs,
+		interval:    interval,
+		keepRecent:  keepRecent,
+		logger:      logger,
+	}
+}
+
+func (app *App) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	app.height = req.Header.Height
+	return app.Application.BeginBlock(req)
+}
+
+func (app *App) Commit() abci.ResponseCommit {
+	if height := app.height - 1; height > 0 && uint64(height)%app.interval == 0 {
+		app.snapshot(height)
+	}
+
+	return app.Application.Commit()
+}
+
+// Wait waits for the snapshot being written, if any
+func (app *App) Wait() {
+	app.wg.Wait()
+}
+
+// Close stops taking snapshots and waits for the one being written, if any, which reads the dbs
+// of the node
+func (app *App) Close() {
+	app.mtx.Lock()
+	app.closed = true
+	app.mtx.Unlock()
+
+	app.wg.Wait()
+}
+
+// snapshot writes the snapshot at height from views of the dbs
+func (app *App) snapshot(height int64) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	if app.closed {
+		return
+	}
+
+	if app.running {
+		app.logger.Info("Skipping snapshot, the previous one is still written", "height", height)
+		return
+	}
+
+	views, release, err := app.dbs.View()
+	if err != nil {
+		app.logger.Error("Failed to create snapshot", "height", height, "error", err)
+		return
+	}
+
+	app.running = true
+	app.wg.Add(1)
+
+	go func() {
+		defer app.wg.Done()
+		defer app.setRunning(false)
+		defer release()
+
+		manifest, err := Create(app.dir, views, height)
+		if err != nil {
+			// blocks replayed at start are executed behind the tendermint state
+			app.logger.Error("Failed to create snapshot", "height", height, "error", err)
+			return
+		}
+
+		app.logger.Info("Created snapshot", "height", manifest.Height, "appHash", manifest.AppHash, "chunks", len(manifest.Chunks))
+
+		if err := Prune(app.dir, app.keepRecent); err != nil {
+			app.logger.Error("Failed to prune snapshots", "error", err)
+		}
+	}()
+}
+
+func (app *App) setRunning(running bool) {
+	app.mtx.Lock()
+	app.running = running
+	app.mtx.Unlock()
+}
@@ -0,0 +1,123 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// testApp commits a key of its store for every block
+type testApp struct {
+	abci.BaseApplication
+
+	ms  *rootmulti.Store
+	key *sdk.KVStoreKey
+
+	height int64
+}
+
+func (app *testApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	app.height = req.Header.Height
+	return abci.ResponseBeginBlock{}
+}
+
+func (app *testApp) Commit() abci.ResponseCommit {
+	app.ms.GetKVStore(app.key).Set(sdk.Uint64ToBigEndian(uint64(app.height)), []byte("value"))
+	return abci.ResponseCommit{Data: app.ms.Commit().Hash}
+}
+
+// TestApp runs the blocks of a node the way tendermint does, and checks the snapshots taken
+// every interval blocks against the app hashes of their heights
+func TestApp(t *testing.T) {
+	t.Parallel()
+
+	dataDir := t.TempDir()
+
+	var dbs DBs
+
+	for _, db := range []*dbm.DB{&dbs.Application, &dbs.State, &dbs.BlockStore} {
+		levelDB, err := dbm.NewGoLevelDB("db", t.TempDir())
+		require.NoError(t, err)
+
+		t.Cleanup(levelDB.Close)
+
+		*db = levelDB
+	}
+
+	key := sdk.NewKVStoreKey("test")
+	testApp := &testApp{ms: mountStores(t, dbs.Application, []*sdk.KVStoreKey{key}), key: key}
+
+	const interval = 3
+
+	app := NewApp(testApp, dataDir, &dbs, interval, 2, log.NewNopLogger())
+
+	state, err := sm.MakeGenesisState(&tmTypes.GenesisDoc{
+		ChainID:    "iris-snapshot",
+		Validators: []tmTypes.GenesisValidator{{PubKey: ed25519.GenPrivKey().PubKey(), Power: 10}},
+	})
+	require.NoError(t, err)
+	sm.SaveState(dbs.State, state)
+
+	blockStore := store.NewBlockStore(dbs.BlockStore)
+	appHashes := make(map[int64][]byte)
+
+	for height := int64(1); height <= 4*interval; height++ {
+		block := tmTypes.MakeBlock(height, nil, &tmTypes.Commit{}, nil)
+		blockStore.SaveBlock(block, block.MakePartSet(tmTypes.BlockPartSizeBytes), &tmTypes.Commit{})
+
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		res := app.Commit()
+
+		// the state is saved once the app has committed, the snapshot of the previous block
+		// being written from the views taken before
+		state.LastBlockHeight = height
+		state.AppHash = res.Data
+		sm.SaveState(dbs.State, state)
+
+		appHashes[height] = res.Data
+
+		// the next snapshot is not skipped
+		if height%interval == 0 {
+			app.Wait()
+		}
+	}
+
+	app.Close()
+
+	// snapshots at 3, 6 and 9, the last one taken as 10 commits, the latest two kept
+	manifests, err := List(dataDir)
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+
+	for i, height := range []int64{3 * interval, 2 * interval} {
+		require.Equal(t, height, manifests[i].Height)
+		require.Equal(t, appHashes[height], []byte(manifests[i].AppHash))
+		require.NoError(t, Verify(dataDir, manifests[i]))
+	}
+
+	// the snapshot is restored at its height
+	restored := DBs{Application: dbm.NewMemDB(), State: dbm.NewMemDB(), BlockStore: dbm.NewMemDB()}
+
+	_, err = Restore(dataDir, restored, 3*interval)
+	require.NoError(t, err)
+	require.Equal(t, int64(3*interval), sm.LoadState(restored.State).LastBlockHeight)
+	require.Equal(t, appHashes[3*interval], mountStores(t, restored.Application, []*sdk.KVStoreKey{key}).LastCommitID().Hash)
+
+	// no snapshot once closed
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 4*interval + 1}})
+	app.Commit()
+	app.Wait()
+
+	manifests, err = List(dataDir)
+	require.NoError(t, err)
+	require.Equal(t, int64(3*interval), manifests[0].Height)
+}
@@ -0,0 +1,402 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// keys of the multistore, the iavl stores and the tendermint state and block store
+const (
+	latestVersionKey = "s/latest"
+	commitInfoKeyFmt = "s/%d"
+	storeKeyFmt      = "s/k:%s/"
+
+	iavlRootPrefix = 'r'
+	iavlNodePrefix = 'n'
+
+	stateKey              = "stateKey"
+	validatorsKeyFmt      = "validatorsKey:%v"
+	consensusParamsKeyFmt = "consensusParamsKey:%v"
+	abciResponsesKeyFmt   = "abciResponsesKey:%v"
+	blockMetaKeyFmt       = "H:%v"
+	blockPartKeyFmt       = "P:%v:%v"
+	blockCommitKeyFmt     = "C:%v"
+	seenCommitKeyFmt      = "SC:%v"
+	blockStoreStateKey    = "blockStore"
+)
+
+// validatorsAhead is the number of heights past the state the validators are saved at
+const validatorsAhead = 2
+
+var cdc = amino.NewCodec()
+
+// commitInfo mirrors the commit info the multistore keeps for each version
+type commitInfo struct {
+	Version    int64
+	StoreInfos []storeInfo
+}
+
+type storeInfo struct {
+	Name string
+	Core storeCore
+}
+
+type storeCore struct {
+	CommitID commitID
+}
+
+type commitID struct {
+	Version int64
+	Hash    []byte
+}
+
+// hash returns the app hash of the version, the merkle root of the hashes of the stores
+func (ci commitInfo) hash() []byte {
+	m := make(map[string][]byte, len(ci.StoreInfos))
+	for _, si := range ci.StoreInfos {
+		m[si.Name] = tmhash.Sum(si.Core.CommitID.Hash)
+	}
+
+	return merkle.SimpleHashFromMap(m)
+}
+
+// Create writes a snapshot of the node at a height to a directory, the height of the tendermint
+// state of the node if zero. The tendermint state is only kept for the latest height, so a
+// snapshot can only be taken at the latest height of the node.
+func Create(dir string, dbs DBs, height int64) (*Manifest, error) {
+	state := sm.LoadState(dbs.State)
+	if state.IsEmpty() {
+		return nil, fmt.Errorf("no tendermint state to snapshot")
+	}
+
+	if height == 0 {
+		height = state.LastBlockHeight
+	}
+
+	if height != state.LastBlockHeight {
+		return nil, fmt.Errorf("tendermint state is at height %d, not %d", state.LastBlockHeight, height)
+	}
+
+	cInfo, err := loadCommitInfo(dbs.Application, height)
+	if err != nil {
+		return nil, err
+	}
+
+	appHash := cInfo.hash()
+	if !bytes.Equal(appHash, state.AppHash) {
+		return nil, fmt.Errorf("app hash %X at height %d does not match the tendermint state app hash %X", appHash, height, state.AppHash)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	// the snapshot is written to a temp directory, moved in place once complete
+	tmpDir := Dir(dir, height) + tmpSuffix
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return nil, err
+	}
+
+	if err := os.Mkdir(tmpDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	manifest, err := writeSnapshot(tmpDir, dbs, state, cInfo)
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		return nil, err
+	}
+
+	if err := os.RemoveAll(Dir(dir, height)); err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(tmpDir, Dir(dir, height)); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func writeSnapshot(dir string, dbs DBs, state sm.State, cInfo commitInfo) (*Manifest, error) {
+	height := state.LastBlockHeight
+	w := newChunkWriter(dir, DefaultChunkSize)
+
+	stores, err := writeApplication(w, dbs.Application, cInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeState(w, dbs.State, state); err != nil {
+		return nil, err
+	}
+
+	if err := writeBlockStore(w, dbs.BlockStore, height); err != nil {
+		return nil, err
+	}
+
+	chunks, err := w.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		Height:    height,
+		ChainID:   state.ChainID,
+		AppHash:   state.AppHash,
+		Format:    Format,
+		Stores:    stores,
+		Chunks:    chunks,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, manifestFile), bz, 0o644); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func loadCommitInfo(db dbm.DB, height int64) (commitInfo, error) {
+	var cInfo commitInfo
+
+	bz := db.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, height)))
+	if bz == nil {
+		return cInfo, fmt.Errorf("no application state at height %d", height)
+	}
+
+	if err := cdc.UnmarshalBinaryLengthPrefixed(bz, &cInfo); err != nil {
+		return cInfo, fmt.Errorf("invalid commit info at height %d: %w", height, err)
+	}
+
+	return cInfo, nil
+}
+
+// writeApplication writes the commit info of the multistore at the height, and the root and the
+// nodes of the tree of every store at that version. It returns the names of the stores.
+func writeApplication(w *chunkWriter, db dbm.DB, cInfo commitInfo) ([]string, error) {
+	height := cInfo.Version
+
+	latest, err := cdc.MarshalBinaryLengthPrefixed(height)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.WriteRecord(DBApplication, []byte(latestVersionKey), latest); err != nil {
+		return nil, err
+	}
+
+	commitInfoKey := []byte(fmt.Sprintf(commitInfoKeyFmt, height))
+	if err := w.WriteRecord(DBApplication, commitInfoKey, db.Get(commitInfoKey)); err != nil {
+		return nil, err
+	}
+
+	stores := make([]string, 0, len(cInfo.StoreInfos))
+	for _, si := range cInfo.StoreInfos {
+		stores = append(stores, si.Name)
+	}
+
+	sort.Strings(stores)
+
+	for _, name := range stores {
+		if err := writeIAVLStore(w, dbm.NewPrefixDB(db, []byte(fmt.Sprintf(storeKeyFmt, name))), name, height); err != nil {
+			return nil, err
+		}
+	}
+
+	return stores, nil
+}
+
+// writeIAVLStore writes the root of the iavl tree of a store at a version and the nodes reachable
+// from it, with the keys they have in the application db
+func writeIAVLStore(w *chunkWriter, db dbm.DB, name string, version int64) error {
+	prefix := []byte(fmt.Sprintf(storeKeyFmt, name))
+
+	rootKey := make([]byte, 9)
+	rootKey[0] = iavlRootPrefix
+	binary.BigEndian.PutUint64(rootKey[1:], uint64(version))
+
+	if !db.Has(rootKey) {
+		return fmt.Errorf("no version %d of store %s", version, name)
+	}
+
+	rootHash := db.Get(rootKey)
+
+	if err := w.WriteRecord(DBApplication, append(append([]byte{}, prefix...), rootKey...), rootHash); err != nil {
+		return err
+	}
+
+	// an empty tree has an empty root
+	hashes := [][]byte{}
+	if len(rootHash) != 0 {
+		hashes = append(hashes, rootHash)
+	}
+
+	for len(hashes) > 0 {
+		hash := hashes[len(hashes)-1]
+		hashes = hashes[:len(hashes)-1]
+
+		nodeKey := append([]byte{iavlNodePrefix}, hash...)
+
+		node := db.Get(nodeKey)
+		if node == nil {
+			return fmt.Errorf("missing node %X of store %s at version %d", hash, name, version)
+		}
+
+		if err := w.WriteRecord(DBApplication, append(append([]byte{}, prefix...), nodeKey...), node); err != nil {
+			return err
+		}
+
+		children, err := iavlNodeChildren(node)
+		if err != nil {
+			return fmt.Errorf("invalid node %X of store %s: %w", hash, name, err)
+		}
+
+		hashes = append(hashes, children...)
+	}
+
+	return nil
+}
+
+// iavlNodeChildren decodes the hashes of the children of an encoded iavl node, none for a leaf.
+// Nodes are encoded as height, size, version and key, then either the value of a leaf or the
+// hashes of the left and right children of an inner node.
+func iavlNodeChildren(node []byte) ([][]byte, error) {
+	height, n, err := amino.DecodeInt8(node)
+	if err != nil {
+		return nil, err
+	}
+
+	if height == 0 {
+		return nil, nil
+	}
+
+	node = node[n:]
+
+	// size and version
+	for i := 0; i < 2; i++ {
+		if _, n, err = amino.DecodeVarint(node); err != nil {
+			return nil, err
+		}
+
+		node = node[n:]
+	}
+
+	children := make([][]byte, 0, 3)
+	for i := 0; i < 3; i++ {
+		bz, n, err := amino.DecodeByteSlice(node)
+		if err != nil {
+			return nil, err
+		}
+
+		node = node[n:]
+		children = append(children, bz)
+	}
+
+	// the first slice is the key
+	return children[1:], nil
+}
+
+// writeState writes the tendermint state at its height, along with the validators, consensus
+// params and results it is looked up with. The sets are written in full at the heights they are
+// loaded at, with the state pointing at them as the last changes, since the changes before the
+// height are not part of the snapshot.
+func writeState(w *chunkWriter, db dbm.DB, state sm.State) error {
+	height := state.LastBlockHeight
+
+	for h := height; h <= height+validatorsAhead; h++ {
+		valSet, err := sm.LoadValidators(db, h)
+		if err != nil {
+			return err
+		}
+
+		valInfo := &sm.ValidatorsInfo{ValidatorSet: valSet, LastHeightChanged: h}
+		if err := w.WriteRecord(DBState, []byte(fmt.Sprintf(validatorsKeyFmt, h)), valInfo.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	for h := height; h <= height+1; h++ {
+		params, err := sm.LoadConsensusParams(db, h)
+		if err != nil {
+			return err
+		}
+
+		paramsInfo := sm.ConsensusParamsInfo{ConsensusParams: params, LastHeightChanged: h}
+		if err := w.WriteRecord(DBState, []byte(fmt.Sprintf(consensusParamsKeyFmt, h)), paramsInfo.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	abciResponsesKey := []byte(fmt.Sprintf(abciResponsesKeyFmt, height))
+	if abciResponses := db.Get(abciResponsesKey); abciResponses != nil {
+		if err := w.WriteRecord(DBState, abciResponsesKey, abciResponses); err != nil {
+			return err
+		}
+	}
+
+	if state.LastHeightValidatorsChanged < height+validatorsAhead {
+		state.LastHeightValidatorsChanged = height + validatorsAhead
+	}
+
+	if state.LastHeightConsensusParamsChanged < height+1 {
+		state.LastHeightConsensusParamsChanged = height + 1
+	}
+
+	return w.WriteRecord(DBState, []byte(stateKey), state.Bytes())
+}
+
+// writeBlockStore writes the block at the height, with its commit and the commit of the block
+// before it, and the block store state pointing at it
+func writeBlockStore(w *chunkWriter, db dbm.DB, height int64) error {
+	blockMeta := store.NewBlockStore(db).LoadBlockMeta(height)
+	if blockMeta == nil {
+		return fmt.Errorf("no block at height %d", height)
+	}
+
+	keys := [][]byte{[]byte(fmt.Sprintf(blockMetaKeyFmt, height))}
+	for i := uint32(0); i < blockMeta.BlockID.PartsHeader.Total; i++ {
+		keys = append(keys, []byte(fmt.Sprintf(blockPartKeyFmt, height, i)))
+	}
+
+	keys = append(keys,
+		[]byte(fmt.Sprintf(blockCommitKeyFmt, height-1)),
+		[]byte(fmt.Sprintf(seenCommitKeyFmt, height)),
+	)
+
+	for _, key := range keys {
+		value := db.Get(key)
+		if value == nil {
+			// the first block has no commit before it
+			continue
+		}
+
+		if err := w.WriteRecord(DBBlockStore, key, value); err != nil {
+			return err
+		}
+	}
+
+	// the block store state is encoded by the block store itself
+	memDB := dbm.NewMemDB()
+	store.BlockStoreStateJSON{Height: height}.Save(memDB)
+
+	return w.WriteRecord(DBBlockStore, []byte(blockStoreStateKey), memDB.Get([]byte(blockStoreStateKey)))
+}
@@ -0,0 +1,236 @@
+package snapshot
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+)
+
+// A snapshot is a stream of records, each a key and a value to set in one of the DBs of the
+// node, encoded as [db][uvarint key length][key][uvarint value length][value] and cut into
+// chunks of chunkSize bytes.
+
+// chunkWriter writes the records of a snapshot to chunk files in a directory
+type chunkWriter struct {
+	dir       string
+	chunkSize int64
+
+	file   *os.File
+	buf    *bufio.Writer
+	hasher hash.Hash
+	size   int64
+
+	chunks []Chunk
+}
+
+func newChunkWriter(dir string, chunkSize int64) *chunkWriter {
+	return &chunkWriter{dir: dir, chunkSize: chunkSize}
+}
+
+// Write implements io.Writer, cutting the chunks as they fill up
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+
+	for len(p) > 0 {
+		if w.file == nil {
+			if err := w.openChunk(); err != nil {
+				return written, err
+			}
+		}
+
+		n := int64(len(p))
+		if left := w.chunkSize - w.size; n > left {
+			n = left
+		}
+
+		if _, err := w.buf.Write(p[:n]); err != nil {
+			return written, err
+		}
+
+		w.hasher.Write(p[:n])
+		w.size += n
+		written += int(n)
+		p = p[n:]
+
+		if w.size == w.chunkSize {
+			if err := w.closeChunk(); err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+// WriteRecord writes a record setting key to value in the db with the given id
+func (w *chunkWriter) WriteRecord(db byte, key, value []byte) error {
+	header := make([]byte, 1+binary.MaxVarintLen64)
+	header[0] = db
+	n := binary.PutUvarint(header[1:], uint64(len(key)))
+
+	if _, err := w.Write(header[:1+n]); err != nil {
+		return err
+	}
+
+	if _, err := w.Write(key); err != nil {
+		return err
+	}
+
+	n = binary.PutUvarint(header, uint64(len(value)))
+	if _, err := w.Write(header[:n]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(value)
+
+	return err
+}
+
+// Close closes the last chunk and returns the chunks written
+func (w *chunkWriter) Close() ([]Chunk, error) {
+	if w.file != nil {
+		if err := w.closeChunk(); err != nil {
+			return nil, err
+		}
+	}
+
+	return w.chunks, nil
+}
+
+func (w *chunkWriter) openChunk() (err error) {
+	w.file, err = os.Create(chunkPath(w.dir, uint32(len(w.chunks))))
+	if err != nil {
+		return err
+	}
+
+	w.buf = bufio.NewWriter(w.file)
+	w.hasher = sha256.New()
+	w.size = 0
+
+	return nil
+}
+
+func (w *chunkWriter) closeChunk() error {
+	defer func() {
+		w.file = nil
+	}()
+
+	if err := w.buf.Flush(); err != nil {
+		w.file.Close()
+		return err
+	}
+
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+
+	w.chunks = append(w.chunks, Chunk{
+		Index: uint32(len(w.chunks)),
+		Hash:  w.hasher.Sum(nil),
+		Size:  w.size,
+	})
+
+	return nil
+}
+
+// chunkReader reads the records of a snapshot from its chunk files, in order
+type chunkReader struct {
+	dir    string
+	chunks []Chunk
+
+	file *os.File
+	r    *bufio.Reader
+}
+
+func newChunkReader(dir string, chunks []Chunk) *chunkReader {
+	return &chunkReader{dir: dir, chunks: chunks}
+}
+
+// ReadByte implements io.ByteReader, for the uvarint lengths
+func (r *chunkReader) ReadByte() (byte, error) {
+	var b [1]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return 0, err
+	}
+
+	return b[0], nil
+}
+
+// Read implements io.Reader over the chunks
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if r.file == nil {
+			if len(r.chunks) == 0 {
+				return 0, io.EOF
+			}
+
+			file, err := os.Open(chunkPath(r.dir, r.chunks[0].Index))
+			if err != nil {
+				return 0, err
+			}
+
+			r.file = file
+			r.r = bufio.NewReader(file)
+			r.chunks = r.chunks[1:]
+		}
+
+		n, err := r.r.Read(p)
+		if errors.Is(err, io.EOF) {
+			r.file.Close()
+			r.file = nil
+
+			if n == 0 {
+				continue
+			}
+
+			err = nil
+		}
+
+		return n, err
+	}
+}
+
+// ReadRecord reads the next record, returning io.EOF after the last one
+func (r *chunkReader) ReadRecord() (db byte, key, value []byte, err error) {
+	db, err = r.ReadByte()
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	if key, err = r.readBytes(); err != nil {
+		return 0, nil, nil, fmt.Errorf("truncated snapshot record: %w", err)
+	}
+
+	if value, err = r.readBytes(); err != nil {
+		return 0, nil, nil, fmt.Errorf("truncated snapshot record: %w", err)
+	}
+
+	return db, key, value, nil
+}
+
+func (r *chunkReader) readBytes() ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// Close closes the chunk being read
+func (r *chunkReader) Close() {
+	if r.file != nil {
+		r.file.Close()
+		r.file = nil
+	}
+}
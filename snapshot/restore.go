@@ -0,0 +1,101 @@
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// restoreBatchSize is the number of records written to a db in a batch
+const restoreBatchSize = 10000
+
+// Restore verifies the chunks of the snapshot at a height against its manifest and writes its
+// records to the databases of a node, which must be empty. The restored app hash is to be
+// checked against the manifest by loading the application.
+func Restore(dir string, dbs DBs, height int64) (*Manifest, error) {
+	manifest, err := Load(dir, height)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := Verify(dir, manifest); err != nil {
+		return nil, err
+	}
+
+	if err := CheckEmpty(dbs); err != nil {
+		return nil, err
+	}
+
+	r := newChunkReader(Dir(dir, height), manifest.Chunks)
+	defer r.Close()
+
+	batches := make(map[byte]dbm.Batch)
+	pending := 0
+
+	for {
+		id, key, value, err := r.ReadRecord()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		batch, ok := batches[id]
+		if !ok {
+			db, err := dbs.get(id)
+			if err != nil {
+				return nil, err
+			}
+
+			batch = db.NewBatch()
+			batches[id] = batch
+		}
+
+		batch.Set(key, value)
+
+		if pending++; pending == restoreBatchSize {
+			writeBatches(batches, false)
+
+			pending = 0
+		}
+	}
+
+	writeBatches(batches, true)
+
+	return manifest, nil
+}
+
+// CheckEmpty checks that the databases of a node hold no state
+func CheckEmpty(dbs DBs) error {
+	if dbs.Application.Has([]byte(latestVersionKey)) {
+		return fmt.Errorf("application db is not empty")
+	}
+
+	if !sm.LoadState(dbs.State).IsEmpty() {
+		return fmt.Errorf("tendermint state db is not empty")
+	}
+
+	if store.LoadBlockStoreStateJSON(dbs.BlockStore).Height != 0 {
+		return fmt.Errorf("block store db is not empty")
+	}
+
+	return nil
+}
+
+// writeBatches writes and clears the pending batches
+func writeBatches(batches map[byte]dbm.Batch, sync bool) {
+	for id, batch := range batches {
+		if sync {
+			batch.WriteSync()
+		} else {
+			batch.Write()
+		}
+
+		batch.Close()
+		delete(batches, id)
+	}
+}
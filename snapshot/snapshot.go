@@ -0,0 +1,195 @@
+// Package snapshot writes and restores chunked, hashed snapshots of the state of a node at a
+// height: the stores of every module in the application multistore, and the Tendermint state
+// and last block the node needs to carry on from that height.
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// Format is the version of the snapshot layout written by this package
+	Format = uint32(1)
+
+	// DefaultChunkSize is the max size of a snapshot chunk
+	DefaultChunkSize = 16 << 20
+
+	manifestFile = "manifest.json"
+	tmpSuffix    = ".tmp"
+)
+
+// DB ids the records of a snapshot are written to
+const (
+	DBApplication byte = iota
+	DBState
+	DBBlockStore
+)
+
+// DBs are the databases of a node a snapshot is taken from and restored to
+type DBs struct {
+	Application dbm.DB
+	State       dbm.DB
+	BlockStore  dbm.DB
+}
+
+func (dbs DBs) get(id byte) (dbm.DB, error) {
+	switch id {
+	case DBApplication:
+		return dbs.Application, nil
+	case DBState:
+		return dbs.State, nil
+	case DBBlockStore:
+		return dbs.BlockStore, nil
+	default:
+		return nil, fmt.Errorf("unknown snapshot db %d", id)
+	}
+}
+
+// Chunk is a chunk of the records of a snapshot
+type Chunk struct {
+	Index uint32          `json:"index"`
+	Hash  common.HexBytes `json:"hash"` // sha256 of the chunk
+	Size  int64           `json:"size"`
+}
+
+// Manifest describes a snapshot
+type Manifest struct {
+	Height    int64           `json:"height"`
+	ChainID   string          `json:"chain_id"`
+	AppHash   common.HexBytes `json:"app_hash"`
+	Format    uint32          `json:"format"`
+	Stores    []string        `json:"stores"`
+	Chunks    []Chunk         `json:"chunks"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// Hash returns the hash of the snapshot, over the hashes of its chunks
+func (m *Manifest) Hash() common.HexBytes {
+	hasher := sha256.New()
+	for _, chunk := range m.Chunks {
+		hasher.Write(chunk.Hash)
+	}
+
+	return hasher.Sum(nil)
+}
+
+// Size returns the size of the chunks of the snapshot
+func (m *Manifest) Size() (size int64) {
+	for _, chunk := range m.Chunks {
+		size += chunk.Size
+	}
+
+	return size
+}
+
+// Dir returns the directory of the snapshot at a height
+func Dir(dir string, height int64) string {
+	return filepath.Join(dir, strconv.FormatInt(height, 10))
+}
+
+func chunkPath(dir string, index uint32) string {
+	return filepath.Join(dir, strconv.FormatUint(uint64(index), 10))
+}
+
+// Load returns the manifest of the snapshot at a height
+func Load(dir string, height int64) (*Manifest, error) {
+	bz, err := os.ReadFile(filepath.Join(Dir(dir, height), manifestFile))
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest of snapshot %d: %w", height, err)
+	}
+
+	if manifest.Format != Format {
+		return nil, fmt.Errorf("unsupported format %d of snapshot %d, expected %d", manifest.Format, height, Format)
+	}
+
+	return &manifest, nil
+}
+
+// List returns the manifests of the snapshots in a directory, the latest first
+func List(dir string) ([]*Manifest, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	manifests := make([]*Manifest, 0, len(entries))
+
+	for _, entry := range entries {
+		height, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if !entry.IsDir() || err != nil {
+			continue
+		}
+
+		manifest, err := Load(dir, height)
+		if err != nil {
+			return nil, err
+		}
+
+		manifests = append(manifests, manifest)
+	}
+
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].Height > manifests[j].Height
+	})
+
+	return manifests, nil
+}
+
+// Prune deletes the snapshots in a directory but the keepRecent latest ones
+func Prune(dir string, keepRecent int) error {
+	manifests, err := List(dir)
+	if err != nil {
+		return err
+	}
+
+	for i := keepRecent; i < len(manifests); i++ {
+		if err := os.RemoveAll(Dir(dir, manifests[i].Height)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Verify checks the chunks of the snapshot at a height against the hashes of its manifest
+func Verify(dir string, manifest *Manifest) error {
+	for _, chunk := range manifest.Chunks {
+		f, err := os.Open(chunkPath(Dir(dir, manifest.Height), chunk.Index))
+		if err != nil {
+			return err
+		}
+
+		hasher := sha256.New()
+		size, err := io.Copy(hasher, f)
+		f.Close()
+
+		if err != nil {
+			return err
+		}
+
+		if size != chunk.Size || !bytes.Equal(hasher.Sum(nil), chunk.Hash) {
+			return fmt.Errorf("chunk %d of snapshot %d does not match its hash", chunk.Index, manifest.Height)
+		}
+	}
+
+	return nil
+}
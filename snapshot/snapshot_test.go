@@ -0,0 +1,155 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestChunkRecords(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	// records span several chunks of 64 bytes
+	w := newChunkWriter(dir, 64)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, w.WriteRecord(byte(i%3), []byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))))
+	}
+
+	chunks, err := w.Close()
+	require.NoError(t, err)
+	require.Greater(t, len(chunks), 1)
+
+	r := newChunkReader(dir, chunks)
+	defer r.Close()
+
+	for i := 0; ; i++ {
+		db, key, value, err := r.ReadRecord()
+		if errors.Is(err, io.EOF) {
+			require.Equal(t, 20, i)
+			break
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, byte(i%3), db)
+		require.Equal(t, fmt.Sprintf("key-%d", i), string(key))
+		require.Equal(t, fmt.Sprintf("value-%d", i), string(value))
+	}
+}
+
+func TestVerifyAndPrune(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, os.MkdirAll(Dir(dir, height), 0o755))
+
+		w := newChunkWriter(Dir(dir, height), DefaultChunkSize)
+		require.NoError(t, w.WriteRecord(DBApplication, []byte("key"), []byte("value")))
+
+		chunks, err := w.Close()
+		require.NoError(t, err)
+
+		manifest := &Manifest{Height: height, Format: Format, Chunks: chunks}
+		require.NoError(t, Verify(dir, manifest))
+
+		bz, err := json.Marshal(manifest)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(Dir(dir, height), manifestFile), bz, 0o644))
+	}
+
+	// a tampered chunk is detected
+	require.NoError(t, os.WriteFile(chunkPath(Dir(dir, 2), 0), []byte("tampered"), 0o644))
+	manifest, err := Load(dir, 2)
+	require.NoError(t, err)
+	require.Error(t, Verify(dir, manifest))
+
+	require.NoError(t, Prune(dir, 2))
+
+	manifests, err := List(dir)
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+	require.Equal(t, int64(3), manifests[0].Height)
+	require.Equal(t, int64(2), manifests[1].Height)
+}
+
+// TestApplicationRecords checks that the records of the multistore at a version restore the
+// stores with the same commit
+func TestApplicationRecords(t *testing.T) {
+	t.Parallel()
+
+	keys := []*sdk.KVStoreKey{sdk.NewKVStoreKey("staking"), sdk.NewKVStoreKey("sidechannel"), sdk.NewKVStoreKey("empty")}
+
+	db := dbm.NewMemDB()
+	ms := mountStores(t, db, keys)
+
+	for version := 0; version < 5; version++ {
+		for i := 0; i < 50; i++ {
+			ms.GetKVStore(keys[0]).Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d-%d", version, i)))
+			ms.GetKVStore(keys[1]).Set([]byte(fmt.Sprintf("key-%d-%d", version, i)), []byte("value"))
+		}
+
+		ms.Commit()
+	}
+
+	commitID := ms.LastCommitID()
+
+	cInfo, err := loadCommitInfo(db, commitID.Version)
+	require.NoError(t, err)
+	require.Equal(t, commitID.Hash, cInfo.hash())
+
+	dir := t.TempDir()
+	w := newChunkWriter(dir, 1024)
+
+	stores, err := writeApplication(w, db, cInfo)
+	require.NoError(t, err)
+	require.Equal(t, []string{"empty", "sidechannel", "staking"}, stores)
+
+	chunks, err := w.Close()
+	require.NoError(t, err)
+
+	restored := dbm.NewMemDB()
+	r := newChunkReader(dir, chunks)
+
+	defer r.Close()
+
+	for {
+		_, key, value, err := r.ReadRecord()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(t, err)
+		restored.Set(key, value)
+	}
+
+	restoredMS := mountStores(t, restored, keys)
+	require.Equal(t, commitID, restoredMS.LastCommitID())
+	require.Equal(t, []byte("value-4-7"), restoredMS.GetKVStore(keys[0]).Get([]byte("key-7")))
+	require.Equal(t, []byte("value"), restoredMS.GetKVStore(keys[1]).Get([]byte("key-0-7")))
+}
+
+func mountStores(t *testing.T, db dbm.DB, keys []*sdk.KVStoreKey) *rootmulti.Store {
+	t.Helper()
+
+	ms := rootmulti.NewStore(db)
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+
+	require.NoError(t, ms.LoadLatestVersion())
+
+	return ms
+}
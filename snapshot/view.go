@@ -0,0 +1,98 @@
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// levelDB is a db with the goleveldb backend
+type levelDB interface {
+	DB() *leveldb.DB
+}
+
+// viewDB is a point-in-time view of a goleveldb db. A snapshot is written through Get and Has
+// only, the rest of the interface is the one of the db itself and must not be used.
+type viewDB struct {
+	dbm.DB
+	snap *leveldb.Snapshot
+}
+
+func (db viewDB) Get(key []byte) []byte {
+	if key == nil {
+		key = []byte{}
+	}
+
+	res, err := db.snap.Get(key, nil)
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil
+		}
+
+		panic(err)
+	}
+
+	return res
+}
+
+func (db viewDB) Has(key []byte) bool {
+	return db.Get(key) != nil
+}
+
+func (db viewDB) Iterator(_, _ []byte) dbm.Iterator {
+	panic("snapshot views cannot be iterated")
+}
+
+func (db viewDB) ReverseIterator(_, _ []byte) dbm.Iterator {
+	panic("snapshot views cannot be iterated")
+}
+
+// View returns point-in-time views of the dbs, to write a snapshot from while the node carries
+// on, and the func releasing them. The dbs must have the goleveldb backend.
+func (dbs DBs) View() (DBs, func(), error) {
+	var (
+		views DBs
+		snaps []*leveldb.Snapshot
+	)
+
+	release := func() {
+		for _, snap := range snaps {
+			snap.Release()
+		}
+	}
+
+	for _, id := range []byte{DBApplication, DBState, DBBlockStore} {
+		db, err := dbs.get(id)
+		if err != nil {
+			release()
+			return views, nil, err
+		}
+
+		ldb, ok := db.(levelDB)
+		if !ok {
+			release()
+			return views, nil, fmt.Errorf("snapshot db %d does not have the goleveldb backend", id)
+		}
+
+		snap, err := ldb.DB().GetSnapshot()
+		if err != nil {
+			release()
+			return views, nil, err
+		}
+
+		snaps = append(snaps, snap)
+
+		switch id {
+		case DBApplication:
+			views.Application = viewDB{DB: db, snap: snap}
+		case DBState:
+			views.State = viewDB{DB: db, snap: snap}
+		case DBBlockStore:
+			views.BlockStore = viewDB{DB: db, snap: snap}
+		}
+	}
+
+	return views, release, nil
+}
@@ -19,4 +19,8 @@ const (
 	FlagStartEpoch        = "start-epoch"
 	FlagEndEpoch          = "end-epoch"
 	FlagTimes             = "times"
+	FlagMilestone         = "milestone"
+	FlagFromHeight        = "from-height"
+	FlagToHeight          = "to-height"
+	FlagLimit             = "limit"
 )
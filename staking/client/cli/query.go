@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -32,6 +33,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetValidatorInfo(cdc),
 			GetCurrentValSet(cdc),
+			GetValSet(cdc),
+			GetValSetChangelog(cdc),
 			GetTotalStakingPower(cdc),
 			GetValidatorStatus(cdc),
 			GetProposer(cdc),
@@ -116,6 +119,71 @@ func GetCurrentValSet(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetValSet validator set at a height
+func GetValSet(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set",
+		Short: "show the validator set at a height (--height), defaults to the latest height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := types.QueryCurrentValidatorSet
+			if viper.GetBool(FlagMilestone) {
+				route = types.QueryMilestoneValidatorSet
+			}
+
+			// get validator set at height
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, route), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("{\"height\":%v,\"validator_set\":%s}\n", height, res)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(FlagMilestone, false, "--milestone to show the milestone validator set")
+
+	return cmd
+}
+
+// GetValSetChangelog validator set diffs in a height range
+func GetValSetChangelog(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-changelog",
+		Short: "show the validator set diffs applied between two heights",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			fromHeight := viper.GetInt64(FlagFromHeight)
+			toHeight := viper.GetInt64(FlagToHeight)
+			if toHeight == 0 {
+				toHeight = math.MaxInt64 - 1
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetChangelogParams(fromHeight, toHeight, viper.GetUint64(FlagLimit)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetChangelog), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "--from-height=<first height of the range>")
+	cmd.Flags().Int64(FlagToHeight, 0, "--to-height=<last height of the range, defaults to the latest height>")
+	cmd.Flags().Uint64(FlagLimit, types.MaxValidatorSetChangelogLimit, "--limit=<max number of diffs>")
+
+	return cmd
+}
+
 // Get total staking power
 func GetTotalStakingPower(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
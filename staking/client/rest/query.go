@@ -3,6 +3,7 @@ package rest
 
 import (
 	"fmt"
+	"math"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -137,6 +138,10 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/validator-set",
 		validatorSetHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator-set/changelog",
+		validatorSetChangelogHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/proposer/{times}",
 		proposerHandlerFn(cliCtx),
@@ -376,13 +381,25 @@ func validatorByIDHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+//swagger:parameters stakingValidatorSet
+type stakingValidatorSetParams struct {
+
+	//Height of the validator set, defaults to the latest height
+	//in:query
+	Height int64 `json:"height"`
+
+	//Set to true for the milestone validator set
+	//in:query
+	Milestone bool `json:"milestone"`
+}
+
 // swagger:route GET /staking/validator-set staking stakingValidatorSet
-// It returns the current validator set
+// It returns the validator set at a height, the current one by default
 // responses:
 //
 //	200: stakingValidatorSetResponse
 //
-// get current validator set
+// get validator set
 func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -390,7 +407,12 @@ func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentValidatorSet), nil)
+		route := types.QueryCurrentValidatorSet
+		if r.URL.Query().Get("milestone") == "true" {
+			route = types.QueryMilestoneValidatorSet
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, route), nil)
 		if err != nil {
 			RestLogger.Error("Error while fetching current validator set ", "Error", err.Error())
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -409,6 +431,93 @@ func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+//swagger:response stakingValidatorSetChangelogResponse
+type stakingValidatorSetChangelogResponse struct {
+	//in:body
+	Output stakingValidatorSetChangelogStructure `json:"output"`
+}
+
+type stakingValidatorSetChangelogStructure struct {
+	Height string                   `json:"height"`
+	Result []types.ValidatorSetDiff `json:"result"`
+}
+
+//swagger:parameters stakingValidatorSetChangelog
+type stakingValidatorSetChangelogParams struct {
+
+	//First height of the range
+	//in:query
+	FromHeight int64 `json:"from-height"`
+
+	//Last height of the range, defaults to the latest height
+	//in:query
+	ToHeight int64 `json:"to-height"`
+
+	//Max number of diffs
+	//in:query
+	Limit uint64 `json:"limit"`
+}
+
+// swagger:route GET /staking/validator-set/changelog staking stakingValidatorSetChangelog
+// It returns the validator set diffs applied in a height range
+// responses:
+//
+//	200: stakingValidatorSetChangelogResponse
+//
+// get validator set changelog
+func validatorSetChangelogHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var (
+			fromHeight int64
+			toHeight   int64 = math.MaxInt64 - 1
+			limit      uint64
+		)
+
+		if vars.Get("from-height") != "" {
+			if fromHeight, ok = rest.ParseInt64OrReturnBadRequest(w, vars.Get("from-height")); !ok {
+				return
+			}
+		}
+
+		if vars.Get("to-height") != "" {
+			if toHeight, ok = rest.ParseInt64OrReturnBadRequest(w, vars.Get("to-height")); !ok {
+				return
+			}
+		}
+
+		if vars.Get("limit") != "" {
+			if limit, ok = rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit")); !ok {
+				return
+			}
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetChangelogParams(fromHeight, toHeight, limit))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetChangelog), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator set changelog ", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 //swagger:parameters stakingProposerByTime
 type Times struct {
 
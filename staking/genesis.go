@@ -41,6 +41,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	for _, sequence := range data.StakingSequences {
 		keeper.SetStakingSequence(ctx, sequence)
 	}
+
+	// move legacy sequences to the log registry once it is active
+	if keeper.logRegistry.IsActive(ctx) {
+		keeper.MigrateStakingSequences(ctx)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -3,7 +3,6 @@ package staking
 import (
 	"bytes"
 	"fmt"
-	"math/big"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,7 +10,6 @@ import (
 	hmCommon "github.com/zenanetwork/iris/common"
 	"github.com/zenanetwork/iris/helper"
 	"github.com/zenanetwork/iris/staking/types"
-	hmTypes "github.com/zenanetwork/iris/types"
 )
 
 // NewHandler new handler
@@ -68,13 +66,8 @@ func HandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keepe
 		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Invalid amount %v for validator %v", msg.Amount, msg.ID)).Result()
 	}
 
-	// sequence id
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -111,13 +104,8 @@ func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, c
 		return hmCommon.ErrNoValidator(k.Codespace()).Result()
 	}
 
-	// sequence id
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -151,13 +139,8 @@ func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper,
 		return hmCommon.ErrNoValidator(k.Codespace()).Result()
 	}
 
-	// sequence id
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -196,13 +179,8 @@ func HandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keepe
 		return hmCommon.ErrValUnbonded(k.Codespace()).Result()
 	}
 
-	// sequence id
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -13,9 +13,12 @@ import (
 
 	"github.com/zenanetwork/iris/chainmanager"
 	"github.com/zenanetwork/iris/helper"
+	"github.com/zenanetwork/iris/logregistry"
+	logregistryTypes "github.com/zenanetwork/iris/logregistry/types"
 	"github.com/zenanetwork/iris/params/subspace"
 	"github.com/zenanetwork/iris/staking/types"
 	hmTypes "github.com/zenanetwork/iris/types"
+	"github.com/zenanetwork/iris/upgrade"
 )
 
 var (
@@ -26,6 +29,8 @@ var (
 	CurrentValidatorSetKey          = []byte{0x23} // Key to store current validator set
 	StakingSequenceKey              = []byte{0x24} // prefix for each key for staking sequence map
 	CurrentMilestoneValidatorSetKey = []byte{0x25} // Key to store current validator set for milestone
+	ValidatorSetDiffKey             = []byte{0x26} // prefix for each key to a validator set diff
+	MilestoneParticipationKey       = []byte{0x27} // prefix for each key to the milestone participation of a validator
 )
 
 // ModuleCommunicator manages different module interaction
@@ -50,6 +55,10 @@ type Keeper struct {
 	chainKeeper chainmanager.Keeper
 	// module communicator
 	moduleCommunicator ModuleCommunicator
+	// log registry keeper
+	logRegistry logregistry.Keeper
+	// upgrade keeper
+	upgradeKeeper upgrade.Keeper
 }
 
 // NewKeeper create new keeper
@@ -59,7 +68,9 @@ func NewKeeper(
 	paramSpace subspace.Subspace,
 	codespace sdk.CodespaceType,
 	chainKeeper chainmanager.Keeper,
+	upgradeKeeper upgrade.Keeper,
 	moduleCommunicator ModuleCommunicator,
+	logRegistry logregistry.Keeper,
 ) Keeper {
 	keeper := Keeper{
 		cdc:                cdc,
@@ -68,6 +79,8 @@ func NewKeeper(
 		codespace:          codespace,
 		chainKeeper:        chainKeeper,
 		moduleCommunicator: moduleCommunicator,
+		logRegistry:        logRegistry,
+		upgradeKeeper:      upgradeKeeper,
 	}
 
 	return keeper
@@ -294,7 +307,7 @@ func (k *Keeper) UpdateValidatorSetInStore(ctx sdk.Context, newValidatorSet hmTy
 
 	//Hard fork changes for milestone
 	//When there is any update in checkpoint validator set, we assign it to milestone validator set too.
-	if ctx.BlockHeight() >= helper.GetAalborgHardForkHeight() {
+	if k.upgradeKeeper.IsUpgradeActive(ctx, helper.AalborgUpgrade) {
 		store.Set(CurrentMilestoneValidatorSetKey, bz)
 	}
 
@@ -316,6 +329,84 @@ func (k *Keeper) GetValidatorSet(ctx sdk.Context) (validatorSet hmTypes.Validato
 	return validatorSet
 }
 
+// GetValidatorSetDiffKey returns the key of the validator set diff at height
+func GetValidatorSetDiffKey(height int64) []byte {
+	return append(ValidatorSetDiffKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// AddValidatorSetDiff adds the validator set diff of a block to the changelog
+func (k *Keeper) AddValidatorSetDiff(ctx sdk.Context, diff types.ValidatorSetDiff) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(diff)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetValidatorSetDiffKey(diff.Height), bz)
+
+	return nil
+}
+
+// GetValidatorSetDiffs returns at most limit validator set diffs between fromHeight and toHeight, both included
+func (k *Keeper) GetValidatorSetDiffs(ctx sdk.Context, fromHeight, toHeight int64, limit uint64) (diffs []types.ValidatorSetDiff) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(GetValidatorSetDiffKey(fromHeight), GetValidatorSetDiffKey(toHeight+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(diffs)) < limit; iterator.Next() {
+		var diff types.ValidatorSetDiff
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &diff); err != nil {
+			k.Logger(ctx).Error("GetValidatorSetDiffs | UnmarshalBinaryBare", "error", err)
+			continue
+		}
+
+		diffs = append(diffs, diff)
+	}
+
+	return diffs
+}
+
+// GetMilestoneParticipationKey returns the key of the milestone participation of a validator
+func GetMilestoneParticipationKey(valID hmTypes.ValidatorID) []byte {
+	return append(MilestoneParticipationKey, valID.Bytes()...)
+}
+
+// RecordMilestoneParticipation counts a milestone proposer turn of the validator with signer,
+// as missed if the turn timed out
+func (k *Keeper) RecordMilestoneParticipation(ctx sdk.Context, signer hmTypes.IrisAddress, missed bool) {
+	validator, err := k.GetValidatorInfo(ctx, signer.Bytes())
+	if err != nil {
+		k.Logger(ctx).Error("RecordMilestoneParticipation | GetValidatorInfo", "signer", signer.String(), "error", err)
+		return
+	}
+
+	participation := k.GetMilestoneParticipation(ctx, validator.ID).Record(missed)
+
+	bz, err := k.cdc.MarshalBinaryBare(participation)
+	if err != nil {
+		k.Logger(ctx).Error("RecordMilestoneParticipation | MarshalBinaryBare", "error", err)
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Set(GetMilestoneParticipationKey(validator.ID), bz)
+}
+
+// GetMilestoneParticipation returns the milestone participation of a validator
+func (k *Keeper) GetMilestoneParticipation(ctx sdk.Context, valID hmTypes.ValidatorID) (participation types.MilestoneParticipation) {
+	bz := ctx.KVStore(k.storeKey).Get(GetMilestoneParticipationKey(valID))
+	if bz == nil {
+		return participation
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &participation); err != nil {
+		k.Logger(ctx).Error("GetMilestoneParticipation | UnmarshalBinaryBare", "error", err)
+	}
+
+	return participation
+}
+
 // IncrementAccum increments accum for validator set by n times and replace validator set in store
 func (k *Keeper) IncrementAccum(ctx sdk.Context, times int) {
 	// get validator set
@@ -434,6 +525,37 @@ func (k *Keeper) GetStakingSequences(ctx sdk.Context) (sequences []string) {
 	return
 }
 
+// HasProcessedLog checks if the staking log at logIndex of the L1 block was already processed,
+// by any module once the log registry is active
+func (k *Keeper) HasProcessedLog(ctx sdk.Context, blockNumber uint64, logIndex uint64) bool {
+	if k.logRegistry.IsActive(ctx) {
+		return k.logRegistry.HasProcessedLog(ctx, blockNumber, logIndex)
+	}
+
+	return k.HasStakingSequence(ctx, logregistryTypes.GetSequence(blockNumber, logIndex))
+}
+
+// SetProcessedLog marks the staking log at logIndex of the L1 block as processed
+func (k *Keeper) SetProcessedLog(ctx sdk.Context, txHash hmTypes.IrisHash, logIndex uint64, blockNumber uint64) {
+	if k.logRegistry.IsActive(ctx) {
+		k.logRegistry.SetProcessedLog(ctx, logregistryTypes.NewProcessedLog(types.ModuleName, txHash, logIndex, blockNumber))
+		return
+	}
+
+	k.SetStakingSequence(ctx, logregistryTypes.GetSequence(blockNumber, logIndex))
+}
+
+// MigrateStakingSequences moves the staking sequences to the log registry
+func (k *Keeper) MigrateStakingSequences(ctx sdk.Context) {
+	sequences := k.GetStakingSequences(ctx)
+	k.logRegistry.MigrateSequences(ctx, types.ModuleName, sequences)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, sequence := range sequences {
+		store.Delete(GetStakingSequenceKey(sequence))
+	}
+}
+
 // IterateStakingSequencesAndApplyFn iterate validators and apply the given function.
 func (k *Keeper) IterateStakingSequencesAndApplyFn(ctx sdk.Context, f func(sequence string) error) {
 	store := ctx.KVStore(k.storeKey)
@@ -12,6 +12,7 @@ import (
 
 	chSim "github.com/zenanetwork/iris/checkpoint/simulation"
 	stakingSim "github.com/zenanetwork/iris/staking/simulation"
+	stakingTypes "github.com/zenanetwork/iris/staking/types"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -409,6 +410,31 @@ func (suite *KeeperTestSuite) TestGetMilestoneProposer() {
 	require.Equal(t, currentValSet2.GetProposer(), currentMilestoneProposer)
 }
 
+func (suite *KeeperTestSuite) TestMilestoneParticipation() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(t, 4, keeper, ctx, false, 10, 0)
+	proposer := keeper.GetMilestoneCurrentProposer(ctx)
+
+	require.Zero(t, keeper.GetMilestoneParticipation(ctx, proposer.ID).Turns())
+
+	keeper.RecordMilestoneParticipation(ctx, proposer.Signer, false)
+	keeper.RecordMilestoneParticipation(ctx, proposer.Signer, true)
+	keeper.RecordMilestoneParticipation(ctx, proposer.Signer, true)
+
+	participation := keeper.GetMilestoneParticipation(ctx, proposer.ID)
+	require.Equal(t, stakingTypes.MilestoneParticipation{Proposed: 1, Missed: 2}, participation)
+	require.Equal(t, uint64(66), participation.MissedPercent())
+
+	// counts are halved once the window is full
+	for participation.Turns() < stakingTypes.MilestoneParticipationWindow-1 {
+		participation = participation.Record(false)
+	}
+
+	participation = participation.Record(true)
+	require.Equal(t, stakingTypes.MilestoneParticipation{Proposed: 48, Missed: 1}, participation)
+}
+
 func (suite *KeeperTestSuite) TestMilestoneValidatorSetIncAccumChange() {
 	// create sub test to check if validator remove
 	t, app, ctx := suite.T(), suite.app, suite.ctx
@@ -477,3 +503,33 @@ func (suite *KeeperTestSuite) TestUpdateMilestoneValidatorSetChange() {
 
 	require.Equal(t, prevValSet.TotalVotingPower(), currentValSet.TotalVotingPower(), "Total VotingPower should not change")
 }
+
+func (suite *KeeperTestSuite) TestValidatorSetDiffs() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	validators := stakingSim.GenRandomVal(3, 0, 10, 10, false, 1, 0)
+
+	for height := int64(1); height <= 5; height++ {
+		// updates are ordered by validator ID
+		updates := []*hmTypes.Validator{&validators[2], &validators[0]}
+		diff := stakingTypes.NewValidatorSetDiff(height*10, updates, validators[1].Signer)
+		require.Equal(t, validators[0].ID, diff.Updates[0].ID)
+		require.Equal(t, validators[2].ID, diff.Updates[1].ID)
+
+		require.NoError(t, keeper.AddValidatorSetDiff(ctx, diff))
+	}
+
+	diffs := keeper.GetValidatorSetDiffs(ctx, 20, 40, 10)
+	require.Len(t, diffs, 3)
+	require.Equal(t, int64(20), diffs[0].Height)
+	require.Equal(t, int64(40), diffs[2].Height)
+	require.Equal(t, validators[1].Signer, diffs[0].Proposer)
+
+	// limit
+	diffs = keeper.GetValidatorSetDiffs(ctx, 0, 100, 2)
+	require.Len(t, diffs, 2)
+	require.Equal(t, int64(10), diffs[0].Height)
+
+	require.Empty(t, keeper.GetValidatorSetDiffs(ctx, 51, 100, 10))
+}
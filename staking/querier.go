@@ -37,6 +37,10 @@ func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querie
 			return handleQueryTotalValidatorPower(ctx, req, keeper)
 		case types.QueryMilestoneProposer:
 			return handleQueryMilestoneProposer(ctx, req, keeper)
+		case types.QueryMilestoneValidatorSet:
+			return handleQueryMilestoneValidatorSet(ctx, req, keeper)
+		case types.QueryValidatorSetChangelog:
+			return handleQueryValidatorSetChangelog(ctx, req, keeper)
 
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
@@ -66,6 +70,46 @@ func handleQueryCurrentValidatorSet(ctx sdk.Context, _ abci.RequestQuery, keeper
 	return bz, nil
 }
 
+func handleQueryMilestoneValidatorSet(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// get milestone validator set
+	validatorSet := keeper.GetMilestoneValidatorSet(ctx)
+
+	// json record
+	bz, err := jsoniter.ConfigFastest.Marshal(validatorSet)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func handleQueryValidatorSetChangelog(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorSetChangelogParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.FromHeight < 0 || params.ToHeight < params.FromHeight {
+		return nil, sdk.ErrInternal(fmt.Sprintf("invalid height range %v-%v", params.FromHeight, params.ToHeight))
+	}
+
+	if params.Limit == 0 || params.Limit > types.MaxValidatorSetChangelogLimit {
+		params.Limit = types.MaxValidatorSetChangelogLimit
+	}
+
+	diffs := keeper.GetValidatorSetDiffs(ctx, params.FromHeight, params.ToHeight, params.Limit)
+	if diffs == nil {
+		diffs = []types.ValidatorSetDiff{}
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(diffs)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func handleQuerySigner(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QuerySignerParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
@@ -224,17 +268,16 @@ func handleQueryStakingSequence(ctx sdk.Context, req abci.RequestQuery, keeper K
 		return nil, sdk.ErrInternal("Transaction is not confirmed yet. Please wait for sometime and try again")
 	}
 
-	// sequence id
-
-	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(params.LogIndex))
-
 	// check if incoming tx already exists
-	if !keeper.HasStakingSequence(ctx, sequence.String()) {
+	if !keeper.HasProcessedLog(ctx, receipt.BlockNumber.Uint64(), params.LogIndex) {
 		keeper.Logger(ctx).Warn("No staking sequence exist: %s %s", params.TxHash, params.LogIndex)
 		return nil, nil
 	}
 
+	// sequence id
+	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(params.LogIndex))
+
 	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, sequence)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
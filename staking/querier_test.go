@@ -234,7 +234,7 @@ func (suite *QuerierTestSuite) TestHandleQueryStakingSequence() {
 	sequence := new(big.Int).Mul(txreceipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
 	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
 
-	app.StakingKeeper.SetStakingSequence(ctx, sequence.String())
+	app.StakingKeeper.SetProcessedLog(ctx, txHash, msg.LogIndex, txreceipt.BlockNumber.Uint64())
 
 	suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
 
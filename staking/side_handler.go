@@ -3,7 +3,6 @@ package staking
 import (
 	"bytes"
 	"fmt"
-	"math/big"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +14,7 @@ import (
 	"github.com/zenanetwork/iris/common"
 	hmCommon "github.com/zenanetwork/iris/common"
 	"github.com/zenanetwork/iris/helper"
+	logregistryTypes "github.com/zenanetwork/iris/logregistry/types"
 	"github.com/zenanetwork/iris/staking/types"
 	hmTypes "github.com/zenanetwork/iris/types"
 )
@@ -337,13 +337,8 @@ func PostHandleMsgValidatorJoin(ctx sdk.Context, k Keeper, msg types.MsgValidato
 		return common.ErrSideTxValidation(k.Codespace()).Result()
 	}
 
-	// Check for replay attack
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -373,7 +368,7 @@ func PostHandleMsgValidatorJoin(ctx sdk.Context, k Keeper, msg types.MsgValidato
 	}
 
 	// update last updated
-	newValidator.LastUpdated = sequence.String()
+	newValidator.LastUpdated = logregistryTypes.GetSequence(msg.BlockNumber, msg.LogIndex)
 
 	// add validator to store
 	k.Logger(ctx).Debug("Adding new validator to state", "validator", newValidator.String())
@@ -393,7 +388,7 @@ func PostHandleMsgValidatorJoin(ctx sdk.Context, k Keeper, msg types.MsgValidato
 	}
 
 	// save staking sequence
-	k.SetStakingSequence(ctx, sequence.String())
+	k.SetProcessedLog(ctx, msg.TxHash, msg.LogIndex, msg.BlockNumber)
 	k.Logger(ctx).Debug("✅ New validator successfully joined", "validator", strconv.FormatUint(newValidator.ID.Uint64(), 10))
 
 	// TX bytes
@@ -427,13 +422,8 @@ func PostHandleMsgStakeUpdate(ctx sdk.Context, k Keeper, msg types.MsgStakeUpdat
 		return common.ErrSideTxValidation(k.Codespace()).Result()
 	}
 
-	// Check for replay attack
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -454,7 +444,7 @@ func PostHandleMsgStakeUpdate(ctx sdk.Context, k Keeper, msg types.MsgStakeUpdat
 	k.Logger(ctx).Debug("Updating validator stake", "sideTxResult", sideTxResult)
 
 	// update last updated
-	validator.LastUpdated = sequence.String()
+	validator.LastUpdated = logregistryTypes.GetSequence(msg.BlockNumber, msg.LogIndex)
 
 	// update nonce
 	validator.Nonce = msg.Nonce
@@ -475,7 +465,7 @@ func PostHandleMsgStakeUpdate(ctx sdk.Context, k Keeper, msg types.MsgStakeUpdat
 	}
 
 	// save staking sequence
-	k.SetStakingSequence(ctx, sequence.String())
+	k.SetProcessedLog(ctx, msg.TxHash, msg.LogIndex, msg.BlockNumber)
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -506,12 +496,8 @@ func PostHandleMsgSignerUpdate(ctx sdk.Context, k Keeper, msg types.MsgSignerUpd
 		return common.ErrSideTxValidation(k.Codespace()).Result()
 	}
 
-	// Check for replay attack
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
 	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -537,7 +523,7 @@ func PostHandleMsgSignerUpdate(ctx sdk.Context, k Keeper, msg types.MsgSignerUpd
 	oldValidator := validator.Copy()
 
 	// update last updated
-	validator.LastUpdated = sequence.String()
+	validator.LastUpdated = logregistryTypes.GetSequence(msg.BlockNumber, msg.LogIndex)
 
 	// update nonce
 	validator.Nonce = msg.Nonce
@@ -562,7 +548,7 @@ func PostHandleMsgSignerUpdate(ctx sdk.Context, k Keeper, msg types.MsgSignerUpd
 	// remove old validator from TM
 	oldValidator.VotingPower = 0
 	// updated last
-	oldValidator.LastUpdated = sequence.String()
+	oldValidator.LastUpdated = logregistryTypes.GetSequence(msg.BlockNumber, msg.LogIndex)
 
 	// updated nonce
 	oldValidator.Nonce = msg.Nonce
@@ -584,7 +570,7 @@ func PostHandleMsgSignerUpdate(ctx sdk.Context, k Keeper, msg types.MsgSignerUpd
 	}
 
 	// save staking sequence
-	k.SetStakingSequence(ctx, sequence.String())
+	k.SetProcessedLog(ctx, msg.TxHash, msg.LogIndex, msg.BlockNumber)
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -633,13 +619,8 @@ func PostHandleMsgValidatorExit(ctx sdk.Context, k Keeper, msg types.MsgValidato
 		return common.ErrSideTxValidation(k.Codespace()).Result()
 	}
 
-	// Check for replay attack
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -662,7 +643,7 @@ func PostHandleMsgValidatorExit(ctx sdk.Context, k Keeper, msg types.MsgValidato
 	validator.EndEpoch = msg.DeactivationEpoch
 
 	// update last updated
-	validator.LastUpdated = sequence.String()
+	validator.LastUpdated = logregistryTypes.GetSequence(msg.BlockNumber, msg.LogIndex)
 
 	// update nonce
 	validator.Nonce = msg.Nonce
@@ -674,7 +655,7 @@ func PostHandleMsgValidatorExit(ctx sdk.Context, k Keeper, msg types.MsgValidato
 	}
 
 	// save staking sequence
-	k.SetStakingSequence(ctx, sequence.String())
+	k.SetProcessedLog(ctx, msg.TxHash, msg.LogIndex, msg.BlockNumber)
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
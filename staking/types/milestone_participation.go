@@ -0,0 +1,41 @@
+package types
+
+// MilestoneParticipationWindow is the number of milestone turns after which the participation
+// counts of a validator are halved, so that recent turns weigh more than old ones
+const MilestoneParticipationWindow uint64 = 100
+
+// MilestoneParticipation counts the milestone proposer turns of a validator
+type MilestoneParticipation struct {
+	Proposed uint64 `json:"proposed"` // turns the validator proposed an accepted milestone
+	Missed   uint64 `json:"missed"`   // turns that timed out with the validator as proposer
+}
+
+// Turns returns the number of counted turns
+func (p MilestoneParticipation) Turns() uint64 {
+	return p.Proposed + p.Missed
+}
+
+// MissedPercent returns the percentage of counted turns the validator missed, 0 without turns
+func (p MilestoneParticipation) MissedPercent() uint64 {
+	if p.Turns() == 0 {
+		return 0
+	}
+
+	return p.Missed * 100 / p.Turns()
+}
+
+// Record counts a turn, halving the counts once the window is full
+func (p MilestoneParticipation) Record(missed bool) MilestoneParticipation {
+	if missed {
+		p.Missed++
+	} else {
+		p.Proposed++
+	}
+
+	if p.Turns() >= MilestoneParticipationWindow {
+		p.Proposed /= 2
+		p.Missed /= 2
+	}
+
+	return p
+}
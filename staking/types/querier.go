@@ -6,18 +6,23 @@ import (
 
 // query endpoints supported by the staking Querier
 const (
-	QueryCurrentValidatorSet  = "current-validator-set"
-	QuerySigner               = "signer"
-	QueryValidator            = "validator"
-	QueryValidatorStatus      = "validator-status"
-	QueryProposer             = "proposer"
-	QueryTotalValidatorPower  = "total-val-power"
-	QueryCurrentProposer      = "current-proposer"
-	QueryProposerBonusPercent = "proposer-bonus-percent"
-	QueryStakingSequence      = "staking-sequence"
-	QueryMilestoneProposer    = "milestone-proposer"
+	QueryCurrentValidatorSet   = "current-validator-set"
+	QuerySigner                = "signer"
+	QueryValidator             = "validator"
+	QueryValidatorStatus       = "validator-status"
+	QueryProposer              = "proposer"
+	QueryTotalValidatorPower   = "total-val-power"
+	QueryCurrentProposer       = "current-proposer"
+	QueryProposerBonusPercent  = "proposer-bonus-percent"
+	QueryStakingSequence       = "staking-sequence"
+	QueryMilestoneProposer     = "milestone-proposer"
+	QueryMilestoneValidatorSet = "milestone-validator-set"
+	QueryValidatorSetChangelog = "validator-set-changelog"
 )
 
+// MaxValidatorSetChangelogLimit is the maximum number of validator set diffs returned by a changelog query
+const MaxValidatorSetChangelogLimit = 100
+
 // QuerySignerParams defines the params for querying by address
 type QuerySignerParams struct {
 	SignerAddress []byte `json:"signer_address"`
@@ -73,3 +78,15 @@ func NewQueryValidatorStatusParams(signerAddress []byte) QueryValidatorStatusPar
 func NewQueryStakingSequenceParams(txHash string, logIndex uint64) QueryStakingSequenceParams {
 	return QueryStakingSequenceParams{TxHash: txHash, LogIndex: logIndex}
 }
+
+// QueryValidatorSetChangelogParams defines the params for querying the validator set diffs in a height range
+type QueryValidatorSetChangelogParams struct {
+	FromHeight int64  `json:"from_height"`
+	ToHeight   int64  `json:"to_height"`
+	Limit      uint64 `json:"limit"`
+}
+
+// NewQueryValidatorSetChangelogParams creates a new instance of QueryValidatorSetChangelogParams.
+func NewQueryValidatorSetChangelogParams(fromHeight, toHeight int64, limit uint64) QueryValidatorSetChangelogParams {
+	return QueryValidatorSetChangelogParams{FromHeight: fromHeight, ToHeight: toHeight, Limit: limit}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"sort"
+
+	hmTypes "github.com/zenanetwork/iris/types"
+)
+
+// ValidatorUpdate is the voting power of a validator after a validator set update, zero if it was removed
+type ValidatorUpdate struct {
+	ID          hmTypes.ValidatorID `json:"ID"`
+	Signer      hmTypes.IrisAddress `json:"signer"`
+	VotingPower int64               `json:"power"`
+}
+
+// ValidatorSetDiff is the change applied to the validator set in the end block of Height
+type ValidatorSetDiff struct {
+	Height   int64               `json:"height"`
+	Updates  []ValidatorUpdate   `json:"updates"`
+	Proposer hmTypes.IrisAddress `json:"proposer"`
+}
+
+// NewValidatorSetDiff creates the diff of updates applied at height, ordered by validator ID
+func NewValidatorSetDiff(height int64, updates []*hmTypes.Validator, proposer hmTypes.IrisAddress) ValidatorSetDiff {
+	diff := ValidatorSetDiff{
+		Height:   height,
+		Updates:  make([]ValidatorUpdate, 0, len(updates)),
+		Proposer: proposer,
+	}
+
+	for _, v := range updates {
+		diff.Updates = append(diff.Updates, ValidatorUpdate{
+			ID:          v.ID,
+			Signer:      v.Signer,
+			VotingPower: v.VotingPower,
+		})
+	}
+
+	sort.Slice(diff.Updates, func(i, j int) bool {
+		return diff.Updates[i].ID < diff.Updates[j].ID
+	})
+
+	return diff
+}
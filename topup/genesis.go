@@ -11,6 +11,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		keeper.SetTopupSequence(ctx, sequence)
 	}
 
+	// move legacy sequences to the log registry once it is active
+	if keeper.logRegistry.IsActive(ctx) {
+		keeper.MigrateTopupSequences(ctx)
+	}
+
 	// Add genesis dividend accounts
 	for _, dividendAccount := range data.DividentAccounts {
 		if err := keeper.AddDividendAccount(ctx, dividendAccount); err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/zenanetwork/iris/app"
+	logregistryTypes "github.com/zenanetwork/iris/logregistry/types"
 	"github.com/zenanetwork/iris/topup"
 	"github.com/zenanetwork/iris/topup/types"
 	"github.com/zenanetwork/iris/types/simulation"
@@ -25,7 +26,7 @@ type GenesisTestSuite struct {
 
 // SetupTest setup necessary things for genesis test
 func (suite *GenesisTestSuite) SetupTest() {
-	suite.app, suite.ctx, _ = createTestApp(true)
+	suite.app, suite.ctx, _ = createTestApp(false)
 }
 
 // TestGenesisTestSuite
@@ -53,5 +54,12 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 
 	actualParams := topup.ExportGenesis(ctx, app.TopupKeeper)
 
-	require.LessOrEqual(t, len(topupSequences), len(actualParams.TopupSequences))
+	// the log registry is active from genesis, so the sequences are moved to it
+	require.Empty(t, actualParams.TopupSequences)
+
+	for _, sequence := range topupSequences {
+		blockNumber, logIndex, err := logregistryTypes.ParseSequence(sequence)
+		require.NoError(t, err)
+		require.True(t, app.LogRegistryKeeper.HasProcessedLog(ctx, blockNumber, logIndex))
+	}
 }
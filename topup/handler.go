@@ -42,13 +42,8 @@ func HandleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, contractCalle
 		return types.ErrSendDisabled(k.Codespace()).Result()
 	}
 
-	// sequence id
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx already exists
-	if k.HasTopupSequence(ctx, sequence.String()) {
+	if k.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
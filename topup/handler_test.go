@@ -1,7 +1,6 @@
 package topup_test
 
 import (
-	"math/big"
 	"math/rand"
 	"testing"
 	"time"
@@ -94,13 +93,8 @@ func (suite *HandlerTestSuite) TestHandleMsgTopup() {
 			blockNumber,
 		)
 
-		// sequence id
-		blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-		sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-		sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
-		// set sequence
-		app.TopupKeeper.SetTopupSequence(ctx, sequence.String())
+		// set processed log
+		app.TopupKeeper.SetProcessedLog(ctx, msg.TxHash, msg.LogIndex, msg.BlockNumber)
 
 		// handler
 		result := suite.handler(ctx, msg)
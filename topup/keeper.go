@@ -11,6 +11,8 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/zenanetwork/iris/bank"
 	"github.com/zenanetwork/iris/chainmanager"
+	"github.com/zenanetwork/iris/logregistry"
+	logregistryTypes "github.com/zenanetwork/iris/logregistry/types"
 	"github.com/zenanetwork/iris/params/subspace"
 	"github.com/zenanetwork/iris/staking"
 	"github.com/zenanetwork/iris/topup/types"
@@ -42,6 +44,8 @@ type Keeper struct {
 	bk bank.Keeper
 	// staking keeper
 	sk staking.Keeper
+	// log registry keeper
+	logRegistry logregistry.Keeper
 }
 
 // NewKeeper create new keeper
@@ -53,6 +57,7 @@ func NewKeeper(
 	chainKeeper chainmanager.Keeper,
 	bankKeeper bank.Keeper,
 	stakingKeeper staking.Keeper,
+	logRegistry logregistry.Keeper,
 ) Keeper {
 	return Keeper{
 		cdc:         cdc,
@@ -62,6 +67,7 @@ func NewKeeper(
 		chainKeeper: chainKeeper,
 		bk:          bankKeeper,
 		sk:          stakingKeeper,
+		logRegistry: logRegistry,
 	}
 }
 
@@ -119,6 +125,37 @@ func (keeper *Keeper) SetTopupSequence(ctx sdk.Context, sequence string) {
 	store.Set(GetTopupSequenceKey(sequence), DefaultValue)
 }
 
+// HasProcessedLog checks if the topup log at logIndex of the L1 block was already processed,
+// by any module once the log registry is active
+func (keeper *Keeper) HasProcessedLog(ctx sdk.Context, blockNumber uint64, logIndex uint64) bool {
+	if keeper.logRegistry.IsActive(ctx) {
+		return keeper.logRegistry.HasProcessedLog(ctx, blockNumber, logIndex)
+	}
+
+	return keeper.HasTopupSequence(ctx, logregistryTypes.GetSequence(blockNumber, logIndex))
+}
+
+// SetProcessedLog marks the topup log at logIndex of the L1 block as processed
+func (keeper *Keeper) SetProcessedLog(ctx sdk.Context, txHash hmTypes.IrisHash, logIndex uint64, blockNumber uint64) {
+	if keeper.logRegistry.IsActive(ctx) {
+		keeper.logRegistry.SetProcessedLog(ctx, logregistryTypes.NewProcessedLog(types.ModuleName, txHash, logIndex, blockNumber))
+		return
+	}
+
+	keeper.SetTopupSequence(ctx, logregistryTypes.GetSequence(blockNumber, logIndex))
+}
+
+// MigrateTopupSequences moves the topup sequences to the log registry
+func (keeper *Keeper) MigrateTopupSequences(ctx sdk.Context) {
+	sequences := keeper.GetTopupSequences(ctx)
+	keeper.logRegistry.MigrateSequences(ctx, types.ModuleName, sequences)
+
+	store := ctx.KVStore(keeper.key)
+	for _, sequence := range sequences {
+		store.Delete(GetTopupSequenceKey(sequence))
+	}
+}
+
 // HasTopupSequence checks if topup already exists
 func (keeper *Keeper) HasTopupSequence(ctx sdk.Context, sequence string) bool {
 	store := ctx.KVStore(keeper.key)
@@ -51,17 +51,16 @@ func querySequence(ctx sdk.Context, req abci.RequestQuery, k Keeper, contractCal
 		return nil, sdk.ErrInternal("Transaction is not confirmed yet. Please wait for sometime and try again")
 	}
 
-	// sequence id
-
-	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(params.LogIndex))
-
 	// check if incoming tx already exists
-	if !k.HasTopupSequence(ctx, sequence.String()) {
+	if !k.HasProcessedLog(ctx, receipt.BlockNumber.Uint64(), params.LogIndex) {
 		k.Logger(ctx).Error("No sequence exist: %s %s", params.TxHash, params.LogIndex)
 		return nil, nil
 	}
 
+	// sequence id
+	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(params.LogIndex))
+
 	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, sequence)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
@@ -85,10 +85,10 @@ func (suite *QuerierTestSuite) TestQuerySequence() {
 	logIndex := uint64(simulation.RandIntBetween(r1, 0, 100))
 	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
 
-	// set topup sequence
+	// set processed topup log
 	sequence := new(big.Int).Mul(txreceipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
 	sequence.Add(sequence, new(big.Int).SetUint64(logIndex))
-	app.TopupKeeper.SetTopupSequence(ctx, sequence.String())
+	app.TopupKeeper.SetProcessedLog(ctx, txHash, logIndex, txreceipt.BlockNumber.Uint64())
 
 	// mock external calls
 	suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
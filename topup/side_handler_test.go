@@ -101,11 +101,6 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgTopup() {
 			blockNumber,
 		)
 
-		// sequence id
-		bn := new(big.Int).SetUint64(msg.BlockNumber)
-		sequence := new(big.Int).Mul(bn, big.NewInt(hmTypes.DefaultLogIndexUnit))
-		sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 		// mock external call
 		event := &stakinginfo.StakinginfoTopUpFee{
 			User: ethCommon.BytesToAddress(addr1.Bytes()),
@@ -120,7 +115,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgTopup() {
 		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
 
 		// there should be no stored event record
-		ok := app.TopupKeeper.HasTopupSequence(ctx, sequence.String())
+		ok := app.TopupKeeper.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex)
 		require.False(t, ok)
 	})
 
@@ -336,18 +331,13 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgTopup() {
 			blockNumber,
 		)
 
-		// check if incoming tx is older
-		blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-		sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-		sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 		result := suite.postHandler(ctx, msg, abci.SideTxResultType_No)
 		require.False(t, result.IsOK(), "Post handler should fail")
 		require.Equal(t, common.CodeSideTxValidationFailed, result.Code)
 		require.Equal(t, 0, len(result.Events), "No error should be emitted for failed post-tx")
 
 		// there should be no stored sequence
-		ok := app.TopupKeeper.HasTopupSequence(ctx, sequence.String())
+		ok := app.TopupKeeper.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex)
 		require.False(t, ok)
 
 		// account coins should be empty
@@ -369,17 +359,12 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgTopup() {
 			blockNumber,
 		)
 
-		// check if incoming tx is older
-		blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-		sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-		sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
 		require.True(t, result.IsOK(), "Post handler should succeed")
 		require.Greater(t, len(result.Events), 0, "Appropriate error should be emitted for successful post-tx")
 
 		// there should be stored sequence
-		ok := app.TopupKeeper.HasTopupSequence(ctx, sequence.String())
+		ok := app.TopupKeeper.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex)
 		require.True(t, ok)
 
 		// account coins should be empty
@@ -407,17 +392,12 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgTopup() {
 			blockNumber,
 		)
 
-		// check if incoming tx is older
-		bn := new(big.Int).SetUint64(msg.BlockNumber)
-		sequence := new(big.Int).Mul(bn, big.NewInt(hmTypes.DefaultLogIndexUnit))
-		sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
 		require.True(t, result.IsOK(), "Post handler should succeed")
 		require.Greater(t, len(result.Events), 0, "Appropriate error should be emitted for successful post-tx")
 
 		// there should be stored sequence
-		ok := app.TopupKeeper.HasTopupSequence(ctx, sequence.String())
+		ok := app.TopupKeeper.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex)
 		require.True(t, ok)
 
 		// account coins should not be empty
@@ -452,17 +432,12 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgTopup() {
 			blockNumber,
 		)
 
-		// check if incoming tx is older
-		bn := new(big.Int).SetUint64(msg.BlockNumber)
-		sequence := new(big.Int).Mul(bn, big.NewInt(hmTypes.DefaultLogIndexUnit))
-		sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
 		require.True(t, result.IsOK(), "Post handler should succeed")
 		require.Greater(t, len(result.Events), 0, "Appropriate error should be emitted for successful post-tx")
 
 		// there should be stored sequence
-		ok := app.TopupKeeper.HasTopupSequence(ctx, sequence.String())
+		ok := app.TopupKeeper.HasProcessedLog(ctx, msg.BlockNumber, msg.LogIndex)
 		require.True(t, ok)
 
 		result = suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
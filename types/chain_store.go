@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChainStorePrefixKey prefixes the state a module keeps for the zena child chains secured in
+// addition to the chain of the chain params, whose state keeps the unprefixed keys
+var ChainStorePrefixKey = []byte{0x80}
+
+// GetChainStoreKey returns the prefix of the state of a zena child chain in a module store
+func GetChainStoreKey(chainID string) []byte {
+	key := make([]byte, 0, len(ChainStorePrefixKey)+len(chainID)+1)
+	key = append(key, ChainStorePrefixKey...)
+	key = append(key, chainID...)
+
+	return append(key, '/')
+}
+
+// ChainStore returns the state of a zena child chain in a module store, the store itself for
+// an empty chain id
+func ChainStore(store sdk.KVStore, chainID string) sdk.KVStore {
+	if chainID == "" {
+		return store
+	}
+
+	return prefix.NewStore(store, GetChainStoreKey(chainID))
+}
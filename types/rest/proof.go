@@ -1,5 +1,16 @@
 package rest
 
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
 // CommitTxProof commit tx proof
 type CommitTxProof struct {
 	Vote  string `json:"vote"`
@@ -14,3 +25,107 @@ type SideTxProof struct {
 	Tx   string      `json:"tx"`
 	Data string      `json:"data"`
 }
+
+// StoreProof is an IAVL proof of a store key against the app hash of a block.
+// The store state at Height is committed in AppHash of the header at Height+1.
+// An empty Value proves the key is absent.
+type StoreProof struct {
+	Height  int64         `json:"height"`
+	AppHash cmn.HexBytes  `json:"app_hash"`
+	Store   string        `json:"store"`
+	Key     cmn.HexBytes  `json:"key"`
+	Value   cmn.HexBytes  `json:"value"`
+	Proof   *merkle.Proof `json:"proof"`
+}
+
+// QueryStoreProof reads key from the store with its IAVL proof.
+// The store is read at the context height, or at the latest height with a committed app hash.
+// AppHash is certified by the light client verifier unless the node is trusted.
+func QueryStoreProof(cliCtx context.CLIContext, storeName string, key []byte) (StoreProof, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return StoreProof{}, err
+	}
+
+	height := cliCtx.Height
+	if height == 0 {
+		status, err := node.Status()
+		if err != nil {
+			return StoreProof{}, err
+		}
+
+		// the app hash of the latest block is only in the next header
+		height = status.SyncInfo.LatestBlockHeight - 1
+	}
+
+	result, err := node.ABCIQueryWithOptions(fmt.Sprintf("/store/%s/key", storeName), key, rpcclient.ABCIQueryOptions{
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return StoreProof{}, err
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		return StoreProof{}, errors.New(resp.Log)
+	}
+
+	// the AppHash for height H is in header H+1
+	appHashHeight := resp.Height + 1
+
+	var appHash []byte
+
+	if cliCtx.TrustNode {
+		commit, err := node.Commit(&appHashHeight)
+		if err != nil {
+			return StoreProof{}, err
+		}
+
+		appHash = commit.Header.AppHash
+	} else {
+		commit, err := cliCtx.Verify(appHashHeight)
+		if err != nil {
+			return StoreProof{}, err
+		}
+
+		appHash = commit.Header.AppHash
+	}
+
+	return StoreProof{
+		Height:  resp.Height,
+		AppHash: appHash,
+		Store:   storeName,
+		Key:     resp.Key,
+		Value:   resp.Value,
+		Proof:   resp.Proof,
+	}, nil
+}
+
+// Verify checks the proof of Value (or of the absence of Key if Value is empty)
+// against appHash, which must come from a trusted header at Height+1.
+func (p StoreProof) Verify(appHash []byte) error {
+	if p.Proof == nil {
+		return errors.New("missing proof")
+	}
+
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(p.Store), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(p.Key, merkle.KeyEncodingURL)
+
+	prt := rootmulti.DefaultProofRuntime()
+
+	if len(p.Value) == 0 {
+		if err := prt.VerifyAbsence(p.Proof, appHash, kp.String()); err != nil {
+			return fmt.Errorf("failed to prove absence of key: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := prt.VerifyValue(p.Proof, appHash, kp.String(), p.Value); err != nil {
+		return fmt.Errorf("failed to prove value of key: %w", err)
+	}
+
+	return nil
+}
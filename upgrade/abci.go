@@ -0,0 +1,47 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/zenanetwork/iris/upgrade/types"
+)
+
+// BeginBlocker applies the scheduled upgrades which became active. It halts the node at the
+// height of an upgrade unknown to this binary.
+func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k Keeper) {
+	if !k.HasStores(ctx) {
+		return
+	}
+
+	// on a live network, the store is added at a block past genesis
+	if k.storesHeight > 0 && ctx.BlockHeight() == k.storesHeight {
+		k.SeedBuiltInPlans(ctx)
+	}
+
+	for _, plan := range k.getScheduledPlans(ctx) {
+		if !plan.IsActive(ctx.BlockHeight()) {
+			continue
+		}
+
+		if _, done := k.GetDoneHeight(ctx, plan.Name); done {
+			continue
+		}
+
+		handler, ok := k.handlers[plan.Name]
+		if !ok && !types.IsKnownUpgrade(plan.Name) && !k.IsBuiltIn(plan.Name) {
+			msg := fmt.Sprintf("UPGRADE %q NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info)
+			k.Logger(ctx).Error(msg)
+			panic(msg)
+		}
+
+		if ok {
+			handler(ctx, plan)
+		}
+
+		k.setDone(ctx, plan.Name, ctx.BlockHeight())
+		k.Logger(ctx).Info("Applied upgrade", "name", plan.Name, "height", ctx.BlockHeight())
+	}
+}
@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/spf13/cobra"
+
+	"github.com/zenanetwork/iris/upgrade/types"
+	"github.com/zenanetwork/iris/version"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetQueryPlans(cdc),
+			GetQueryPlan(cdc),
+		)...,
+	)
+
+	return queryCmd
+}
+
+// GetQueryPlans implements the upgrade plans query command.
+func GetQueryPlans(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plans",
+		Args:  cobra.NoArgs,
+		Short: "show the upgrade plans",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the plans of the upgrades, built-in and scheduled by governance.
+
+Example:
+$ %s query upgrade plans
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPlans)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var plans types.Plans
+			if err = jsoniter.ConfigFastest.Unmarshal(bz, &plans); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(plans)
+		},
+	}
+}
+
+// GetQueryPlan implements the upgrade plan query command.
+func GetQueryPlan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show the plan of an upgrade",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the plan of an upgrade.
+
+Example:
+$ %s query upgrade plan danelaw
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			data, err := cliCtx.Codec.MarshalJSON(types.NewQueryPlanParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPlan)
+			bz, _, err := cliCtx.QueryWithData(route, data)
+			if err != nil {
+				return err
+			}
+
+			var plan types.Plan
+			if err = jsoniter.ConfigFastest.Unmarshal(bz, &plan); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	govCli "github.com/zenanetwork/iris/gov/client/cli"
+	govTypes "github.com/zenanetwork/iris/gov/types"
+	"github.com/zenanetwork/iris/helper"
+	hmTypes "github.com/zenanetwork/iris/types"
+	"github.com/zenanetwork/iris/upgrade/types"
+	"github.com/zenanetwork/iris/version"
+)
+
+var logger = helper.Logger.With("module", "upgrade/client/cli")
+
+// GetCmdSubmitUpgradeProposal implements a command handler for submitting a software
+// upgrade proposal transaction.
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a software upgrade proposal along with an initial deposit.
+The upgrade is active from the given height. Nodes halt at that height unless their
+binary knows the upgrade, so a new binary must be rolled out before it.
+
+Example:
+$ %s tx gov submit-proposal software-upgrade danelaw --upgrade-height=22393043 --title="Danelaw" --description="Danelaw hardfork" --deposit="1000000000000000000matic" --validator-id=1 --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			plan := types.NewPlan(args[0], viper.GetInt64(FlagUpgradeHeight), viper.GetString(FlagUpgradeInfo))
+			content := types.NewSoftwareUpgradeProposal(viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription), plan)
+
+			return submitProposal(cliCtx, content)
+		},
+	}
+
+	cmd.Flags().Int64(FlagUpgradeHeight, 0, "Height from which the upgrade is active")
+	cmd.Flags().String(FlagUpgradeInfo, "", "Info for the upgrade plan, e.g. the release to upgrade to")
+
+	if err := cmd.MarkFlagRequired(FlagUpgradeHeight); err != nil {
+		logger.Error("GetCmdSubmitUpgradeProposal | MarkFlagRequired | FlagUpgradeHeight", "Error", err)
+	}
+
+	return addProposalFlags(cmd)
+}
+
+// GetCmdSubmitCancelUpgradeProposal implements a command handler for submitting a cancel
+// software upgrade proposal transaction.
+func GetCmdSubmitCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal cancelling a software upgrade",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal cancelling a software upgrade which is not yet active.
+
+Example:
+$ %s tx gov submit-proposal cancel-software-upgrade danelaw --title="Cancel Danelaw" --description="Postpone Danelaw" --deposit="1000000000000000000matic" --validator-id=1 --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			content := types.NewCancelSoftwareUpgradeProposal(viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription), args[0])
+
+			return submitProposal(cliCtx, content)
+		},
+	}
+
+	return addProposalFlags(cmd)
+}
+
+func addProposalFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().String(govCli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().Int(govCli.FlagValidatorID, 0, "--validator-id=<validator ID here>")
+
+	if err := cmd.MarkFlagRequired(govCli.FlagValidatorID); err != nil {
+		logger.Error("addProposalFlags | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
+
+func submitProposal(cliCtx context.CLIContext, content govTypes.Content) error {
+	validatorID := viper.GetUint64(govCli.FlagValidatorID)
+	if validatorID == 0 {
+		return fmt.Errorf("Valid validator ID required")
+	}
+
+	deposit, err := sdk.ParseCoins(viper.GetString(govCli.FlagDeposit))
+	if err != nil {
+		return err
+	}
+
+	from := helper.GetFromAddress(cliCtx)
+
+	// create submit proposal
+	msg := govTypes.NewMsgSubmitProposal(content, deposit, from, hmTypes.NewValidatorID(validatorID))
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/zenanetwork/iris/gov/client"
+	"github.com/zenanetwork/iris/upgrade/client/cli"
+	"github.com/zenanetwork/iris/upgrade/client/rest"
+)
+
+// software upgrade proposal handlers
+var (
+	ProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitUpgradeProposal, rest.ProposalRESTHandler)
+	CancelProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelUpgradeProposal, rest.CancelProposalRESTHandler)
+)
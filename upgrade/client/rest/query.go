@@ -0,0 +1,118 @@
+// nolint
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/zenanetwork/iris/upgrade/types"
+)
+
+// It represents the upgrade plans
+//
+//swagger:response upgradePlansResponse
+type upgradePlansResponse struct {
+	//in:body
+	Output upgradePlans `json:"output"`
+}
+
+type upgradePlans struct {
+	Height string `json:"height"`
+	Result []plan `json:"result"`
+}
+
+// It represents the plan of an upgrade
+//
+//swagger:response upgradePlanResponse
+type upgradePlanResponse struct {
+	//in:body
+	Output upgradePlan `json:"output"`
+}
+
+type upgradePlan struct {
+	Height string `json:"height"`
+	Result plan   `json:"result"`
+}
+
+type plan struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	Info   string `json:"info"`
+}
+
+// swagger:route GET /upgrade/plans upgrade upgradePlans
+// It returns the upgrade plans
+// responses:
+//
+//	200: upgradePlansResponse
+func plansHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPlans)
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// swagger:route GET /upgrade/plan/{name} upgrade upgradePlanByName
+// It returns the plan of an upgrade
+// responses:
+//
+//	200: upgradePlanResponse
+func planHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryPlanParams(mux.Vars(r)["name"]))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPlan)
+
+		res, height, err := cliCtx.QueryWithData(route, queryParams)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//swagger:parameters upgradePlans upgradePlanByName
+type Height struct {
+
+	//Block Height
+	//in:query
+	Height string `json:"height"`
+}
+
+//swagger:parameters upgradePlanByName
+type Name struct {
+
+	//Name of the upgrade
+	//required:true
+	//in:path
+	Name string `json:"name"`
+}
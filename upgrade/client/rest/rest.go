@@ -0,0 +1,12 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes registers the upgrade module REST routes.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/upgrade/plans", plansHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/upgrade/plan/{name}", planHandlerFn(cliCtx)).Methods("GET")
+}
@@ -0,0 +1,104 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restClient "github.com/zenanetwork/iris/client/rest"
+	govRest "github.com/zenanetwork/iris/gov/client/rest"
+	govTypes "github.com/zenanetwork/iris/gov/types"
+	hmTypes "github.com/zenanetwork/iris/types"
+	"github.com/zenanetwork/iris/types/rest"
+	"github.com/zenanetwork/iris/upgrade/types"
+)
+
+type (
+	// SoftwareUpgradeProposalReq defines a software upgrade proposal request body.
+	SoftwareUpgradeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string              `json:"title" yaml:"title"`
+		Description string              `json:"description" yaml:"description"`
+		Plan        types.Plan          `json:"plan" yaml:"plan"`
+		Proposer    hmTypes.IrisAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins           `json:"deposit" yaml:"deposit"`
+		Validator   hmTypes.ValidatorID `json:"validator" yaml:"validator"`
+	}
+
+	// CancelSoftwareUpgradeProposalReq defines a cancel software upgrade proposal request body.
+	CancelSoftwareUpgradeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string              `json:"title" yaml:"title"`
+		Description string              `json:"description" yaml:"description"`
+		Name        string              `json:"name" yaml:"name"`
+		Proposer    hmTypes.IrisAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins           `json:"deposit" yaml:"deposit"`
+		Validator   hmTypes.ValidatorID `json:"validator" yaml:"validator"`
+	}
+)
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the software
+// upgrade REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "software_upgrade",
+		Handler:  postUpgradeProposalHandlerFn(cliCtx),
+	}
+}
+
+// CancelProposalRESTHandler returns a ProposalRESTHandler that exposes the cancel
+// software upgrade REST handler with a given sub-route.
+func CancelProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "cancel_software_upgrade",
+		Handler:  postCancelUpgradeProposalHandlerFn(cliCtx),
+	}
+}
+
+func postUpgradeProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SoftwareUpgradeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSoftwareUpgradeProposal(req.Title, req.Description, req.Plan)
+
+		writeProposal(w, cliCtx, req.BaseReq, govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator))
+	}
+}
+
+func postCancelUpgradeProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelSoftwareUpgradeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description, req.Name)
+
+		writeProposal(w, cliCtx, req.BaseReq, govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator))
+	}
+}
+
+func writeProposal(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq, msg govTypes.MsgSubmitProposal) {
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	restClient.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/zenanetwork/iris/upgrade/types"
+)
+
+// QueryPlan returns the plan of an upgrade, which tells the height it is active from
+func QueryPlan(cliCtx context.CLIContext, name string) (plan types.Plan, err error) {
+	data, err := cliCtx.Codec.MarshalJSON(types.NewQueryPlanParams(name))
+	if err != nil {
+		return plan, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPlan)
+
+	bz, _, err := cliCtx.QueryWithData(route, data)
+	if err != nil {
+		return plan, err
+	}
+
+	err = jsoniter.ConfigFastest.Unmarshal(bz, &plan)
+
+	return plan, err
+}
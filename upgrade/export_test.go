@@ -0,0 +1,6 @@
+package upgrade
+
+// SetStoresHeight sets the height the store is added at
+func (k *Keeper) SetStoresHeight(height int64) {
+	k.storesHeight = height
+}
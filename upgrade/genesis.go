@@ -0,0 +1,35 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zenanetwork/iris/upgrade/types"
+)
+
+// InitGenesis sets the upgrade plans for genesis, and the plans of the built-in upgrades left
+// out. Before the store is added, there are no plans to set.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	if !keeper.HasStores(ctx) {
+		return
+	}
+
+	for _, plan := range data.Plans {
+		keeper.setPlan(ctx, plan)
+	}
+
+	keeper.SeedBuiltInPlans(ctx)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	var plans []types.Plan
+	if keeper.HasStores(ctx) {
+		plans = keeper.getScheduledPlans(ctx)
+	}
+
+	if plans == nil {
+		plans = []types.Plan{}
+	}
+
+	return types.NewGenesisState(plans)
+}
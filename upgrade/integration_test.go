@@ -0,0 +1,20 @@
+package upgrade_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/zenanetwork/iris/app"
+)
+
+//
+// Create test app
+//
+
+// returns context and app
+func createTestApp(isCheckTx bool) (*app.IrisApp, sdk.Context) {
+	app := app.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+
+	return app, ctx
+}
@@ -0,0 +1,257 @@
+package upgrade
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/zenanetwork/iris/helper"
+	"github.com/zenanetwork/iris/upgrade/types"
+)
+
+// UpgradeHandler applies the state changes of an upgrade at the height of its plan
+type UpgradeHandler func(ctx sdk.Context, plan types.Plan)
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// heights of the built-in upgrades for the configured chain
+	builtIn map[string]int64
+	// height from which the store is in the multistore, negative if it is not
+	storesHeight int64
+	// handlers of the upgrades known to this binary
+	handlers map[string]UpgradeHandler
+}
+
+// NewKeeper create new keeper. The built-in upgrades are the ones gated by height in helper,
+// at the heights of the configured chain.
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	codespace sdk.CodespaceType,
+) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     storeKey,
+		codespace:    codespace,
+		builtIn:      helper.GetUpgradeHeights(),
+		storesHeight: helper.GetUpgradeStoresHeight(),
+		handlers:     make(map[string]UpgradeHandler),
+	}
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// SetUpgradeHandler registers the handler run at the height of the plan of an upgrade
+func (k Keeper) SetUpgradeHandler(name string, handler UpgradeHandler) {
+	k.handlers[name] = handler
+}
+
+// IsBuiltIn returns true if name is an upgrade gated by height in helper. Its plan is seeded at
+// the height of the configured chain, and governance can move it as any other plan.
+func (k Keeper) IsBuiltIn(name string) bool {
+	_, ok := k.builtIn[name]
+	return ok
+}
+
+// HasStores returns true if the upgrade and log registry stores are in the multistore at the
+// height of ctx. Before, the built-in upgrades are active from their heights in helper, and
+// the others are not.
+func (k Keeper) HasStores(ctx sdk.Context) bool {
+	return k.storesHeight >= 0 && ctx.BlockHeight() >= k.storesHeight
+}
+
+// SeedBuiltInPlans writes the plans of the built-in upgrades at their heights in helper, once
+// the store is added. A plan already in the store, e.g. from genesis, is kept, and the upgrades
+// already active are done.
+func (k Keeper) SeedBuiltInPlans(ctx sdk.Context) {
+	names := make([]string, 0, len(k.builtIn))
+	for name := range k.builtIn {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	store := ctx.KVStore(k.storeKey)
+
+	for _, name := range names {
+		if store.Has(types.GetPlanKey(name)) {
+			continue
+		}
+
+		// a negative height is active from genesis
+		height := k.builtIn[name]
+		if height < 0 {
+			height = 0
+		}
+
+		plan := types.NewPlan(name, height, "built-in")
+		k.setPlan(ctx, plan)
+
+		if plan.IsActive(ctx.BlockHeight()) {
+			k.setDone(ctx, name, height)
+		}
+	}
+
+	store.Set(types.SeededKey, []byte{0x01})
+}
+
+// hasPlans returns true if the plans are read from the store, once it is added and the plans of
+// the built-in upgrades are seeded in it. The check state of a new network only has them from
+// the first block.
+func (k Keeper) hasPlans(ctx sdk.Context) bool {
+	return k.HasStores(ctx) && ctx.KVStore(k.storeKey).Has(types.SeededKey)
+}
+
+// -----------------------------------------------------------------------------
+// Plans
+
+// ScheduleUpgrade schedules an upgrade, replacing its plan if it is not yet active
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !k.hasPlans(ctx) {
+		return types.ErrInvalidPlan(k.codespace, "upgrades can't be scheduled before the upgrade store is added")
+	}
+
+	if plan.Height <= ctx.BlockHeight() {
+		return types.ErrInvalidPlan(k.codespace, "height must be greater than the current height")
+	}
+
+	if current, ok := k.GetPlan(ctx, plan.Name); ok && current.IsActive(ctx.BlockHeight()) {
+		return types.ErrPlanActive(k.codespace, plan.Name)
+	}
+
+	k.setPlan(ctx, plan)
+
+	return nil
+}
+
+// CancelUpgrade cancels an upgrade which is not yet active
+func (k Keeper) CancelUpgrade(ctx sdk.Context, name string) sdk.Error {
+	plan, ok := k.GetPlan(ctx, name)
+	if !ok || !k.hasPlans(ctx) {
+		return types.ErrUnknownPlan(k.codespace, name)
+	}
+
+	if plan.IsActive(ctx.BlockHeight()) {
+		return types.ErrPlanActive(k.codespace, name)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetPlanKey(name))
+
+	return nil
+}
+
+// GetPlan returns the plan of an upgrade. Before the store is added, only the built-in upgrades
+// have a plan, at their heights in helper.
+func (k Keeper) GetPlan(ctx sdk.Context, name string) (plan types.Plan, ok bool) {
+	if !k.hasPlans(ctx) {
+		if height, ok := k.builtIn[name]; ok {
+			return types.NewPlan(name, height, "built-in"), true
+		}
+
+		return plan, false
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPlanKey(name))
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &plan)
+
+	return plan, true
+}
+
+// GetPlans returns the plans of all upgrades, sorted by height
+func (k Keeper) GetPlans(ctx sdk.Context) []types.Plan {
+	var plans []types.Plan
+
+	if k.hasPlans(ctx) {
+		plans = k.getScheduledPlans(ctx)
+	} else {
+		for name, height := range k.builtIn {
+			plans = append(plans, types.NewPlan(name, height, "built-in"))
+		}
+	}
+
+	sort.Slice(plans, func(i, j int) bool {
+		if plans[i].Height != plans[j].Height {
+			return plans[i].Height < plans[j].Height
+		}
+
+		return plans[i].Name < plans[j].Name
+	})
+
+	return plans
+}
+
+// IsUpgradeActive returns true if the upgrade is scheduled at or below the current height
+func (k Keeper) IsUpgradeActive(ctx sdk.Context, name string) bool {
+	plan, ok := k.GetPlan(ctx, name)
+	return ok && plan.IsActive(ctx.BlockHeight())
+}
+
+// IsUpgradeHeight returns true if the upgrade is scheduled at the current height
+func (k Keeper) IsUpgradeHeight(ctx sdk.Context, name string) bool {
+	plan, ok := k.GetPlan(ctx, name)
+	return ok && plan.Height == ctx.BlockHeight()
+}
+
+// GetDoneHeight returns the height a scheduled upgrade was applied at
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) (int64, bool) {
+	if !k.HasStores(ctx) {
+		return 0, false
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDoneKey(name))
+	if bz == nil {
+		return 0, false
+	}
+
+	var height int64
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &height)
+
+	return height, true
+}
+
+func (k Keeper) setPlan(ctx sdk.Context, plan types.Plan) {
+	ctx.KVStore(k.storeKey).Set(types.GetPlanKey(plan.Name), k.cdc.MustMarshalBinaryBare(plan))
+}
+
+func (k Keeper) setDone(ctx sdk.Context, name string, height int64) {
+	ctx.KVStore(k.storeKey).Set(types.GetDoneKey(name), k.cdc.MustMarshalBinaryBare(height))
+}
+
+// getScheduledPlans returns the plans scheduled on-chain, sorted by name
+func (k Keeper) getScheduledPlans(ctx sdk.Context) (plans []types.Plan) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PlanPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var plan types.Plan
+
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &plan)
+		plans = append(plans, plan)
+	}
+
+	return plans
+}
@@ -0,0 +1,200 @@
+package upgrade_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/zenanetwork/iris/app"
+	"github.com/zenanetwork/iris/helper"
+	"github.com/zenanetwork/iris/upgrade"
+	"github.com/zenanetwork/iris/upgrade/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.IrisApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(false)
+	suite.ctx = suite.ctx.WithBlockHeight(5)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// Tests
+
+func (suite *KeeperTestSuite) TestScheduleUpgrade() {
+	t, k, ctx := suite.T(), suite.app.UpgradeKeeper, suite.ctx
+
+	require.Error(t, k.ScheduleUpgrade(ctx, types.NewPlan("v2", 5, "")))
+	require.Error(t, k.ScheduleUpgrade(ctx, types.NewPlan("", 10, "")))
+	require.NoError(t, k.ScheduleUpgrade(ctx, types.NewPlan("v2", 10, "")))
+
+	require.False(t, k.IsUpgradeActive(ctx, "v2"))
+	require.True(t, k.IsUpgradeActive(ctx.WithBlockHeight(10), "v2"))
+	require.False(t, k.IsUpgradeActive(ctx.WithBlockHeight(10), "v3"))
+
+	// rescheduled while not active
+	require.NoError(t, k.ScheduleUpgrade(ctx, types.NewPlan("v2", 20, "")))
+	require.False(t, k.IsUpgradeActive(ctx.WithBlockHeight(10), "v2"))
+	require.Error(t, k.ScheduleUpgrade(ctx.WithBlockHeight(20), types.NewPlan("v2", 30, "")))
+
+	require.NoError(t, k.CancelUpgrade(ctx, "v2"))
+	_, ok := k.GetPlan(ctx, "v2")
+	require.False(t, ok)
+	require.Error(t, k.CancelUpgrade(ctx, "v2"))
+}
+
+func (suite *KeeperTestSuite) TestBuiltInUpgrades() {
+	t, k, ctx := suite.T(), suite.app.UpgradeKeeper, suite.ctx
+
+	// seeded at genesis at the heights of the chain, all active from genesis on a devnet
+	heights := helper.GetUpgradeHeights()
+	for name, height := range heights {
+		require.True(t, k.IsBuiltIn(name))
+		require.True(t, k.IsUpgradeActive(ctx, name))
+
+		plan, ok := k.GetPlan(ctx, name)
+		require.True(t, ok)
+		require.Equal(t, height, plan.Height)
+
+		done, ok := k.GetDoneHeight(ctx, name)
+		require.True(t, ok)
+		require.Equal(t, height, done)
+	}
+
+	// the upgrades of the binary are active from the genesis of a new network
+	require.Len(t, k.GetPlans(ctx), len(heights)+len(types.Upgrades))
+	require.True(t, k.IsUpgradeActive(ctx, types.LogRegistryUpgrade))
+	require.ElementsMatch(t, k.GetPlans(ctx), upgrade.ExportGenesis(ctx, k).Plans)
+
+	// active from genesis, they can't be moved anymore
+	require.Error(t, k.ScheduleUpgrade(ctx, types.NewPlan(helper.DanelawUpgrade, 10, "")))
+	require.Error(t, k.CancelUpgrade(ctx, helper.DanelawUpgrade))
+}
+
+func TestMoveBuiltInUpgrade(t *testing.T) {
+	t.Parallel()
+
+	k, ctx := createTestKeeper(t, 0)
+
+	// a fork not active yet, planned in the genesis
+	genesis := types.NewGenesisState([]types.Plan{types.NewPlan(helper.DanelawUpgrade, 20, "")})
+	require.NoError(t, types.ValidateGenesis(genesis))
+	upgrade.InitGenesis(ctx, k, genesis)
+
+	plan, ok := k.GetPlan(ctx, helper.DanelawUpgrade)
+	require.True(t, ok)
+	require.Equal(t, int64(20), plan.Height)
+	require.True(t, k.IsUpgradeActive(ctx, helper.JorvikUpgrade))
+
+	// moved by governance
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.ScheduleUpgrade(ctx, types.NewPlan(helper.DanelawUpgrade, 30, "")))
+	require.False(t, k.IsUpgradeActive(ctx.WithBlockHeight(20), helper.DanelawUpgrade))
+	require.True(t, k.IsUpgradeActive(ctx.WithBlockHeight(30), helper.DanelawUpgrade))
+	require.True(t, k.IsUpgradeHeight(ctx.WithBlockHeight(30), helper.DanelawUpgrade))
+	require.False(t, k.IsUpgradeHeight(ctx.WithBlockHeight(31), helper.DanelawUpgrade))
+
+	// applied at its height without a handler, as the binary gates it
+	require.NotPanics(t, func() {
+		upgrade.BeginBlocker(ctx.WithBlockHeight(30), abci.RequestBeginBlock{}, k)
+	})
+
+	height, ok := k.GetDoneHeight(ctx, helper.DanelawUpgrade)
+	require.True(t, ok)
+	require.Equal(t, int64(30), height)
+}
+
+func TestUpgradeStoresHeight(t *testing.T) {
+	t.Parallel()
+
+	k, ctx := createTestKeeper(t, 10)
+
+	// before the store is added, the built-in upgrades are at their heights in helper
+	upgrade.InitGenesis(ctx, k, types.DefaultGenesisState())
+
+	ctx = ctx.WithBlockHeight(5)
+	upgrade.BeginBlocker(ctx, abci.RequestBeginBlock{}, k)
+
+	require.False(t, k.HasStores(ctx))
+	require.True(t, k.IsUpgradeActive(ctx, helper.AalborgUpgrade))
+	require.False(t, k.IsUpgradeActive(ctx, types.LogRegistryUpgrade))
+	require.Len(t, k.GetPlans(ctx), len(helper.GetUpgradeHeights()))
+	require.Error(t, k.ScheduleUpgrade(ctx, types.NewPlan("v2", 20, "")))
+	require.Empty(t, upgrade.ExportGenesis(ctx, k).Plans)
+
+	// seeded when the store is added
+	ctx = ctx.WithBlockHeight(10)
+	upgrade.BeginBlocker(ctx, abci.RequestBeginBlock{}, k)
+
+	require.True(t, k.HasStores(ctx))
+	require.Len(t, upgrade.ExportGenesis(ctx, k).Plans, len(helper.GetUpgradeHeights()))
+	require.True(t, k.IsUpgradeActive(ctx, helper.AalborgUpgrade))
+	require.False(t, k.IsUpgradeActive(ctx, types.LogRegistryUpgrade))
+
+	height, ok := k.GetDoneHeight(ctx, helper.AalborgUpgrade)
+	require.True(t, ok)
+	require.Equal(t, helper.GetAalborgHardForkHeight(), height)
+
+	// the upgrades of the binary are scheduled by governance
+	require.NoError(t, k.ScheduleUpgrade(ctx, types.NewPlan(types.LogRegistryUpgrade, 20, "")))
+	require.True(t, k.IsUpgradeActive(ctx.WithBlockHeight(20), types.LogRegistryUpgrade))
+}
+
+// createTestKeeper returns a keeper on a new store added at storesHeight
+func createTestKeeper(t *testing.T, storesHeight int64) (upgrade.Keeper, sdk.Context) {
+	t.Helper()
+
+	key := sdk.NewKVStoreKey(types.StoreKey)
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	k := upgrade.NewKeeper(codec.New(), key, types.DefaultCodespace)
+	k.SetStoresHeight(storesHeight)
+
+	return k, sdk.NewContext(cms, abci.Header{}, false, log.NewNopLogger())
+}
+
+func (suite *KeeperTestSuite) TestBeginBlocker() {
+	t, k, ctx := suite.T(), suite.app.UpgradeKeeper, suite.ctx
+
+	require.NoError(t, k.ScheduleUpgrade(ctx, types.NewPlan("v2", 10, "")))
+
+	upgrade.BeginBlocker(ctx.WithBlockHeight(9), abci.RequestBeginBlock{}, k)
+
+	// halts at an upgrade unknown to the binary
+	require.Panics(t, func() {
+		upgrade.BeginBlocker(ctx.WithBlockHeight(10), abci.RequestBeginBlock{}, k)
+	})
+
+	applied := 0
+
+	k.SetUpgradeHandler("v2", func(ctx sdk.Context, plan types.Plan) {
+		applied++
+	})
+
+	upgrade.BeginBlocker(ctx.WithBlockHeight(10), abci.RequestBeginBlock{}, k)
+	upgrade.BeginBlocker(ctx.WithBlockHeight(11), abci.RequestBeginBlock{}, k)
+	require.Equal(t, 1, applied)
+
+	height, ok := k.GetDoneHeight(ctx, "v2")
+	require.True(t, ok)
+	require.Equal(t, int64(10), height)
+}
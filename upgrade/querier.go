@@ -0,0 +1,54 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	jsoniter "github.com/json-iterator/go"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/zenanetwork/iris/upgrade/types"
+)
+
+// NewQuerier creates a querier for upgrade REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryPlans:
+			return queryPlans(ctx, req, keeper)
+		case types.QueryPlan:
+			return queryPlan(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown upgrade query endpoint")
+		}
+	}
+}
+
+func queryPlans(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := jsoniter.ConfigFastest.Marshal(keeper.GetPlans(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryPlan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryPlanParams
+
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	plan, ok := keeper.GetPlan(ctx, params.Name)
+	if !ok {
+		return nil, types.ErrUnknownPlan(keeper.Codespace(), params.Name)
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(plan)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc module codec
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
+
+// RegisterCodec registers all necessary upgrade module types with a given codec.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "iris/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(CancelSoftwareUpgradeProposal{}, "iris/CancelSoftwareUpgradeProposal", nil)
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Upgrade module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = "upgrade"
+
+	CodeInvalidPlan sdk.CodeType = 1
+	CodeUnknownPlan sdk.CodeType = 2
+	CodePlanActive  sdk.CodeType = 3
+)
+
+// ErrInvalidPlan returns an error for an invalid upgrade plan.
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, fmt.Sprintf("invalid upgrade plan: %s", msg))
+}
+
+// ErrUnknownPlan returns an error for an upgrade that is not scheduled.
+func ErrUnknownPlan(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownPlan, fmt.Sprintf("upgrade %s is not scheduled", name))
+}
+
+// ErrPlanActive returns an error for an upgrade that is already active.
+func ErrPlanActive(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodePlanActive, fmt.Sprintf("upgrade %s is already active", name))
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisState - all upgrade state that must be provided at genesis
+type GenesisState struct {
+	Plans []Plan `json:"plans" yaml:"plans"`
+}
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(plans []Plan) GenesisState {
+	return GenesisState{
+		Plans: plans,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state, with the upgrades of this binary
+// active from genesis
+func DefaultGenesisState() GenesisState {
+	plans := make([]Plan, 0, len(Upgrades))
+	for _, name := range Upgrades {
+		plans = append(plans, NewPlan(name, 0, "genesis"))
+	}
+
+	return NewGenesisState(plans)
+}
+
+// ValidateGenesis performs basic validation of upgrade genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	names := make(map[string]bool)
+
+	for _, plan := range data.Plans {
+		if err := plan.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if names[plan.Name] {
+			return fmt.Errorf("duplicate upgrade plan %s", plan.Name)
+		}
+
+		names[plan.Name] = true
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns upgrade GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		ModuleCdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+
+	return genesisState
+}
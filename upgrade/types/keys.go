@@ -0,0 +1,31 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "upgrade"
+
+	// StoreKey is the store key string for upgrade
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for upgrade
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for upgrade
+	QuerierRoute = ModuleName
+)
+
+var (
+	PlanPrefixKey = []byte{0x01} // prefix key for the scheduled plans
+	DonePrefixKey = []byte{0x03} // prefix key for the heights the plans were applied at
+	SeededKey     = []byte{0x04} // key set once the plans of the built-in upgrades are seeded
+)
+
+// GetPlanKey returns the key of the plan of an upgrade
+func GetPlanKey(name string) []byte {
+	return append(PlanPrefixKey, []byte(name)...)
+}
+
+// GetDoneKey returns the key of the height an upgrade was applied at
+func GetDoneKey(name string) []byte {
+	return append(DonePrefixKey, []byte(name)...)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Plan schedules a named upgrade at a height, from which the upgrade is active
+type Plan struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+	Info   string `json:"info" yaml:"info"`
+}
+
+// NewPlan creates a new plan
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// ValidateBasic validates the plan
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "name cannot be empty")
+	}
+
+	if p.Height < 0 {
+		return ErrInvalidPlan(DefaultCodespace, "height cannot be negative")
+	}
+
+	return nil
+}
+
+// IsActive returns true if the upgrade is active at height
+func (p Plan) IsActive(height int64) bool {
+	return height >= p.Height
+}
+
+// String implements the Stringer interface.
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan:
+  Name:   %s
+  Height: %d
+  Info:   %s`, p.Name, p.Height, p.Info)
+}
+
+// Plans is a list of upgrade plans
+type Plans []Plan
+
+// String implements the Stringer interface.
+func (p Plans) String() string {
+	plans := make([]string, 0, len(p))
+	for _, plan := range p {
+		plans = append(plans, plan.String())
+	}
+
+	return strings.Join(plans, "\n")
+}
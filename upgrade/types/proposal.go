@@ -0,0 +1,132 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govTypes "github.com/zenanetwork/iris/gov/types"
+)
+
+const (
+	// ProposalTypeSoftwareUpgrade defines the type for a SoftwareUpgradeProposal
+	ProposalTypeSoftwareUpgrade = "SoftwareUpgrade"
+
+	// ProposalTypeCancelSoftwareUpgrade defines the type for a CancelSoftwareUpgradeProposal
+	ProposalTypeCancelSoftwareUpgrade = "CancelSoftwareUpgrade"
+)
+
+// Assert the upgrade proposals implement govtypes.Content at compile-time
+var (
+	_ govTypes.Content = SoftwareUpgradeProposal{}
+	_ govTypes.Content = CancelSoftwareUpgradeProposal{}
+)
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeSoftwareUpgrade)
+	govTypes.RegisterProposalTypeCodec(SoftwareUpgradeProposal{}, "iris/SoftwareUpgradeProposal")
+	govTypes.RegisterProposalType(ProposalTypeCancelSoftwareUpgrade)
+	govTypes.RegisterProposalTypeCodec(CancelSoftwareUpgradeProposal{}, "iris/CancelSoftwareUpgradeProposal")
+}
+
+// SoftwareUpgradeProposal defines a proposal which schedules an upgrade
+type SoftwareUpgradeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Plan        Plan   `json:"plan" yaml:"plan"`
+}
+
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) SoftwareUpgradeProposal {
+	return SoftwareUpgradeProposal{title, description, plan}
+}
+
+// GetTitle returns the title of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) GetTitle() string { return sup.Title }
+
+// GetDescription returns the description of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
+
+// ProposalRoute returns the routing key of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) ProposalType() string { return ProposalTypeSoftwareUpgrade }
+
+// ValidateBasic validates the software upgrade proposal
+func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(DefaultCodespace, sup); err != nil {
+		return err
+	}
+
+	if sup.Plan.Height == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "height must be greater than 0")
+	}
+
+	return sup.Plan.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (sup SoftwareUpgradeProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+  Plan:
+`, sup.Title, sup.Description))
+
+	b.WriteString(fmt.Sprintf(`    Name:   %s
+    Height: %d
+    Info:   %s
+`, sup.Plan.Name, sup.Plan.Height, sup.Plan.Info))
+
+	return b.String()
+}
+
+// CancelSoftwareUpgradeProposal defines a proposal which cancels a scheduled upgrade
+type CancelSoftwareUpgradeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Name        string `json:"name" yaml:"name"`
+}
+
+func NewCancelSoftwareUpgradeProposal(title, description, name string) CancelSoftwareUpgradeProposal {
+	return CancelSoftwareUpgradeProposal{title, description, name}
+}
+
+// GetTitle returns the title of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) GetTitle() string { return csup.Title }
+
+// GetDescription returns the description of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) GetDescription() string { return csup.Description }
+
+// ProposalRoute returns the routing key of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) ProposalType() string {
+	return ProposalTypeCancelSoftwareUpgrade
+}
+
+// ValidateBasic validates the cancel software upgrade proposal
+func (csup CancelSoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(DefaultCodespace, csup); err != nil {
+		return err
+	}
+
+	if len(strings.TrimSpace(csup.Name)) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "name cannot be empty")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (csup CancelSoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Cancel Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+  Name:        %s
+`, csup.Title, csup.Description, csup.Name)
+}
@@ -0,0 +1,17 @@
+package types
+
+// query endpoints supported by the upgrade Querier
+const (
+	QueryPlans = "plans"
+	QueryPlan  = "plan"
+)
+
+// QueryPlanParams defines the params for querying the plan of an upgrade
+type QueryPlanParams struct {
+	Name string `json:"name"`
+}
+
+// NewQueryPlanParams creates a new instance of QueryPlanParams.
+func NewQueryPlanParams(name string) QueryPlanParams {
+	return QueryPlanParams{Name: name}
+}
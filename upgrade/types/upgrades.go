@@ -0,0 +1,31 @@
+package types
+
+// Names of the upgrades of this binary scheduled by governance
+const (
+	LogRegistryUpgrade            = "log-registry"
+	ValidatorSetChangelogUpgrade  = "validator-set-changelog"
+	CheckpointBlockIndexUpgrade   = "checkpoint-block-index"
+	MilestoneParticipationUpgrade = "milestone-participation"
+	FinalityHeightsUpgrade        = "finality-heights"
+)
+
+// Upgrades are the upgrades of this binary scheduled by governance on the live networks.
+// A new network activates them from genesis.
+var Upgrades = []string{
+	LogRegistryUpgrade,
+	ValidatorSetChangelogUpgrade,
+	CheckpointBlockIndexUpgrade,
+	MilestoneParticipationUpgrade,
+	FinalityHeightsUpgrade,
+}
+
+// IsKnownUpgrade returns true if name is an upgrade of this binary scheduled by governance
+func IsKnownUpgrade(name string) bool {
+	for _, upgrade := range Upgrades {
+		if upgrade == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -19,7 +19,9 @@ type ResponseWithHeight struct {
 }
 
 func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k Keeper) {
-	if ctx.BlockHeight() == helper.GetSpanOverrideHeight() {
+	addChildChainFirstSpans(ctx, k)
+
+	if k.upgradeKeeper.IsUpgradeHeight(ctx, helper.SpanOverrideUpgrade) {
 		k.Logger(ctx).Info("overriding span BeginBlocker", "height", ctx.BlockHeight())
 
 		j, ok := rest.SPAN_OVERRIDES[helper.GenesisDoc.ChainID]
@@ -53,3 +55,22 @@ func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k Keeper) {
 		}
 	}
 }
+
+// addChildChainFirstSpans adds the first span of the zena child chains registered since the last
+// block, produced by the current validator set
+func addChildChainFirstSpans(ctx sdk.Context, k Keeper) {
+	for _, childChain := range k.chainKeeper.GetParams(ctx).ChildChains {
+		chainKeeper, err := k.WithChainID(ctx, childChain.ZenaChainID)
+		if err != nil || chainKeeper.HasSpan(ctx, 0) {
+			continue
+		}
+
+		firstSpan := types.NewFirstSpan(k.sk.GetValidatorSet(ctx), k.GetParams(ctx).ProducerCount, childChain.ZenaChainID)
+		if err := chainKeeper.AddNewSpan(ctx, firstSpan); err != nil {
+			k.Logger(ctx).Error("Error adding first span of zena child chain", "chainID", childChain.ZenaChainID, "error", err)
+			continue
+		}
+
+		k.Logger(ctx).Info("Added first span of zena child chain", "chainID", childChain.ZenaChainID)
+	}
+}
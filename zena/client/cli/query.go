@@ -18,6 +18,7 @@ import (
 	"github.com/zenanetwork/iris/helper"
 	hmTypes "github.com/zenanetwork/iris/types"
 	"github.com/zenanetwork/iris/version"
+	upgradeUtils "github.com/zenanetwork/iris/upgrade/client/utils"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -353,9 +354,14 @@ func GetPreparedProposeSpan(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			danelaw, err := upgradeUtils.QueryPlan(cliCtx, helper.DanelawUpgrade)
+			if err != nil {
+				return err
+			}
+
 			var result []byte
 
-			if nodeStatus.SyncInfo.LatestBlockHeight < helper.GetDanelawHeight() {
+			if !danelaw.IsActive(nodeStatus.SyncInfo.LatestBlockHeight) {
 				msg := types.NewMsgProposeSpan(
 					spanID,
 					proposer,
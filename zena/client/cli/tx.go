@@ -17,6 +17,7 @@ import (
 	hmClient "github.com/zenanetwork/iris/client"
 	"github.com/zenanetwork/iris/helper"
 	hmTypes "github.com/zenanetwork/iris/types"
+	upgradeUtils "github.com/zenanetwork/iris/upgrade/client/utils"
 )
 
 var cliLogger = helper.Logger.With("module", "zena/client/cli")
@@ -124,8 +125,13 @@ func PostSendProposeSpanTx(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			danelaw, err := upgradeUtils.QueryPlan(cliCtx, helper.DanelawUpgrade)
+			if err != nil {
+				return err
+			}
+
 			var msg sdk.Msg
-			if nodeStatus.SyncInfo.LatestBlockHeight < helper.GetDanelawHeight() {
+			if !danelaw.IsActive(nodeStatus.SyncInfo.LatestBlockHeight) {
 				msg = types.NewMsgProposeSpan(
 					spanID,
 					proposer,
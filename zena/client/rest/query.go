@@ -139,6 +139,17 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/zena/params", paramsHandlerFn(cliCtx)).Methods("GET")
 }
 
+// spanQueryPath returns the querier path of a span query, of the zena child chain in the
+// chain_id query param if any
+func spanQueryPath(r *http.Request, query string) string {
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query)
+	if chainID := r.URL.Query().Get("chain_id"); chainID != "" {
+		path = fmt.Sprintf("%s/%s", path, chainID)
+	}
+
+	return path
+}
+
 //swagger:parameters zenaCurrentSpanById
 type zenaCurrentSpanById struct {
 
@@ -175,7 +186,7 @@ func fetchNextSpanSeedHandlerFn(
 			return
 		}
 
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextSpanSeed), seedQueryParams)
+		res, _, err := cliCtx.QueryWithData(spanQueryPath(r, types.QueryNextSpanSeed), seedQueryParams)
 		if err != nil {
 			RestLogger.Error("Error while fetching next span seed  ", "Error", err.Error())
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -247,7 +258,7 @@ func spanListHandlerFn(
 		}
 
 		// query spans
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySpanList), queryParams)
+		res, _, err := cliCtx.QueryWithData(spanQueryPath(r, types.QuerySpanList), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -302,7 +313,8 @@ func spanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			loadSpanOverrides()
 		}
 
-		if span, ok := spanOverrides[spanID]; ok {
+		// the overrides are spans of the chain of the chain params
+		if span, ok := spanOverrides[spanID]; ok && r.URL.Query().Get("chain_id") == "" {
 			res = span.Result
 			height = span.Height
 			spanOverridden = true
@@ -316,7 +328,7 @@ func spanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			}
 
 			// fetch span
-			res, height, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySpan), queryParams)
+			res, height, err = cliCtx.QueryWithData(spanQueryPath(r, types.QuerySpan), queryParams)
 			if err != nil {
 				hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 				return
@@ -346,7 +358,7 @@ func latestSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// fetch latest span
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLatestSpan), nil)
+		res, height, err := cliCtx.QueryWithData(spanQueryPath(r, types.QueryLatestSpan), nil)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -487,7 +499,7 @@ func prepareNextSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		nextProducerBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextProducers), query)
+		nextProducerBytes, _, err := cliCtx.QueryWithData(spanQueryPath(r, types.QueryNextProducers), query)
 		if err != nil {
 			fmt.Println("error while querying next producers: ", err)
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -600,3 +612,11 @@ type Height struct {
 	//in:query
 	Height string `json:"height"`
 }
+
+//swagger:parameters zenaSpanList zenaSpanById zenaSpanLatest zenaNextSpanSeed
+type ZenaChainID struct {
+
+	//Chain ID of a zena child chain, the chain of the chain params if empty
+	//in:query
+	ChainID string `json:"chain_id"`
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/zenanetwork/iris/helper"
 	hmTypes "github.com/zenanetwork/iris/types"
 	"github.com/zenanetwork/iris/types/rest"
+	upgradeUtils "github.com/zenanetwork/iris/upgrade/client/utils"
 )
 
 // It represents Propose Span msg.
@@ -173,8 +174,14 @@ func postProposeSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		danelaw, err := upgradeUtils.QueryPlan(cliCtx, helper.DanelawUpgrade)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		var msg sdk.Msg
-		if nodeStatus.SyncInfo.LatestBlockHeight < helper.GetDanelawHeight() {
+		if !danelaw.IsActive(nodeStatus.SyncInfo.LatestBlockHeight) {
 			// draft a propose span message
 			msg = types.NewMsgProposeSpan(
 				req.ID,
@@ -1,6 +1,8 @@
 package zena
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/zenanetwork/iris/zena/types"
@@ -24,6 +26,27 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		// update last span
 		keeper.UpdateLastSpan(ctx, data.Spans[len(data.Spans)-1].ID)
 	}
+
+	for _, childChain := range data.ChildChains {
+		chainKeeper, err := keeper.WithChainID(ctx, childChain.ZenaChainID)
+		if err != nil || chainKeeper.ChainID() == "" {
+			panic(fmt.Sprintf("spans of zena chain %s which is not a child chain", childChain.ZenaChainID))
+		}
+
+		if len(childChain.Spans) == 0 {
+			continue
+		}
+
+		hmTypes.SortSpanByID(childChain.Spans)
+
+		for _, span := range childChain.Spans {
+			if err := chainKeeper.AddNewRawSpan(ctx, *span); err != nil {
+				chainKeeper.Logger(ctx).Error("Error AddNewRawSpan", "error", err)
+			}
+		}
+
+		chainKeeper.UpdateLastSpan(ctx, childChain.Spans[len(childChain.Spans)-1].ID)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -33,9 +56,27 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	allSpans := keeper.GetAllSpans(ctx)
 	hmTypes.SortSpanByID(allSpans)
 
+	var childChains []types.ChildChainGenesisState
+
+	for _, childChain := range keeper.chainKeeper.GetParams(ctx).ChildChains {
+		chainKeeper, err := keeper.WithChainID(ctx, childChain.ZenaChainID)
+		if err != nil {
+			panic(err)
+		}
+
+		childSpans := chainKeeper.GetAllSpans(ctx)
+		hmTypes.SortSpanByID(childSpans)
+
+		childChains = append(childChains, types.ChildChainGenesisState{
+			ZenaChainID: childChain.ZenaChainID,
+			Spans:       childSpans,
+		})
+	}
+
 	return types.NewGenesisState(
 		params,
 		// TODO think better way to export all spans
 		allSpans,
+		childChains,
 	)
 }
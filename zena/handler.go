@@ -31,7 +31,7 @@ func HandleMsgProposeSpan(ctx sdk.Context, msg sdk.Msg, k Keeper) sdk.Result {
 	var proposeMsg types.MsgProposeSpanV2
 	switch msg := msg.(type) {
 	case types.MsgProposeSpan:
-		if ctx.BlockHeight() >= helper.GetDanelawHeight() {
+		if k.upgradeKeeper.IsUpgradeActive(ctx, helper.DanelawUpgrade) {
 			err := errors.New("msg span is not allowed after Danelaw hardfork height")
 			k.Logger(ctx).Error(err.Error())
 			return sdk.ErrTxDecode(err.Error()).Result()
@@ -45,7 +45,7 @@ func HandleMsgProposeSpan(ctx sdk.Context, msg sdk.Msg, k Keeper) sdk.Result {
 			Seed:       msg.Seed,
 		}
 	case types.MsgProposeSpanV2:
-		if ctx.BlockHeight() < helper.GetDanelawHeight() {
+		if !k.upgradeKeeper.IsUpgradeActive(ctx, helper.DanelawUpgrade) {
 			err := errors.New("msg span v2 is not allowed before Danelaw hardfork height")
 			k.Logger(ctx).Error(err.Error())
 			return sdk.ErrTxDecode(err.Error()).Result()
@@ -61,12 +61,9 @@ func HandleMsgProposeSpan(ctx sdk.Context, msg sdk.Msg, k Keeper) sdk.Result {
 		"seed", proposeMsg.Seed.String(),
 	)
 
-	// chainManager params
-	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
-
-	// check chain id
-	if chainParams.ZenaChainID != proposeMsg.ChainID {
+	// check chain id, the spans of a child chain are kept apart
+	k, err := k.WithChainID(ctx, proposeMsg.ChainID)
+	if err != nil {
 		k.Logger(ctx).Error("Invalid Zena chain id", "msgChainID", proposeMsg.ChainID)
 		return common.ErrInvalidZenaChainID(k.Codespace()).Result()
 	}
@@ -18,7 +18,9 @@ import (
 	"github.com/zenanetwork/iris/helper"
 	"github.com/zenanetwork/iris/params/subspace"
 	"github.com/zenanetwork/iris/staking"
+	stakingTypes "github.com/zenanetwork/iris/staking/types"
 	hmTypes "github.com/zenanetwork/iris/types"
+	"github.com/zenanetwork/iris/upgrade"
 	"github.com/zenanetwork/iris/zena/types"
 )
 
@@ -47,6 +49,10 @@ type Keeper struct {
 	contractCaller helper.IContractCaller
 	// chain manager keeper
 	chainKeeper chainmanager.Keeper
+	// upgrade keeper
+	upgradeKeeper upgrade.Keeper
+	// zena child chain the keeper is scoped to, empty for the chain of the chain params
+	chainID string
 }
 
 // NewKeeper is the constructor of Keeper
@@ -56,6 +62,7 @@ func NewKeeper(
 	paramSpace subspace.Subspace,
 	codespace sdk.CodespaceType,
 	chainKeeper chainmanager.Keeper,
+	upgradeKeeper upgrade.Keeper,
 	stakingKeeper staking.Keeper,
 	caller *helper.ContractCaller,
 ) Keeper {
@@ -65,6 +72,7 @@ func NewKeeper(
 		paramSpace:     paramSpace.WithKeyTable(types.ParamKeyTable()),
 		codespace:      codespace,
 		chainKeeper:    chainKeeper,
+		upgradeKeeper:  upgradeKeeper,
 		sk:             stakingKeeper,
 		contractCaller: caller,
 	}
@@ -95,9 +103,45 @@ func (k *Keeper) SetContractCaller(contractCaller helper.IContractCaller) {
 	k.contractCaller = contractCaller
 }
 
+// WithChainID returns the keeper of the spans of a zena chain. The spans of a child chain are
+// kept apart from those of the chain of the chain params, and seeded from its own blocks.
+func (k Keeper) WithChainID(ctx sdk.Context, chainID string) (Keeper, error) {
+	params := k.chainKeeper.GetParams(ctx)
+
+	switch {
+	case chainID == params.ChainParams.ZenaChainID:
+		k.chainID = ""
+	case params.IsChildChain(chainID):
+		k.chainID = chainID
+	default:
+		return k, fmt.Errorf("unknown zena chain id %s", chainID)
+	}
+
+	return k, nil
+}
+
+// ChainID returns the zena child chain the keeper is scoped to, empty for the chain of the chain params
+func (k Keeper) ChainID() string {
+	return k.chainID
+}
+
+// ChainContractCaller returns the contract caller reading the zena chain the keeper is scoped to
+func (k Keeper) ChainContractCaller(contractCaller helper.IContractCaller) (helper.IContractCaller, error) {
+	if k.chainID == "" {
+		return contractCaller, nil
+	}
+
+	return contractCaller.ForChildChain(k.chainID)
+}
+
+// store returns the store of the zena chain the keeper is scoped to
+func (k *Keeper) store(ctx sdk.Context) sdk.KVStore {
+	return hmTypes.ChainStore(ctx.KVStore(k.storeKey), k.chainID)
+}
+
 // AddNewSpan adds new span for zena to store
 func (k *Keeper) AddNewSpan(ctx sdk.Context, span hmTypes.Span) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	out, err := k.cdc.MarshalBinaryBare(span)
 	if err != nil {
@@ -116,7 +160,7 @@ func (k *Keeper) AddNewSpan(ctx sdk.Context, span hmTypes.Span) error {
 
 // AddNewRawSpan adds new span for zena to store
 func (k *Keeper) AddNewRawSpan(ctx sdk.Context, span hmTypes.Span) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	out, err := k.cdc.MarshalBinaryBare(span)
 	if err != nil {
@@ -131,7 +175,7 @@ func (k *Keeper) AddNewRawSpan(ctx sdk.Context, span hmTypes.Span) error {
 
 // GetSpan fetches span indexed by id from store
 func (k *Keeper) GetSpan(ctx sdk.Context, id uint64) (*hmTypes.Span, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	spanKey := GetSpanKey(id)
 
 	// If we are starting from 0 there will be no spanKey present
@@ -148,7 +192,7 @@ func (k *Keeper) GetSpan(ctx sdk.Context, id uint64) (*hmTypes.Span, error) {
 }
 
 func (k *Keeper) HasSpan(ctx sdk.Context, id uint64) bool {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	spanKey := GetSpanKey(id)
 
 	return store.Has(spanKey)
@@ -168,7 +212,7 @@ func (k *Keeper) GetAllSpans(ctx sdk.Context) (spans []*hmTypes.Span) {
 
 // GetSpanList returns all spans with params like page and limit
 func (k *Keeper) GetSpanList(ctx sdk.Context, page uint64, limit uint64) ([]hmTypes.Span, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// have max limit
 	if limit > maxSpanListLimit {
@@ -193,7 +237,7 @@ func (k *Keeper) GetSpanList(ctx sdk.Context, page uint64, limit uint64) ([]hmTy
 
 // GetLastSpan fetches last span using lastStartBlock
 func (k *Keeper) GetLastSpan(ctx sdk.Context) (*hmTypes.Span, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	var lastSpanID uint64
 
@@ -215,7 +259,7 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 		err          error
 	)
 
-	if ctx.BlockHeight() < helper.GetJorvikHeight() {
+	if !k.upgradeKeeper.IsUpgradeActive(ctx, helper.JorvikUpgrade) {
 		newProducers, err = k.SelectNextProducers(ctx, seed, nil)
 		if err != nil {
 			return err
@@ -266,7 +310,7 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 
 // SelectNextProducers selects producers for next span
 func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash, prevVals []hmTypes.Validator) (vals []hmTypes.Validator, err error) {
-	if ctx.BlockHeight() < helper.GetJorvikHeight() {
+	if !k.upgradeKeeper.IsUpgradeActive(ctx, helper.JorvikUpgrade) {
 		prevVals = nil
 	}
 
@@ -288,14 +332,8 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash, prevVals
 		spanEligibleVals = rollbackVotingPowers(ctx, spanEligibleVals, prevVals)
 	}
 
-	// TODO remove old selection algorithm
 	// select next producers using seed as block header hash
-	fn := SelectNextProducers
-	if ctx.BlockHeight() < helper.GetNewSelectionAlgoHeight() {
-		fn = XXXSelectNextProducers
-	}
-
-	newProducersIds, err := fn(seed, spanEligibleVals, producerCount)
+	newProducersIds, err := k.GetProducerSelector(ctx).SelectNextProducers(keeperSelectionState{ctx: ctx, k: k}, seed, spanEligibleVals, producerCount)
 	if err != nil {
 		return vals, err
 	}
@@ -321,15 +359,79 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash, prevVals
 	return vals, nil
 }
 
+// GetProducerSelector returns the producer selector active at the current height
+func (k *Keeper) GetProducerSelector(ctx sdk.Context) ProducerSelector {
+	params := k.GetParams(ctx)
+
+	//nolint:gosec
+	if params.ProducerSelector != types.DefaultProducerSelector && uint64(ctx.BlockHeight()) >= params.ProducerSelectorHeight {
+		if selector, ok := GetProducerSelector(params.ProducerSelector); ok {
+			return selector
+		}
+
+		k.Logger(ctx).Error("Unknown producer selector, using the default selection", "selector", params.ProducerSelector)
+	}
+
+	// TODO remove old selection algorithm
+	if !k.upgradeKeeper.IsUpgradeActive(ctx, helper.NewSelectionAlgoUpgrade) {
+		return ProducerSelectorFunc(XXXSelectNextProducers)
+	}
+
+	return ProducerSelectorFunc(SelectNextProducers)
+}
+
+// keeperSelectionState serves the selection state of producer selectors from the store
+type keeperSelectionState struct {
+	ctx sdk.Context
+	k   *Keeper
+}
+
+// RecentProducers implements SelectionState
+func (s keeperSelectionState) RecentProducers(n int) [][]uint64 {
+	producers := make([][]uint64, 0, n)
+
+	lastSpan, err := s.k.GetLastSpan(s.ctx)
+	if err != nil {
+		return producers
+	}
+
+	for id := lastSpan.ID; len(producers) < n; id-- {
+		span, err := s.k.GetSpan(s.ctx, id)
+		if err != nil {
+			break
+		}
+
+		spanProducers := make([]uint64, 0)
+		for _, val := range span.SelectedProducers {
+			for i := int64(0); i < val.VotingPower; i++ {
+				spanProducers = append(spanProducers, val.ID.Uint64())
+			}
+		}
+
+		producers = append(producers, spanProducers)
+
+		if id == 0 {
+			break
+		}
+	}
+
+	return producers
+}
+
+// MilestoneParticipation implements SelectionState
+func (s keeperSelectionState) MilestoneParticipation(valID hmTypes.ValidatorID) stakingTypes.MilestoneParticipation {
+	return s.k.sk.GetMilestoneParticipation(s.ctx, valID)
+}
+
 // UpdateLastSpan updates the last span start block
 func (k *Keeper) UpdateLastSpan(ctx sdk.Context, id uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(LastSpanIDKey, []byte(strconv.FormatUint(id, 10)))
 }
 
 // IncrementLastEthBlock increment last eth block
 func (k *Keeper) IncrementLastEthBlock(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	lastEthBlock := big.NewInt(0)
 	if store.Has(LastProcessedEthBlock) {
@@ -341,13 +443,13 @@ func (k *Keeper) IncrementLastEthBlock(ctx sdk.Context) {
 
 // SetLastEthBlock sets last eth block number
 func (k *Keeper) SetLastEthBlock(ctx sdk.Context, blockNumber *big.Int) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(LastProcessedEthBlock, blockNumber.Bytes())
 }
 
 // GetLastEthBlock get last processed Eth block for seed
 func (k *Keeper) GetLastEthBlock(ctx sdk.Context) *big.Int {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	lastEthBlock := big.NewInt(0)
 	if store.Has(LastProcessedEthBlock) {
@@ -366,7 +468,13 @@ func (k *Keeper) GetNextSpanSeed(ctx sdk.Context, id uint64) (common.Hash, commo
 		author      *common.Address
 	)
 
-	if ctx.BlockHeight() < helper.GetJorvikHeight() {
+	contractCaller, err := k.ChainContractCaller(k.contractCaller)
+	if err != nil {
+		k.Logger(ctx).Error("Error getting the contract caller of the zena chain", "chainID", k.chainID, "error", err)
+		return common.Hash{}, common.Address{}, err
+	}
+
+	if !k.upgradeKeeper.IsUpgradeActive(ctx, helper.JorvikUpgrade) {
 		lastEthBlock := k.GetLastEthBlock(ctx)
 		// increment last processed header block number
 		newEthBlock := lastEthBlock.Add(lastEthBlock, big.NewInt(1))
@@ -374,7 +482,7 @@ func (k *Keeper) GetNextSpanSeed(ctx sdk.Context, id uint64) (common.Hash, commo
 
 		// fetch block header from mainchain
 		var e error
-		blockHeader, e = k.contractCaller.GetMainChainBlock(newEthBlock)
+		blockHeader, e = contractCaller.GetMainChainBlock(newEthBlock)
 		if e != nil {
 			k.Logger(ctx).Error("Error fetching block header from mainchain while calculating next span seed", "error", e)
 			return common.Hash{}, common.Address{}, e
@@ -402,7 +510,7 @@ func (k *Keeper) GetNextSpanSeed(ctx sdk.Context, id uint64) (common.Hash, commo
 			return common.Hash{}, common.Address{}, fmt.Errorf("zena block value out of range for int64: %d", zenaBlock)
 		}
 
-		blockHeader, err = k.contractCaller.GetMaticChainBlock(big.NewInt(int64(zenaBlock)))
+		blockHeader, err = contractCaller.GetMaticChainBlock(big.NewInt(int64(zenaBlock)))
 		if err != nil {
 			k.Logger(ctx).Error("Error fetching block header from zena chain while calculating next span seed", "error", err, "block", zenaBlock)
 			return common.Hash{}, common.Address{}, err
@@ -421,7 +529,7 @@ func (k *Keeper) GetNextSpanSeed(ctx sdk.Context, id uint64) (common.Hash, commo
 
 // StoreSeedProducer stores producer of the block used for seed for the given span id
 func (k *Keeper) StoreSeedProducer(ctx sdk.Context, id uint64, producer *common.Address) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	lastSeedKey := GetLastSeedProducer(id)
 
 	if store.Has(lastSeedKey) {
@@ -434,7 +542,7 @@ func (k *Keeper) StoreSeedProducer(ctx sdk.Context, id uint64, producer *common.
 
 // GetSeedProducer gets producer of the block used for seed for the given span id
 func (k *Keeper) GetSeedProducer(ctx sdk.Context, id uint64) (*common.Address, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	lastSeedKey := GetLastSeedProducer(id)
 
 	authorBytes := store.Get(lastSeedKey)
@@ -457,7 +565,10 @@ func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // GetParams gets the zena module's parameters.
 func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	// params added after genesis are not stored until set by governance
+	params = types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+
 	return
 }
 
@@ -467,7 +578,7 @@ func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 
 // IterateSpansAndApplyFn iterates spans and apply the given function.
 func (k *Keeper) IterateSpansAndApplyFn(ctx sdk.Context, f func(span hmTypes.Span) error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// get span iterator
 	iterator := sdk.KVStorePrefixIterator(store, SpanPrefixKey)
@@ -497,11 +608,17 @@ func (k *Keeper) getZenaBlockForSpanSeed(ctx sdk.Context, seedSpan *hmTypes.Span
 
 	logger := k.Logger(ctx)
 
+	contractCaller, err := k.ChainContractCaller(k.contractCaller)
+	if err != nil {
+		logger.Error("Error getting the contract caller of the zena chain", "chainID", k.chainID, "error", err)
+		return 0, nil, err
+	}
+
 	logger.Debug("getting zena block for span seed", "span id", seedSpan.ID, "proposed span id", proposedSpanID)
 
 	if proposedSpanID == 1 {
 		zenaBlock = 1
-		author, err = k.contractCaller.GetZenaChainBlockAuthor(big.NewInt(int64(zenaBlock)))
+		author, err = contractCaller.GetZenaChainBlockAuthor(big.NewInt(int64(zenaBlock)))
 		if err != nil {
 			logger.Error("Error fetching first block for span seed", "error", err, "block", zenaBlock)
 			return 0, nil, err
@@ -552,7 +669,7 @@ func (k *Keeper) getZenaBlockForSpanSeed(ctx sdk.Context, seedSpan *hmTypes.Span
 		if zenaBlock > math.MaxInt64 {
 			return 0, nil, fmt.Errorf("zena block value out of range for int64: %d", zenaBlock)
 		}
-		author, err = k.contractCaller.GetZenaChainBlockAuthor(big.NewInt(int64(zenaBlock)))
+		author, err = contractCaller.GetZenaChainBlockAuthor(big.NewInt(int64(zenaBlock)))
 		if err != nil {
 			logger.Error("Error fetching block author from zena chain while calculating next span seed", "error", err, "block", zenaBlock)
 			return 0, nil, err
@@ -578,7 +695,7 @@ func (k *Keeper) getZenaBlockForSpanSeed(ctx sdk.Context, seedSpan *hmTypes.Span
 		return 0, nil, fmt.Errorf("zena block value out of range for int64: %d", zenaBlock)
 	}
 
-	author, err = k.contractCaller.GetZenaChainBlockAuthor(big.NewInt(int64(zenaBlock)))
+	author, err = contractCaller.GetZenaChainBlockAuthor(big.NewInt(int64(zenaBlock)))
 	if err != nil {
 		logger.Error("Error fetching end block author from zena chain while calculating next span seed", "error", err, "block", zenaBlock)
 		return 0, nil, err
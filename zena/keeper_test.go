@@ -10,9 +10,13 @@ import (
 	"github.com/zenanetwork/go-zenanet/common"
 	ethTypes "github.com/zenanetwork/go-zenanet/core/types"
 	"github.com/zenanetwork/iris/app"
+	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
 	"github.com/zenanetwork/iris/helper/mocks"
+	"github.com/zenanetwork/iris/params"
+	paramsTypes "github.com/zenanetwork/iris/params/types"
 	hmTypes "github.com/zenanetwork/iris/types"
 	"github.com/zenanetwork/iris/zena"
+	zenaTypes "github.com/zenanetwork/iris/zena/types"
 )
 
 type ZenaKeeperTestSuite struct {
@@ -202,6 +206,51 @@ func (s *ZenaKeeperTestSuite) TestGetSeedProducer() {
 
 }
 
+func (s *ZenaKeeperTestSuite) TestGetProducerSelector() {
+	require, zenaKeeper := s.Require(), s.app.ZenaKeeper
+	ctx := s.ctx.WithBlockHeight(100)
+
+	// default selection when unset
+	require.IsType(zena.ProducerSelectorFunc(nil), zenaKeeper.GetProducerSelector(ctx))
+
+	params := zenaKeeper.GetParams(ctx)
+	params.ProducerSelector = zena.RoundRobinSelector
+	params.ProducerSelectorHeight = 101
+	zenaKeeper.SetParams(ctx, params)
+
+	// not active yet
+	require.IsType(zena.ProducerSelectorFunc(nil), zenaKeeper.GetProducerSelector(ctx))
+	require.IsType(zena.RoundRobin{}, zenaKeeper.GetProducerSelector(ctx.WithBlockHeight(101)))
+
+	// unknown selectors fall back to the default selection
+	params.ProducerSelector = "unknown"
+	zenaKeeper.SetParams(ctx, params)
+	require.IsType(zena.ProducerSelectorFunc(nil), zenaKeeper.GetProducerSelector(ctx.WithBlockHeight(101)))
+}
+
+func (s *ZenaKeeperTestSuite) TestProducerSelectorParamChange() {
+	require, app, ctx := s.Require(), s.app, s.ctx
+	handler := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+
+	// unknown selectors are rejected
+	proposal := paramsTypes.NewParameterChangeProposal("Selector", "description", []paramsTypes.ParamChange{
+		paramsTypes.NewParamChange(zenaTypes.DefaultParamspace, string(zenaTypes.KeyProducerSelector), `"unknown"`),
+	})
+	require.Error(handler(ctx, proposal))
+	require.Error(zenaTypes.ValidateGenesis(zenaTypes.NewGenesisState(zenaTypes.Params{
+		SprintDuration:   zenaTypes.DefaultSprintDuration,
+		SpanDuration:     zenaTypes.DefaultSpanDuration,
+		ProducerCount:    zenaTypes.DefaultProducerCount,
+		ProducerSelector: "unknown",
+	}, nil, nil)))
+
+	proposal = paramsTypes.NewParameterChangeProposal("Selector", "description", []paramsTypes.ParamChange{
+		paramsTypes.NewParamChange(zenaTypes.DefaultParamspace, string(zenaTypes.KeyProducerSelector), `"`+zena.RoundRobinSelector+`"`),
+	})
+	require.NoError(handler(ctx, proposal))
+	require.Equal(zena.RoundRobinSelector, app.ZenaKeeper.GetParams(ctx).ProducerSelector)
+}
+
 func (s *ZenaKeeperTestSuite) TestRollbackVotingPowers() {
 	testcases := []struct {
 		name    string
@@ -257,3 +306,49 @@ func setupValSet() *hmTypes.ValidatorSet {
 
 	return valSet
 }
+
+func (s *ZenaKeeperTestSuite) TestChildChainSpans() {
+	require, ctx, zenaKeeper := s.Require(), s.ctx, s.app.ZenaKeeper
+	valSet := s.setupValSet()
+	vals := make([]hmTypes.Validator, 0, len(valSet.Validators))
+	for _, val := range valSet.Validators {
+		vals = append(vals, *val)
+	}
+
+	chainParams := s.app.ChainKeeper.GetParams(ctx)
+
+	// unknown chains have no spans
+	_, err := zenaKeeper.WithChainID(ctx, "15002")
+	require.Error(err)
+
+	chainParams.ChildChains = []chainmanagerTypes.ChildChainParams{{ZenaChainID: "15002"}}
+	s.app.ChainKeeper.SetParams(ctx, chainParams)
+
+	primaryKeeper, err := zenaKeeper.WithChainID(ctx, chainParams.ChainParams.ZenaChainID)
+	require.NoError(err)
+	require.Empty(primaryKeeper.ChainID())
+
+	childKeeper, err := zenaKeeper.WithChainID(ctx, "15002")
+	require.NoError(err)
+	require.Equal("15002", childKeeper.ChainID())
+
+	// the first span of the child chain is added in the next block
+	require.False(childKeeper.HasSpan(ctx, 0))
+	zena.BeginBlocker(ctx, abci.RequestBeginBlock{}, zenaKeeper)
+	require.True(childKeeper.HasSpan(ctx, 0))
+
+	firstSpan, err := childKeeper.GetLastSpan(ctx)
+	require.NoError(err)
+	require.Equal(uint64(0), firstSpan.ID)
+	require.Equal("15002", firstSpan.ChainID)
+
+	// spans of the child chain are kept apart from those of the chain of the chain params
+	span := hmTypes.NewSpan(1, 256, 6655, *valSet, vals, "15002")
+	require.NoError(childKeeper.AddNewSpan(ctx, span))
+	require.True(childKeeper.HasSpan(ctx, 1))
+	require.False(primaryKeeper.HasSpan(ctx, 1))
+
+	lastSpan, err := childKeeper.GetLastSpan(ctx)
+	require.NoError(err)
+	require.Equal(uint64(1), lastSpan.ID)
+}
@@ -21,6 +21,22 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			}
 
 			return queryParams(ctx, path[1:], req, keeper)
+		}
+
+		// the keeper of the query, scoped to its chain
+		keeper := keeper
+
+		// span queries of a zena child chain end with its chain id
+		if len(path) > 1 {
+			chainKeeper, err := keeper.WithChainID(ctx, path[1])
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(err.Error())
+			}
+
+			keeper = chainKeeper
+		}
+
+		switch path[0] {
 		case types.QuerySpan:
 			return handleQuerySpan(ctx, req, keeper)
 		case types.QuerySpanList:
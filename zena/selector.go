@@ -0,0 +1,266 @@
+package zena
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/zenanetwork/go-zenanet/common"
+
+	stakingTypes "github.com/zenanetwork/iris/staking/types"
+	hmTypes "github.com/zenanetwork/iris/types"
+	"github.com/zenanetwork/iris/zena/types"
+)
+
+// Names of the registered producer selectors
+const (
+	WeightedRandomSelector         = "weighted-random"
+	RoundRobinSelector             = "round-robin"
+	MilestoneParticipationSelector = "milestone-participation"
+)
+
+const (
+	// DefaultRotationSpans is the number of spans within which the round-robin selector
+	// lets every span eligible validator produce
+	DefaultRotationSpans = 8
+
+	// DefaultMaxMissedMilestonePercent is the share of missed milestone turns above which
+	// the milestone participation selector excludes a validator
+	DefaultMaxMissedMilestonePercent = 50
+
+	// DefaultMinMilestoneTurns is the number of milestone turns a validator must have
+	// before its participation is taken into account
+	DefaultMinMilestoneTurns = 4
+)
+
+// SelectionState is the chain state a producer selector can base its selection on
+type SelectionState interface {
+	// RecentProducers returns the producer IDs of at most n last spans, the most recent first.
+	// A producer is repeated as many times as its voting power in the span.
+	RecentProducers(n int) [][]uint64
+
+	// MilestoneParticipation returns the milestone participation of a validator
+	MilestoneParticipation(valID hmTypes.ValidatorID) stakingTypes.MilestoneParticipation
+}
+
+// ProducerSelector selects the producers of the next span among the span eligible validators.
+// It returns producerCount validator IDs, a validator being repeated for each slot it gets.
+// Selection must be deterministic for a given seed and state.
+type ProducerSelector interface {
+	SelectNextProducers(state SelectionState, seed common.Hash, spanEligibleVals []hmTypes.Validator, producerCount uint64) ([]uint64, error)
+}
+
+// ProducerSelectorFunc is a stateless producer selector
+type ProducerSelectorFunc func(seed common.Hash, spanEligibleVals []hmTypes.Validator, producerCount uint64) ([]uint64, error)
+
+// SelectNextProducers implements ProducerSelector
+func (fn ProducerSelectorFunc) SelectNextProducers(_ SelectionState, seed common.Hash, spanEligibleVals []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
+	return fn(seed, spanEligibleVals, producerCount)
+}
+
+var producerSelectors = make(map[string]ProducerSelector)
+
+func init() {
+	RegisterProducerSelector(WeightedRandomSelector, ProducerSelectorFunc(SelectNextProducers))
+	RegisterProducerSelector(RoundRobinSelector, RoundRobin{RotationSpans: DefaultRotationSpans})
+	RegisterProducerSelector(MilestoneParticipationSelector, MilestoneParticipation{
+		MaxMissedPercent: DefaultMaxMissedMilestonePercent,
+		MinTurns:         DefaultMinMilestoneTurns,
+		Next:             ProducerSelectorFunc(SelectNextProducers),
+	})
+}
+
+// RegisterProducerSelector registers a producer selector under name, to be chosen with
+// the ProducerSelector param. It panics if name is already registered.
+func RegisterProducerSelector(name string, selector ProducerSelector) {
+	if _, ok := producerSelectors[name]; ok || name == "" {
+		panic(fmt.Sprintf("producer selector %q already registered", name))
+	}
+
+	producerSelectors[name] = selector
+	types.RegisterProducerSelectorName(name)
+}
+
+// GetProducerSelector returns the producer selector registered under name
+func GetProducerSelector(name string) (ProducerSelector, bool) {
+	selector, ok := producerSelectors[name]
+	return selector, ok
+}
+
+// ProducerSelectorNames returns the names of the registered producer selectors, sorted
+func ProducerSelectorNames() []string {
+	names := make([]string, 0, len(producerSelectors))
+	for name := range producerSelectors {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+//
+// Round-robin selection
+//
+
+// RoundRobin selects producers by stake, while reserving enough slots in each span to
+// rotate through all span eligible validators so that each of them produces at least once
+// in any RotationSpans consecutive spans. The guarantee is loosened to
+// ceil(validators / producerCount) spans when producerCount is too small for it.
+type RoundRobin struct {
+	RotationSpans int
+}
+
+// SelectNextProducers implements ProducerSelector
+func (r RoundRobin) SelectNextProducers(state SelectionState, _ common.Hash, spanEligibleVals []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
+	if err := checkProducerCount(producerCount); err != nil {
+		return nil, err
+	}
+
+	if len(spanEligibleVals) <= int(producerCount) {
+		return validatorIDs(spanEligibleVals), nil
+	}
+
+	rotationSpans := r.RotationSpans
+	if rotationSpans <= 0 {
+		rotationSpans = DefaultRotationSpans
+	}
+
+	// slots of each span reserved for the rotation
+	rotationSlots := (len(spanEligibleVals) + rotationSpans - 1) / rotationSpans
+	if rotationSlots > int(producerCount) {
+		rotationSlots = int(producerCount)
+	}
+
+	// look back far enough to see every validator of a full rotation
+	history := state.RecentProducers((len(spanEligibleVals)+rotationSlots-1)/rotationSlots + rotationSpans)
+
+	// spans since each validator last produced, and its slots in the history
+	waited := make(map[uint64]int)
+	slots := make(map[uint64]int64)
+
+	var historySlots int64
+
+	for i, producers := range history {
+		for _, id := range producers {
+			if _, ok := waited[id]; !ok {
+				waited[id] = i
+			}
+
+			slots[id]++
+			historySlots++
+		}
+	}
+
+	for _, val := range spanEligibleVals {
+		if val.VotingPower < 0 {
+			return nil, fmt.Errorf("voting power value is negative: %d", val.VotingPower)
+		}
+
+		if _, ok := waited[val.ID.Uint64()]; !ok {
+			waited[val.ID.Uint64()] = len(history)
+		}
+	}
+
+	// rotation: the validators that waited the longest, the first ones by ID on a tie
+	byWait := make([]hmTypes.Validator, len(spanEligibleVals))
+	copy(byWait, spanEligibleVals)
+	sort.SliceStable(byWait, func(i, j int) bool {
+		wi, wj := waited[byWait[i].ID.Uint64()], waited[byWait[j].ID.Uint64()]
+		if wi != wj {
+			return wi > wj
+		}
+
+		return byWait[i].ID < byWait[j].ID
+	})
+
+	selected := make([]uint64, 0, producerCount)
+	for _, val := range byWait[:rotationSlots] {
+		selected = append(selected, val.ID.Uint64())
+		slots[val.ID.Uint64()]++
+	}
+
+	// stake: each remaining slot goes to the validator furthest below its stake share
+	// of the slots, i.e. with the highest power * totalSlots - slots * totalPower
+	var totalPower int64
+	for _, val := range spanEligibleVals {
+		totalPower += val.VotingPower
+	}
+
+	totalSlots := historySlots + int64(producerCount)
+	deficit := func(val hmTypes.Validator) *big.Int {
+		expected := new(big.Int).Mul(big.NewInt(val.VotingPower), big.NewInt(totalSlots))
+		return expected.Sub(expected, new(big.Int).Mul(big.NewInt(slots[val.ID.Uint64()]), big.NewInt(totalPower)))
+	}
+
+	for len(selected) < int(producerCount) {
+		best := spanEligibleVals[0]
+		bestDeficit := deficit(best)
+
+		for _, val := range spanEligibleVals[1:] {
+			if d := deficit(val); d.Cmp(bestDeficit) > 0 || (d.Cmp(bestDeficit) == 0 && val.ID < best.ID) {
+				best, bestDeficit = val, d
+			}
+		}
+
+		selected = append(selected, best.ID.Uint64())
+		slots[best.ID.Uint64()]++
+	}
+
+	return selected, nil
+}
+
+//
+// Milestone participation selection
+//
+
+// MilestoneParticipation excludes the validators that missed more than MaxMissedPercent
+// of their last milestone proposer turns, once they had MinTurns turns, and selects the
+// producers among the others with Next. No validator is excluded if all would be.
+type MilestoneParticipation struct {
+	MaxMissedPercent uint64
+	MinTurns         uint64
+	Next             ProducerSelector
+}
+
+// SelectNextProducers implements ProducerSelector
+func (m MilestoneParticipation) SelectNextProducers(state SelectionState, seed common.Hash, spanEligibleVals []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
+	participating := make([]hmTypes.Validator, 0, len(spanEligibleVals))
+
+	for _, val := range spanEligibleVals {
+		participation := state.MilestoneParticipation(val.ID)
+		if participation.Turns() >= m.MinTurns && participation.MissedPercent() > m.MaxMissedPercent {
+			continue
+		}
+
+		participating = append(participating, val)
+	}
+
+	if len(participating) == 0 {
+		participating = spanEligibleVals
+	}
+
+	return m.Next.SelectNextProducers(state, seed, participating, producerCount)
+}
+
+//
+// Utils
+//
+
+func checkProducerCount(producerCount uint64) error {
+	if producerCount > math.MaxInt64 {
+		return fmt.Errorf("producer count value out of range for int: %d", producerCount)
+	}
+
+	return nil
+}
+
+func validatorIDs(vals []hmTypes.Validator) []uint64 {
+	ids := make([]uint64, 0, len(vals))
+	for _, val := range vals {
+		ids = append(ids, val.ID.Uint64())
+	}
+
+	return ids
+}
@@ -53,7 +53,7 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, contractCaller he
 	var proposeMsg types.MsgProposeSpanV2
 	switch msg := msg.(type) {
 	case types.MsgProposeSpan:
-		if ctx.BlockHeight() >= helper.GetDanelawHeight() {
+		if k.upgradeKeeper.IsUpgradeActive(ctx, helper.DanelawUpgrade) {
 			k.Logger(ctx).Error("Msg span is not allowed after Danelaw hardfork height")
 			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 		}
@@ -66,7 +66,7 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, contractCaller he
 			Seed:       msg.Seed,
 		}
 	case types.MsgProposeSpanV2:
-		if ctx.BlockHeight() < helper.GetDanelawHeight() {
+		if !k.upgradeKeeper.IsUpgradeActive(ctx, helper.DanelawUpgrade) {
 			k.Logger(ctx).Error("Msg span v2 is not allowed before Danelaw hardfork height")
 			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 		}
@@ -77,6 +77,19 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, contractCaller he
 		"msgSeed", proposeMsg.Seed.String(),
 	)
 
+	// read the spans and the blocks of the chain of the span
+	k, err := k.WithChainID(ctx, proposeMsg.ChainID)
+	if err != nil {
+		k.Logger(ctx).Error("Invalid zena chain id", "chainID", proposeMsg.ChainID, "error", err)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	contractCaller, err = k.ChainContractCaller(contractCaller)
+	if err != nil {
+		k.Logger(ctx).Error("Error getting the contract caller of the zena chain", "chainID", proposeMsg.ChainID, "error", err)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
 	// calculate next span seed locally
 	seed, seedAuthor, err := k.GetNextSpanSeed(ctx, proposeMsg.ID)
 	if err != nil {
@@ -97,7 +110,7 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, contractCaller he
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
 
-	if ctx.BlockHeight() >= helper.GetDanelawHeight() {
+	if k.upgradeKeeper.IsUpgradeActive(ctx, helper.DanelawUpgrade) {
 		// check if span seed author matches or not.
 		if !bytes.Equal(proposeMsg.SeedAuthor.Bytes(), seedAuthor.Bytes()) {
 			k.Logger(ctx).Error(
@@ -161,7 +174,7 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, sideTxResult
 	var proposeMsg types.MsgProposeSpanV2
 	switch msg := msg.(type) {
 	case types.MsgProposeSpan:
-		if ctx.BlockHeight() >= helper.GetDanelawHeight() {
+		if k.upgradeKeeper.IsUpgradeActive(ctx, helper.DanelawUpgrade) {
 			k.Logger(ctx).Error("Msg span is not allowed after Danelaw hardfork height")
 			return common.ErrSideTxValidation(k.Codespace()).Result()
 		}
@@ -174,13 +187,19 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, sideTxResult
 			Seed:       msg.Seed,
 		}
 	case types.MsgProposeSpanV2:
-		if ctx.BlockHeight() < helper.GetDanelawHeight() {
+		if !k.upgradeKeeper.IsUpgradeActive(ctx, helper.DanelawUpgrade) {
 			k.Logger(ctx).Error("Msg span v2 is not allowed before Danelaw hardfork height")
 			return common.ErrSideTxValidation(k.Codespace()).Result()
 		}
 		proposeMsg = msg
 	}
 
+	k, err := k.WithChainID(ctx, proposeMsg.ChainID)
+	if err != nil {
+		logger.Error("Invalid zena chain id", "chainID", proposeMsg.ChainID, "error", err)
+		return common.ErrInvalidZenaChainID(k.Codespace()).Result()
+	}
+
 	// check for replay
 	if k.HasSpan(ctx, proposeMsg.ID) {
 		logger.Debug("Skipping new span as it's already processed")
@@ -196,7 +215,7 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, sideTxResult
 		"seed", proposeMsg.Seed.String(),
 	)
 
-	if ctx.BlockHeight() >= helper.GetJorvikHeight() {
+	if k.upgradeKeeper.IsUpgradeActive(ctx, helper.JorvikUpgrade) {
 		var seedSpanID uint64
 		if proposeMsg.ID < 2 {
 			seedSpanID = proposeMsg.ID - 1
@@ -212,7 +231,7 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, sideTxResult
 
 		var producer *ethCommon.Address
 
-		if ctx.BlockHeight() < helper.GetDanelawHeight() {
+		if !k.upgradeKeeper.IsUpgradeActive(ctx, helper.DanelawUpgrade) {
 			// store the seed producer
 			_, producer, err = k.getZenaBlockForSpanSeed(ctx, lastSpan, proposeMsg.ID)
 			if err != nil {
@@ -230,7 +249,7 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, sideTxResult
 	}
 
 	// freeze for new span
-	err := k.FreezeSet(ctx, proposeMsg.ID, proposeMsg.StartBlock, proposeMsg.EndBlock, proposeMsg.ChainID, proposeMsg.Seed)
+	err = k.FreezeSet(ctx, proposeMsg.ID, proposeMsg.StartBlock, proposeMsg.EndBlock, proposeMsg.ChainID, proposeMsg.Seed)
 	if err != nil {
 		k.Logger(ctx).Error("Unable to freeze validator set for span", "Error", err)
 		return common.ErrUnableToFreezeValSet(k.Codespace()).Result()
@@ -0,0 +1,180 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zenanetwork/go-zenanet/common"
+
+	stakingTypes "github.com/zenanetwork/iris/staking/types"
+	hmTypes "github.com/zenanetwork/iris/types"
+	"github.com/zenanetwork/iris/zena"
+)
+
+// ValidatorFairness is the share of the producer slots a validator got in a simulation
+type ValidatorFairness struct {
+	ID         hmTypes.ValidatorID
+	StakeShare float64 // share of the total voting power
+	SlotShare  float64 // share of the producer slots
+	Spans      uint64  // spans the validator produced in
+	MaxGap     uint64  // longest run of consecutive spans the validator did not produce in
+}
+
+// FairnessReport is the fairness distribution of a producer selector over simulated spans
+type FairnessReport struct {
+	Selector   string
+	Spans      uint64
+	Validators []ValidatorFairness
+
+	MaxGap       uint64  // longest gap of any validator
+	Starved      int     // validators that never produced
+	MaxDeviation float64 // largest difference between the slot share and the stake share of a validator
+}
+
+// String implements the stringer interface
+func (r FairnessReport) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("%s over %d spans: max gap %d, starved %d, max deviation %.4f\n", r.Selector, r.Spans, r.MaxGap, r.Starved, r.MaxDeviation))
+
+	for _, v := range r.Validators {
+		sb.WriteString(fmt.Sprintf("  validator %d: stake %.4f slots %.4f spans %d max gap %d\n", v.ID, v.StakeShare, v.SlotShare, v.Spans, v.MaxGap))
+	}
+
+	return sb.String()
+}
+
+// selectionState is an in-memory selection state fed with the simulated spans
+type selectionState struct {
+	producers     [][]uint64 // most recent first
+	participation map[hmTypes.ValidatorID]stakingTypes.MilestoneParticipation
+}
+
+func (s *selectionState) RecentProducers(n int) [][]uint64 {
+	if n > len(s.producers) {
+		n = len(s.producers)
+	}
+
+	return s.producers[:n]
+}
+
+func (s *selectionState) MilestoneParticipation(valID hmTypes.ValidatorID) stakingTypes.MilestoneParticipation {
+	return s.participation[valID]
+}
+
+// SimulateProducerSelection selects the producers of spans consecutive spans with the selector
+// registered under name, seeding each span with a hash derived from its number, and reports how
+// the producer slots were distributed among the validators.
+func SimulateProducerSelection(
+	name string,
+	vals []hmTypes.Validator,
+	producerCount uint64,
+	spans uint64,
+	participation map[hmTypes.ValidatorID]stakingTypes.MilestoneParticipation,
+) (report FairnessReport, err error) {
+	selector, ok := zena.GetProducerSelector(name)
+	if !ok {
+		return report, fmt.Errorf("unknown producer selector %q", name)
+	}
+
+	state := &selectionState{participation: participation}
+
+	slots := make(map[uint64]uint64)
+	produced := make(map[uint64]uint64)
+	lastSpan := make(map[uint64]int64)
+	maxGap := make(map[uint64]uint64)
+
+	for _, val := range vals {
+		lastSpan[val.ID.Uint64()] = -1
+	}
+
+	var totalSlots uint64
+
+	for span := uint64(0); span < spans; span++ {
+		var bz [8]byte
+
+		binary.BigEndian.PutUint64(bz[:], span)
+		seed := common.Hash(sha256.Sum256(bz[:]))
+
+		ids, err := selector.SelectNextProducers(state, seed, vals, producerCount)
+		if err != nil {
+			return report, err
+		}
+
+		seen := make(map[uint64]bool)
+		for _, id := range ids {
+			slots[id]++
+			totalSlots++
+
+			if !seen[id] {
+				seen[id] = true
+				produced[id]++
+
+				//nolint:gosec
+				if gap := uint64(int64(span) - lastSpan[id] - 1); gap > maxGap[id] {
+					maxGap[id] = gap
+				}
+
+				//nolint:gosec
+				lastSpan[id] = int64(span)
+			}
+		}
+
+		state.producers = append([][]uint64{ids}, state.producers...)
+	}
+
+	var totalPower int64
+	for _, val := range vals {
+		totalPower += val.VotingPower
+	}
+
+	report = FairnessReport{
+		Selector: name,
+		Spans:    spans,
+	}
+
+	for _, val := range vals {
+		id := val.ID.Uint64()
+
+		//nolint:gosec
+		if gap := uint64(int64(spans) - lastSpan[id] - 1); gap > maxGap[id] {
+			maxGap[id] = gap
+		}
+
+		v := ValidatorFairness{
+			ID:         val.ID,
+			StakeShare: float64(val.VotingPower) / float64(totalPower),
+			SlotShare:  float64(slots[id]) / float64(totalSlots),
+			Spans:      produced[id],
+			MaxGap:     maxGap[id],
+		}
+
+		report.Validators = append(report.Validators, v)
+
+		if v.MaxGap > report.MaxGap {
+			report.MaxGap = v.MaxGap
+		}
+
+		if v.Spans == 0 {
+			report.Starved++
+		}
+
+		deviation := v.SlotShare - v.StakeShare
+		if deviation < 0 {
+			deviation = -deviation
+		}
+
+		if deviation > report.MaxDeviation {
+			report.MaxDeviation = deviation
+		}
+	}
+
+	sort.Slice(report.Validators, func(i, j int) bool {
+		return report.Validators[i].ID < report.Validators[j].ID
+	})
+
+	return report, nil
+}
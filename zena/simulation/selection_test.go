@@ -0,0 +1,72 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	stakingTypes "github.com/zenanetwork/iris/staking/types"
+	hmTypes "github.com/zenanetwork/iris/types"
+	"github.com/zenanetwork/iris/zena"
+)
+
+// validators with a long tail of small stakes, as on mainnet
+func testValidators() []hmTypes.Validator {
+	vals := make([]hmTypes.Validator, 0, 20)
+
+	for i := 1; i <= 20; i++ {
+		power := int64(1000)
+		if i <= 3 {
+			power = 100000
+		} else if i <= 8 {
+			power = 10000
+		}
+
+		vals = append(vals, hmTypes.Validator{
+			ID:          hmTypes.NewValidatorID(uint64(i)),
+			VotingPower: power,
+		})
+	}
+
+	return vals
+}
+
+func TestProducerSelectorFairness(t *testing.T) {
+	t.Parallel()
+
+	vals := testValidators()
+
+	// validator 1 misses most of its milestone turns
+	participation := map[hmTypes.ValidatorID]stakingTypes.MilestoneParticipation{
+		1: {Proposed: 2, Missed: 8},
+		2: {Proposed: 10},
+	}
+
+	const producerCount, spans = 4, 200
+
+	for _, name := range zena.ProducerSelectorNames() {
+		report, err := SimulateProducerSelection(name, vals, producerCount, spans, participation)
+		require.NoError(t, err)
+		t.Log(report)
+
+		switch name {
+		case zena.RoundRobinSelector:
+			// every validator produces within the rotation
+			require.Zero(t, report.Starved)
+			require.LessOrEqual(t, report.MaxGap, uint64(zena.DefaultRotationSpans-1))
+
+			// the largest stakes still get the most slots
+			require.Greater(t, report.Validators[0].SlotShare, report.Validators[19].SlotShare)
+		case zena.MilestoneParticipationSelector:
+			require.Zero(t, report.Validators[0].Spans)
+			require.NotZero(t, report.Validators[1].Spans)
+		}
+	}
+}
+
+func TestSimulateUnknownSelector(t *testing.T) {
+	t.Parallel()
+
+	_, err := SimulateProducerSelection("unknown", testValidators(), 4, 1, nil)
+	require.Error(t, err)
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	chainmanagerTypes "github.com/zenanetwork/iris/chainmanager/types"
 	"github.com/zenanetwork/iris/gov/types"
@@ -10,21 +12,29 @@ import (
 
 // GenesisState is the zena state that must be provided at genesis.
 type GenesisState struct {
-	Params Params          `json:"params" yaml:"params"`
-	Spans  []*hmTypes.Span `json:"spans" yaml:"spans"` // list of spans
+	Params      Params                   `json:"params" yaml:"params"`
+	Spans       []*hmTypes.Span          `json:"spans" yaml:"spans"`                                   // list of spans
+	ChildChains []ChildChainGenesisState `json:"child_chains,omitempty" yaml:"child_chains,omitempty"` // spans of the zena child chains
+}
+
+// ChildChainGenesisState is the span state of a zena child chain
+type ChildChainGenesisState struct {
+	ZenaChainID string          `json:"zena_chain_id" yaml:"zena_chain_id"`
+	Spans       []*hmTypes.Span `json:"spans" yaml:"spans"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, spans []*hmTypes.Span) GenesisState {
+func NewGenesisState(params Params, spans []*hmTypes.Span, childChains []ChildChainGenesisState) GenesisState {
 	return GenesisState{
-		Params: params,
-		Spans:  spans,
+		Params:      params,
+		Spans:       spans,
+		ChildChains: childChains,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams(), nil)
+	return NewGenesisState(DefaultParams(), nil, nil)
 }
 
 // ValidateGenesis performs basic validation of zena genesis data returning an
@@ -34,19 +44,30 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	chainIDs := make(map[string]bool, len(data.ChildChains))
+
+	for _, childChain := range data.ChildChains {
+		if childChain.ZenaChainID == "" {
+			return errors.New("empty zena chain id of child chain spans")
+		}
+
+		if chainIDs[childChain.ZenaChainID] {
+			return fmt.Errorf("duplicate spans of zena child chain %s", childChain.ZenaChainID)
+		}
+
+		chainIDs[childChain.ZenaChainID] = true
+	}
+
 	return nil
 }
 
-// genFirstSpan generates default first valdiator producer set
-func genFirstSpan(valset hmTypes.ValidatorSet, chainId string) []*hmTypes.Span {
-	var (
-		firstSpan         []*hmTypes.Span
-		selectedProducers []hmTypes.Validator
-	)
+// NewFirstSpan returns the first span of a zena chain, produced by the top validators of the set
+func NewFirstSpan(valset hmTypes.ValidatorSet, producerCount uint64, chainID string) hmTypes.Span {
+	var selectedProducers []hmTypes.Validator
 
-	if len(valset.Validators) > int(DefaultProducerCount) {
+	if len(valset.Validators) > int(producerCount) {
 		// pop top validators and select
-		for i := 0; i < int(DefaultProducerCount); i++ {
+		for i := 0; i < int(producerCount); i++ {
 			selectedProducers = append(selectedProducers, *valset.Validators[i])
 		}
 	} else {
@@ -55,10 +76,14 @@ func genFirstSpan(valset hmTypes.ValidatorSet, chainId string) []*hmTypes.Span {
 		}
 	}
 
-	newSpan := hmTypes.NewSpan(0, 0, 0+DefaultFirstSpanDuration-1, valset, selectedProducers, chainId)
-	firstSpan = append(firstSpan, &newSpan)
+	return hmTypes.NewSpan(0, 0, 0+DefaultFirstSpanDuration-1, valset, selectedProducers, chainID)
+}
+
+// genFirstSpan generates default first valdiator producer set
+func genFirstSpan(valset hmTypes.ValidatorSet, chainId string) []*hmTypes.Span {
+	newSpan := NewFirstSpan(valset, DefaultProducerCount, chainId)
 
-	return firstSpan
+	return []*hmTypes.Span{&newSpan}
 }
 
 // GetGenesisStateFromAppState returns staking GenesisState given raw application genesis state
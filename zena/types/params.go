@@ -19,6 +19,11 @@ const (
 	DefaultSpanDuration      uint64 = 100 * DefaultSprintDuration
 	DefaultFirstSpanDuration uint64 = 256
 	DefaultProducerCount     uint64 = 4
+
+	// DefaultProducerSelector selects producers as before the selector params existed:
+	// ticket shuffle before the new selection algorithm height, weighted random after it
+	DefaultProducerSelector              = ""
+	DefaultProducerSelectorHeight uint64 = 0
 )
 
 // Parameter keys
@@ -26,6 +31,9 @@ var (
 	KeySprintDuration = []byte("SprintDuration")
 	KeySpanDuration   = []byte("SpanDuration")
 	KeyProducerCount  = []byte("ProducerCount")
+
+	KeyProducerSelector       = []byte("ProducerSelector")
+	KeyProducerSelectorHeight = []byte("ProducerSelectorHeight")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -35,6 +43,9 @@ type Params struct {
 	SprintDuration uint64 `json:"sprint_duration" yaml:"sprint_duration"` // sprint duration
 	SpanDuration   uint64 `json:"span_duration" yaml:"span_duration"`     // span duration ie number of blocks for which val set is frozen on iris
 	ProducerCount  uint64 `json:"producer_count" yaml:"producer_count"`   // producer count per span
+
+	ProducerSelector       string `json:"producer_selector" yaml:"producer_selector"`               // name of the registered producer selector, empty for the default selection
+	ProducerSelectorHeight uint64 `json:"producer_selector_height" yaml:"producer_selector_height"` // iris height from which ProducerSelector selects producers
 }
 
 // NewParams creates a new Params object
@@ -43,6 +54,9 @@ func NewParams(sprintDuration uint64, spanDuration uint64, producerCount uint64)
 		SprintDuration: sprintDuration,
 		SpanDuration:   spanDuration,
 		ProducerCount:  producerCount,
+
+		ProducerSelector:       DefaultProducerSelector,
+		ProducerSelectorHeight: DefaultProducerSelectorHeight,
 	}
 }
 
@@ -54,6 +68,8 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeySprintDuration, &p.SprintDuration},
 		{KeySpanDuration, &p.SpanDuration},
 		{KeyProducerCount, &p.ProducerCount},
+		{KeyProducerSelector, &p.ProducerSelector},
+		{KeyProducerSelectorHeight, &p.ProducerSelectorHeight},
 	}
 }
 
@@ -73,6 +89,8 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("SprintDuration: %d\n", p.SprintDuration))
 	sb.WriteString(fmt.Sprintf("SpanDuration: %d\n", p.SpanDuration))
 	sb.WriteString(fmt.Sprintf("ProducerCount: %d\n", p.ProducerCount))
+	sb.WriteString(fmt.Sprintf("ProducerSelector: %s\n", p.ProducerSelector))
+	sb.WriteString(fmt.Sprintf("ProducerSelectorHeight: %d\n", p.ProducerSelectorHeight))
 
 	return sb.String()
 }
@@ -91,6 +109,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateProducerSelector(p.ProducerSelector); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -100,7 +122,17 @@ func (p Params) Validate() error {
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().
+		RegisterParamSet(&Params{}).
+		RegisterValidator(KeyProducerSelector, validateProducerSelector)
+}
+
+// producerSelectorNames are the names of the producer selectors registered by the zena module
+var producerSelectorNames = make(map[string]bool)
+
+// RegisterProducerSelectorName makes name a valid ProducerSelector param
+func RegisterProducerSelectorName(name string) {
+	producerSelectorNames[name] = true
 }
 
 // DefaultParams returns a default set of parameters.
@@ -109,6 +141,9 @@ func DefaultParams() Params {
 		SprintDuration: DefaultSprintDuration,
 		SpanDuration:   DefaultSpanDuration,
 		ProducerCount:  DefaultProducerCount,
+
+		ProducerSelector:       DefaultProducerSelector,
+		ProducerSelectorHeight: DefaultProducerSelectorHeight,
 	}
 }
 
@@ -150,3 +185,16 @@ func validateProducerCount(i interface{}) error {
 
 	return nil
 }
+
+func validateProducerSelector(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != DefaultProducerSelector && !producerSelectorNames[v] {
+		return fmt.Errorf("unknown producer selector: %q", v)
+	}
+
+	return nil
+}